@@ -448,7 +448,7 @@ func (m *AppealReviewReply) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for ReviewID
+	// no validation rules for AppealID
 
 	if len(errors) > 0 {
 		return AppealReviewReplyMultiError(errors)
@@ -785,3 +785,6438 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ReplyReviewUpdateReplyValidationError{}
+
+// Validate checks the field values on ListStoreReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreReviewsRequestMultiError, or nil if none found.
+func (m *ListStoreReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Unreplied
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListStoreReviewsRequest_HasMedia_InLookup[m.GetHasMedia()]; !ok {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "HasMedia",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStoreReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStoreReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListStoreReviewsRequestValidationError is the validation error returned by
+// ListStoreReviewsRequest.Validate if the designated constraints aren't met.
+type ListStoreReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreReviewsRequestValidationError) ErrorName() string {
+	return "ListStoreReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreReviewsRequestValidationError{}
+
+var _ListStoreReviewsRequest_HasMedia_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on StoreReviewInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StoreReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoreReviewInfoMultiError, or nil if none found.
+func (m *StoreReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for UserID
+
+	// no validation rules for Nickname
+
+	// no validation rules for OrderID
+
+	// no validation rules for Score
+
+	// no validation rules for ServiceScore
+
+	// no validation rules for ExpressScore
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for Anonymous
+
+	// no validation rules for HasReply
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return StoreReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by StoreReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type StoreReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreReviewInfoMultiError) AllErrors() []error { return m }
+
+// StoreReviewInfoValidationError is the validation error returned by
+// StoreReviewInfo.Validate if the designated constraints aren't met.
+type StoreReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreReviewInfoValidationError) ErrorName() string { return "StoreReviewInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StoreReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreReviewInfoValidationError{}
+
+// Validate checks the field values on ListStoreReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreReviewsReplyMultiError, or nil if none found.
+func (m *ListStoreReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStoreReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStoreReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoreReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListStoreReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListStoreReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListStoreReviewsReplyValidationError is the validation error returned by
+// ListStoreReviewsReply.Validate if the designated constraints aren't met.
+type ListStoreReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreReviewsReplyValidationError) ErrorName() string {
+	return "ListStoreReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreReviewsReplyValidationError{}
+
+// Validate checks the field values on CreateReviewExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewExportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewExportRequestMultiError, or nil if none found.
+func (m *CreateReviewExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewExportRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := CreateReviewExportRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv xlsx]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Unreplied
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewExportRequest_HasMedia_InLookup[m.GetHasMedia()]; !ok {
+		err := CreateReviewExportRequestValidationError{
+			field:  "HasMedia",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateReviewExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewExportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewExportRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateReviewExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewExportRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewExportRequestValidationError is the validation error returned by
+// CreateReviewExportRequest.Validate if the designated constraints aren't met.
+type CreateReviewExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewExportRequestValidationError) ErrorName() string {
+	return "CreateReviewExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewExportRequestValidationError{}
+
+var _CreateReviewExportRequest_Format_InLookup = map[string]struct{}{
+	"csv":  {},
+	"xlsx": {},
+}
+
+var _CreateReviewExportRequest_HasMedia_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on CreateReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewExportReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewExportReplyMultiError, or nil if none found.
+func (m *CreateReviewExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExportID
+
+	if len(errors) > 0 {
+		return CreateReviewExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewExportReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewExportReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewExportReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewExportReplyMultiError) AllErrors() []error { return m }
+
+// CreateReviewExportReplyValidationError is the validation error returned by
+// CreateReviewExportReply.Validate if the designated constraints aren't met.
+type CreateReviewExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewExportReplyValidationError) ErrorName() string {
+	return "CreateReviewExportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewExportReplyValidationError{}
+
+// Validate checks the field values on ReviewExportInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewExportInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewExportInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewExportInfoMultiError, or nil if none found.
+func (m *ReviewExportInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewExportInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExportID
+
+	// no validation rules for Format
+
+	// no validation rules for Status
+
+	// no validation rules for RowCount
+
+	// no validation rules for FileSize
+
+	// no validation rules for FileName
+
+	// no validation rules for Error
+
+	// no validation rules for DownloadURL
+
+	// no validation rules for CreateAt
+
+	// no validation rules for FinishAt
+
+	// no validation rules for ExpireAt
+
+	if len(errors) > 0 {
+		return ReviewExportInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewExportInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewExportInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewExportInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewExportInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewExportInfoMultiError) AllErrors() []error { return m }
+
+// ReviewExportInfoValidationError is the validation error returned by
+// ReviewExportInfo.Validate if the designated constraints aren't met.
+type ReviewExportInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewExportInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewExportInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewExportInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewExportInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewExportInfoValidationError) ErrorName() string { return "ReviewExportInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewExportInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewExportInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewExportInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewExportInfoValidationError{}
+
+// Validate checks the field values on GetReviewExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewExportRequestMultiError, or nil if none found.
+func (m *GetReviewExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := GetReviewExportRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExportID() <= 0 {
+		err := GetReviewExportRequestValidationError{
+			field:  "ExportID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReviewExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewExportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReviewExportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReviewExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewExportRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewExportRequestValidationError is the validation error returned by
+// GetReviewExportRequest.Validate if the designated constraints aren't met.
+type GetReviewExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewExportRequestValidationError) ErrorName() string {
+	return "GetReviewExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewExportRequestValidationError{}
+
+// Validate checks the field values on GetReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewExportReplyMultiError, or nil if none found.
+func (m *GetReviewExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewExportReplyValidationError{
+				field:  "Export",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewExportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetReviewExportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetReviewExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewExportReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewExportReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewExportReplyValidationError is the validation error returned by
+// GetReviewExportReply.Validate if the designated constraints aren't met.
+type GetReviewExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewExportReplyValidationError) ErrorName() string {
+	return "GetReviewExportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewExportReplyValidationError{}
+
+// Validate checks the field values on ListReviewExportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewExportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewExportsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewExportsRequestMultiError, or nil if none found.
+func (m *ListReviewExportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewExportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListReviewExportsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListReviewExportsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListReviewExportsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReviewExportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewExportsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReviewExportsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewExportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewExportsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewExportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewExportsRequestValidationError is the validation error returned by
+// ListReviewExportsRequest.Validate if the designated constraints aren't met.
+type ListReviewExportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewExportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewExportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewExportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewExportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewExportsRequestValidationError) ErrorName() string {
+	return "ListReviewExportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewExportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewExportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewExportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewExportsRequestValidationError{}
+
+// Validate checks the field values on ListReviewExportsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewExportsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewExportsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewExportsReplyMultiError, or nil if none found.
+func (m *ListReviewExportsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewExportsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewExportsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewExportsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewExportsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListReviewExportsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewExportsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewExportsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewExportsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewExportsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewExportsReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewExportsReplyValidationError is the validation error returned by
+// ListReviewExportsReply.Validate if the designated constraints aren't met.
+type ListReviewExportsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewExportsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewExportsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewExportsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewExportsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewExportsReplyValidationError) ErrorName() string {
+	return "ListReviewExportsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewExportsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewExportsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewExportsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewExportsReplyValidationError{}
+
+// Validate checks the field values on ListStoreAppealsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreAppealsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreAppealsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreAppealsRequestMultiError, or nil if none found.
+func (m *ListStoreAppealsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreAppealsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListStoreAppealsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [0 10 20 30 40 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStoreAppealsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreAppealsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStoreAppealsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreAppealsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreAppealsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreAppealsRequestMultiError) AllErrors() []error { return m }
+
+// ListStoreAppealsRequestValidationError is the validation error returned by
+// ListStoreAppealsRequest.Validate if the designated constraints aren't met.
+type ListStoreAppealsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreAppealsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreAppealsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreAppealsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreAppealsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreAppealsRequestValidationError) ErrorName() string {
+	return "ListStoreAppealsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreAppealsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreAppealsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreAppealsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreAppealsRequestValidationError{}
+
+var _ListStoreAppealsRequest_Status_InLookup = map[int32]struct{}{
+	0:  {},
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+	50: {},
+}
+
+// Validate checks the field values on AppealInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppealInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppealInfoMultiError, or
+// nil if none found.
+func (m *AppealInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for CreateAt
+
+	// no validation rules for UpdateAt
+
+	// no validation rules for Round
+
+	if len(errors) > 0 {
+		return AppealInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealInfoMultiError is an error wrapping multiple validation errors
+// returned by AppealInfo.ValidateAll() if the designated constraints aren't met.
+type AppealInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealInfoMultiError) AllErrors() []error { return m }
+
+// AppealInfoValidationError is the validation error returned by
+// AppealInfo.Validate if the designated constraints aren't met.
+type AppealInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealInfoValidationError) ErrorName() string { return "AppealInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppealInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealInfoValidationError{}
+
+// Validate checks the field values on ListStoreAppealsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreAppealsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreAppealsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreAppealsReplyMultiError, or nil if none found.
+func (m *ListStoreAppealsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreAppealsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStoreAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStoreAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoreAppealsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListStoreAppealsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreAppealsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListStoreAppealsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreAppealsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreAppealsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreAppealsReplyMultiError) AllErrors() []error { return m }
+
+// ListStoreAppealsReplyValidationError is the validation error returned by
+// ListStoreAppealsReply.Validate if the designated constraints aren't met.
+type ListStoreAppealsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreAppealsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreAppealsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreAppealsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreAppealsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreAppealsReplyValidationError) ErrorName() string {
+	return "ListStoreAppealsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreAppealsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreAppealsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreAppealsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreAppealsReplyValidationError{}
+
+// Validate checks the field values on GetAppealRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealRequestMultiError, or nil if none found.
+func (m *GetAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := GetAppealRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAppealID() <= 0 {
+		err := GetAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAppealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealRequestMultiError) AllErrors() []error { return m }
+
+// GetAppealRequestValidationError is the validation error returned by
+// GetAppealRequest.Validate if the designated constraints aren't met.
+type GetAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealRequestValidationError) ErrorName() string { return "GetAppealRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealRequestValidationError{}
+
+// Validate checks the field values on GetAppealReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetAppealReplyMultiError,
+// or nil if none found.
+func (m *GetAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAppeal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAppealReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAppealReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppeal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAppealReplyValidationError{
+				field:  "Appeal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAppealReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealReplyMultiError) AllErrors() []error { return m }
+
+// GetAppealReplyValidationError is the validation error returned by
+// GetAppealReply.Validate if the designated constraints aren't met.
+type GetAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealReplyValidationError) ErrorName() string { return "GetAppealReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealReplyValidationError{}
+
+// Validate checks the field values on SupplementAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SupplementAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SupplementAppealRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SupplementAppealRequestMultiError, or nil if none found.
+func (m *SupplementAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupplementAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := SupplementAppealRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAppealID() <= 0 {
+		err := SupplementAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := SupplementAppealRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(errors) > 0 {
+		return SupplementAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SupplementAppealRequestMultiError is an error wrapping multiple validation
+// errors returned by SupplementAppealRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SupplementAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SupplementAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SupplementAppealRequestMultiError) AllErrors() []error { return m }
+
+// SupplementAppealRequestValidationError is the validation error returned by
+// SupplementAppealRequest.Validate if the designated constraints aren't met.
+type SupplementAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SupplementAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SupplementAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SupplementAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SupplementAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SupplementAppealRequestValidationError) ErrorName() string {
+	return "SupplementAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SupplementAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupplementAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupplementAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupplementAppealRequestValidationError{}
+
+// Validate checks the field values on SupplementAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SupplementAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SupplementAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SupplementAppealReplyMultiError, or nil if none found.
+func (m *SupplementAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupplementAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SupplementAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SupplementAppealReplyMultiError is an error wrapping multiple validation
+// errors returned by SupplementAppealReply.ValidateAll() if the designated
+// constraints aren't met.
+type SupplementAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SupplementAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SupplementAppealReplyMultiError) AllErrors() []error { return m }
+
+// SupplementAppealReplyValidationError is the validation error returned by
+// SupplementAppealReply.Validate if the designated constraints aren't met.
+type SupplementAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SupplementAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SupplementAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SupplementAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SupplementAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SupplementAppealReplyValidationError) ErrorName() string {
+	return "SupplementAppealReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SupplementAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupplementAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupplementAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupplementAppealReplyValidationError{}
+
+// Validate checks the field values on WithdrawAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAppealRequestMultiError, or nil if none found.
+func (m *WithdrawAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := WithdrawAppealRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAppealID() <= 0 {
+		err := WithdrawAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := WithdrawAppealRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WithdrawAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAppealRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawAppealRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAppealRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawAppealRequestValidationError is the validation error returned by
+// WithdrawAppealRequest.Validate if the designated constraints aren't met.
+type WithdrawAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAppealRequestValidationError) ErrorName() string {
+	return "WithdrawAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAppealRequestValidationError{}
+
+// Validate checks the field values on WithdrawAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAppealReplyMultiError, or nil if none found.
+func (m *WithdrawAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WithdrawAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAppealReplyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawAppealReply.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAppealReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawAppealReplyValidationError is the validation error returned by
+// WithdrawAppealReply.Validate if the designated constraints aren't met.
+type WithdrawAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAppealReplyValidationError) ErrorName() string {
+	return "WithdrawAppealReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAppealReplyValidationError{}
+
+// Validate checks the field values on GetAppealHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealHistoryRequestMultiError, or nil if none found.
+func (m *GetAppealHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := GetAppealHistoryRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReviewID() <= 0 {
+		err := GetAppealHistoryRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAppealHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAppealHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppealHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetAppealHistoryRequestValidationError is the validation error returned by
+// GetAppealHistoryRequest.Validate if the designated constraints aren't met.
+type GetAppealHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealHistoryRequestValidationError) ErrorName() string {
+	return "GetAppealHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealHistoryRequestValidationError{}
+
+// Validate checks the field values on AppealMessageInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppealMessageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealMessageInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealMessageInfoMultiError, or nil if none found.
+func (m *AppealMessageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealMessageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageID
+
+	// no validation rules for AppealID
+
+	// no validation rules for SenderType
+
+	// no validation rules for Sender
+
+	// no validation rules for Action
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return AppealMessageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealMessageInfoMultiError is an error wrapping multiple validation errors
+// returned by AppealMessageInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AppealMessageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealMessageInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealMessageInfoMultiError) AllErrors() []error { return m }
+
+// AppealMessageInfoValidationError is the validation error returned by
+// AppealMessageInfo.Validate if the designated constraints aren't met.
+type AppealMessageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealMessageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealMessageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealMessageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealMessageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealMessageInfoValidationError) ErrorName() string {
+	return "AppealMessageInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealMessageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealMessageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealMessageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealMessageInfoValidationError{}
+
+// Validate checks the field values on GetAppealHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealHistoryReplyMultiError, or nil if none found.
+func (m *GetAppealHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAppeals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Appeals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Appeals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppealHistoryReplyValidationError{
+					field:  fmt.Sprintf("Appeals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppealHistoryReplyValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAppealHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAppealHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppealHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealHistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealHistoryReplyMultiError) AllErrors() []error { return m }
+
+// GetAppealHistoryReplyValidationError is the validation error returned by
+// GetAppealHistoryReply.Validate if the designated constraints aren't met.
+type GetAppealHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealHistoryReplyValidationError) ErrorName() string {
+	return "GetAppealHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealHistoryReplyValidationError{}
+
+// Validate checks the field values on ReplyTemplateInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplyTemplateInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyTemplateInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyTemplateInfoMultiError, or nil if none found.
+func (m *ReplyTemplateInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyTemplateInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateID
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	// no validation rules for UpdateAt
+
+	if len(errors) > 0 {
+		return ReplyTemplateInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyTemplateInfoMultiError is an error wrapping multiple validation errors
+// returned by ReplyTemplateInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyTemplateInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyTemplateInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyTemplateInfoMultiError) AllErrors() []error { return m }
+
+// ReplyTemplateInfoValidationError is the validation error returned by
+// ReplyTemplateInfo.Validate if the designated constraints aren't met.
+type ReplyTemplateInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyTemplateInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyTemplateInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyTemplateInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyTemplateInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyTemplateInfoValidationError) ErrorName() string {
+	return "ReplyTemplateInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyTemplateInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyTemplateInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyTemplateInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyTemplateInfoValidationError{}
+
+// Validate checks the field values on CreateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReplyTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReplyTemplateRequestMultiError, or nil if none found.
+func (m *CreateReplyTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReplyTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CreateReplyTemplateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := CreateReplyTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := CreateReplyTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateReplyTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReplyTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateReplyTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateReplyTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReplyTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReplyTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateReplyTemplateRequestValidationError is the validation error returned
+// by CreateReplyTemplateRequest.Validate if the designated constraints aren't met.
+type CreateReplyTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReplyTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReplyTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReplyTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReplyTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReplyTemplateRequestValidationError) ErrorName() string {
+	return "CreateReplyTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReplyTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReplyTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReplyTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReplyTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateReplyTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReplyTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReplyTemplateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReplyTemplateReplyMultiError, or nil if none found.
+func (m *CreateReplyTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReplyTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateID
+
+	if len(errors) > 0 {
+		return CreateReplyTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReplyTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateReplyTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReplyTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReplyTemplateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReplyTemplateReplyMultiError) AllErrors() []error { return m }
+
+// CreateReplyTemplateReplyValidationError is the validation error returned by
+// CreateReplyTemplateReply.Validate if the designated constraints aren't met.
+type CreateReplyTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReplyTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReplyTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReplyTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReplyTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReplyTemplateReplyValidationError) ErrorName() string {
+	return "CreateReplyTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReplyTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReplyTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReplyTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReplyTemplateReplyValidationError{}
+
+// Validate checks the field values on UpdateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReplyTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReplyTemplateRequestMultiError, or nil if none found.
+func (m *UpdateReplyTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReplyTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTemplateID() <= 0 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateReplyTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReplyTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateReplyTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateReplyTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReplyTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReplyTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReplyTemplateRequestValidationError is the validation error returned
+// by UpdateReplyTemplateRequest.Validate if the designated constraints aren't met.
+type UpdateReplyTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReplyTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReplyTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReplyTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReplyTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReplyTemplateRequestValidationError) ErrorName() string {
+	return "UpdateReplyTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReplyTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReplyTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReplyTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReplyTemplateRequestValidationError{}
+
+// Validate checks the field values on UpdateReplyTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReplyTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReplyTemplateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReplyTemplateReplyMultiError, or nil if none found.
+func (m *UpdateReplyTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReplyTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateReplyTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReplyTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateReplyTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReplyTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReplyTemplateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReplyTemplateReplyMultiError) AllErrors() []error { return m }
+
+// UpdateReplyTemplateReplyValidationError is the validation error returned by
+// UpdateReplyTemplateReply.Validate if the designated constraints aren't met.
+type UpdateReplyTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReplyTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReplyTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReplyTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReplyTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReplyTemplateReplyValidationError) ErrorName() string {
+	return "UpdateReplyTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReplyTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReplyTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReplyTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReplyTemplateReplyValidationError{}
+
+// Validate checks the field values on DeleteReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReplyTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReplyTemplateRequestMultiError, or nil if none found.
+func (m *DeleteReplyTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReplyTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DeleteReplyTemplateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTemplateID() <= 0 {
+		err := DeleteReplyTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteReplyTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReplyTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteReplyTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteReplyTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReplyTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReplyTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReplyTemplateRequestValidationError is the validation error returned
+// by DeleteReplyTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteReplyTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReplyTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReplyTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReplyTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReplyTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReplyTemplateRequestValidationError) ErrorName() string {
+	return "DeleteReplyTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReplyTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReplyTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReplyTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReplyTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteReplyTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReplyTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReplyTemplateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReplyTemplateReplyMultiError, or nil if none found.
+func (m *DeleteReplyTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReplyTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteReplyTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReplyTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteReplyTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReplyTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReplyTemplateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReplyTemplateReplyMultiError) AllErrors() []error { return m }
+
+// DeleteReplyTemplateReplyValidationError is the validation error returned by
+// DeleteReplyTemplateReply.Validate if the designated constraints aren't met.
+type DeleteReplyTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReplyTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReplyTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReplyTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReplyTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReplyTemplateReplyValidationError) ErrorName() string {
+	return "DeleteReplyTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReplyTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReplyTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReplyTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReplyTemplateReplyValidationError{}
+
+// Validate checks the field values on ListReplyTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReplyTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReplyTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReplyTemplatesRequestMultiError, or nil if none found.
+func (m *ListReplyTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReplyTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListReplyTemplatesRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReplyTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReplyTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReplyTemplatesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListReplyTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReplyTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReplyTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListReplyTemplatesRequestValidationError is the validation error returned by
+// ListReplyTemplatesRequest.Validate if the designated constraints aren't met.
+type ListReplyTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReplyTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReplyTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReplyTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReplyTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReplyTemplatesRequestValidationError) ErrorName() string {
+	return "ListReplyTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReplyTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReplyTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReplyTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReplyTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListReplyTemplatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReplyTemplatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReplyTemplatesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReplyTemplatesReplyMultiError, or nil if none found.
+func (m *ListReplyTemplatesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReplyTemplatesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReplyTemplatesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReplyTemplatesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReplyTemplatesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReplyTemplatesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReplyTemplatesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReplyTemplatesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReplyTemplatesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReplyTemplatesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReplyTemplatesReplyMultiError) AllErrors() []error { return m }
+
+// ListReplyTemplatesReplyValidationError is the validation error returned by
+// ListReplyTemplatesReply.Validate if the designated constraints aren't met.
+type ListReplyTemplatesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReplyTemplatesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReplyTemplatesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReplyTemplatesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReplyTemplatesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReplyTemplatesReplyValidationError) ErrorName() string {
+	return "ListReplyTemplatesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReplyTemplatesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReplyTemplatesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReplyTemplatesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReplyTemplatesReplyValidationError{}
+
+// Validate checks the field values on AutoReplyRuleInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AutoReplyRuleInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AutoReplyRuleInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AutoReplyRuleInfoMultiError, or nil if none found.
+func (m *AutoReplyRuleInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AutoReplyRuleInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleID
+
+	// no validation rules for TemplateID
+
+	// no validation rules for MinScore
+
+	// no validation rules for MaxScore
+
+	// no validation rules for OnlyEmpty
+
+	// no validation rules for DelaySeconds
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return AutoReplyRuleInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AutoReplyRuleInfoMultiError is an error wrapping multiple validation errors
+// returned by AutoReplyRuleInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AutoReplyRuleInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AutoReplyRuleInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AutoReplyRuleInfoMultiError) AllErrors() []error { return m }
+
+// AutoReplyRuleInfoValidationError is the validation error returned by
+// AutoReplyRuleInfo.Validate if the designated constraints aren't met.
+type AutoReplyRuleInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutoReplyRuleInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutoReplyRuleInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutoReplyRuleInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutoReplyRuleInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutoReplyRuleInfoValidationError) ErrorName() string {
+	return "AutoReplyRuleInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutoReplyRuleInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutoReplyRuleInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutoReplyRuleInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutoReplyRuleInfoValidationError{}
+
+// Validate checks the field values on CreateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAutoReplyRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAutoReplyRuleRequestMultiError, or nil if none found.
+func (m *CreateAutoReplyRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAutoReplyRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTemplateID() <= 0 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinScore(); val < 1 || val > 5 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxScore(); val < 1 || val > 5 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OnlyEmpty
+
+	if m.GetDelaySeconds() < 0 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "DelaySeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return CreateAutoReplyRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAutoReplyRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateAutoReplyRuleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAutoReplyRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAutoReplyRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAutoReplyRuleRequestMultiError) AllErrors() []error { return m }
+
+// CreateAutoReplyRuleRequestValidationError is the validation error returned
+// by CreateAutoReplyRuleRequest.Validate if the designated constraints aren't met.
+type CreateAutoReplyRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAutoReplyRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAutoReplyRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAutoReplyRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAutoReplyRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAutoReplyRuleRequestValidationError) ErrorName() string {
+	return "CreateAutoReplyRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAutoReplyRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAutoReplyRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAutoReplyRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAutoReplyRuleRequestValidationError{}
+
+// Validate checks the field values on CreateAutoReplyRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAutoReplyRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAutoReplyRuleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAutoReplyRuleReplyMultiError, or nil if none found.
+func (m *CreateAutoReplyRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAutoReplyRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleID
+
+	if len(errors) > 0 {
+		return CreateAutoReplyRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAutoReplyRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateAutoReplyRuleReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAutoReplyRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAutoReplyRuleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAutoReplyRuleReplyMultiError) AllErrors() []error { return m }
+
+// CreateAutoReplyRuleReplyValidationError is the validation error returned by
+// CreateAutoReplyRuleReply.Validate if the designated constraints aren't met.
+type CreateAutoReplyRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAutoReplyRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAutoReplyRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAutoReplyRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAutoReplyRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAutoReplyRuleReplyValidationError) ErrorName() string {
+	return "CreateAutoReplyRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAutoReplyRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAutoReplyRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAutoReplyRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAutoReplyRuleReplyValidationError{}
+
+// Validate checks the field values on UpdateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAutoReplyRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAutoReplyRuleRequestMultiError, or nil if none found.
+func (m *UpdateAutoReplyRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAutoReplyRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRuleID() <= 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "RuleID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTemplateID() <= 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinScore(); val < 1 || val > 5 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxScore(); val < 1 || val > 5 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OnlyEmpty
+
+	if m.GetDelaySeconds() < 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "DelaySeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return UpdateAutoReplyRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAutoReplyRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateAutoReplyRuleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateAutoReplyRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAutoReplyRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAutoReplyRuleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAutoReplyRuleRequestValidationError is the validation error returned
+// by UpdateAutoReplyRuleRequest.Validate if the designated constraints aren't met.
+type UpdateAutoReplyRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAutoReplyRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAutoReplyRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAutoReplyRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAutoReplyRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAutoReplyRuleRequestValidationError) ErrorName() string {
+	return "UpdateAutoReplyRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAutoReplyRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAutoReplyRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAutoReplyRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAutoReplyRuleRequestValidationError{}
+
+// Validate checks the field values on UpdateAutoReplyRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAutoReplyRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAutoReplyRuleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAutoReplyRuleReplyMultiError, or nil if none found.
+func (m *UpdateAutoReplyRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAutoReplyRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateAutoReplyRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAutoReplyRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateAutoReplyRuleReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAutoReplyRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAutoReplyRuleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAutoReplyRuleReplyMultiError) AllErrors() []error { return m }
+
+// UpdateAutoReplyRuleReplyValidationError is the validation error returned by
+// UpdateAutoReplyRuleReply.Validate if the designated constraints aren't met.
+type UpdateAutoReplyRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAutoReplyRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAutoReplyRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAutoReplyRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAutoReplyRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAutoReplyRuleReplyValidationError) ErrorName() string {
+	return "UpdateAutoReplyRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAutoReplyRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAutoReplyRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAutoReplyRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAutoReplyRuleReplyValidationError{}
+
+// Validate checks the field values on DeleteAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAutoReplyRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAutoReplyRuleRequestMultiError, or nil if none found.
+func (m *DeleteAutoReplyRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAutoReplyRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DeleteAutoReplyRuleRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRuleID() <= 0 {
+		err := DeleteAutoReplyRuleRequestValidationError{
+			field:  "RuleID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteAutoReplyRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAutoReplyRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteAutoReplyRuleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteAutoReplyRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAutoReplyRuleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAutoReplyRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAutoReplyRuleRequestValidationError is the validation error returned
+// by DeleteAutoReplyRuleRequest.Validate if the designated constraints aren't met.
+type DeleteAutoReplyRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAutoReplyRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAutoReplyRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAutoReplyRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAutoReplyRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAutoReplyRuleRequestValidationError) ErrorName() string {
+	return "DeleteAutoReplyRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAutoReplyRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAutoReplyRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAutoReplyRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAutoReplyRuleRequestValidationError{}
+
+// Validate checks the field values on DeleteAutoReplyRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAutoReplyRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAutoReplyRuleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAutoReplyRuleReplyMultiError, or nil if none found.
+func (m *DeleteAutoReplyRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAutoReplyRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAutoReplyRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAutoReplyRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteAutoReplyRuleReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAutoReplyRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAutoReplyRuleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAutoReplyRuleReplyMultiError) AllErrors() []error { return m }
+
+// DeleteAutoReplyRuleReplyValidationError is the validation error returned by
+// DeleteAutoReplyRuleReply.Validate if the designated constraints aren't met.
+type DeleteAutoReplyRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAutoReplyRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAutoReplyRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAutoReplyRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAutoReplyRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAutoReplyRuleReplyValidationError) ErrorName() string {
+	return "DeleteAutoReplyRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAutoReplyRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAutoReplyRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAutoReplyRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAutoReplyRuleReplyValidationError{}
+
+// Validate checks the field values on ListAutoReplyRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAutoReplyRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAutoReplyRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAutoReplyRulesRequestMultiError, or nil if none found.
+func (m *ListAutoReplyRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAutoReplyRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListAutoReplyRulesRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAutoReplyRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAutoReplyRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAutoReplyRulesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListAutoReplyRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAutoReplyRulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAutoReplyRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListAutoReplyRulesRequestValidationError is the validation error returned by
+// ListAutoReplyRulesRequest.Validate if the designated constraints aren't met.
+type ListAutoReplyRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAutoReplyRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAutoReplyRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAutoReplyRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAutoReplyRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAutoReplyRulesRequestValidationError) ErrorName() string {
+	return "ListAutoReplyRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAutoReplyRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAutoReplyRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAutoReplyRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAutoReplyRulesRequestValidationError{}
+
+// Validate checks the field values on ListAutoReplyRulesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAutoReplyRulesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAutoReplyRulesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAutoReplyRulesReplyMultiError, or nil if none found.
+func (m *ListAutoReplyRulesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAutoReplyRulesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAutoReplyRulesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAutoReplyRulesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAutoReplyRulesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAutoReplyRulesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAutoReplyRulesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListAutoReplyRulesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListAutoReplyRulesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAutoReplyRulesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAutoReplyRulesReplyMultiError) AllErrors() []error { return m }
+
+// ListAutoReplyRulesReplyValidationError is the validation error returned by
+// ListAutoReplyRulesReply.Validate if the designated constraints aren't met.
+type ListAutoReplyRulesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAutoReplyRulesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAutoReplyRulesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAutoReplyRulesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAutoReplyRulesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAutoReplyRulesReplyValidationError) ErrorName() string {
+	return "ListAutoReplyRulesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAutoReplyRulesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAutoReplyRulesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAutoReplyRulesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAutoReplyRulesReplyValidationError{}
+
+// Validate checks the field values on WebhookInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookInfoMultiError, or
+// nil if none found.
+func (m *WebhookInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionID
+
+	// no validation rules for Url
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return WebhookInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookInfoMultiError is an error wrapping multiple validation errors
+// returned by WebhookInfo.ValidateAll() if the designated constraints aren't met.
+type WebhookInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookInfoMultiError) AllErrors() []error { return m }
+
+// WebhookInfoValidationError is the validation error returned by
+// WebhookInfo.Validate if the designated constraints aren't met.
+type WebhookInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookInfoValidationError) ErrorName() string { return "WebhookInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookInfoValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CreateWebhookRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 512 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEvents()) < 1 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Events",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateWebhookRequest_Events_Unique := make(map[string]struct{}, len(m.GetEvents()))
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if _, exists := _CreateWebhookRequest_Events_Unique[item]; exists {
+			err := CreateWebhookRequestValidationError{
+				field:  fmt.Sprintf("Events[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateWebhookRequest_Events_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Events[idx]
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookReplyMultiError, or nil if none found.
+func (m *CreateWebhookReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionID
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateWebhookReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateWebhookReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookReplyMultiError) AllErrors() []error { return m }
+
+// CreateWebhookReplyValidationError is the validation error returned by
+// CreateWebhookReply.Validate if the designated constraints aren't met.
+type CreateWebhookReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookReplyValidationError) ErrorName() string {
+	return "CreateWebhookReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookReplyValidationError{}
+
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DeleteWebhookRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubscriptionID() <= 0 {
+		err := DeleteWebhookRequestValidationError{
+			field:  "SubscriptionID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookReplyMultiError, or nil if none found.
+func (m *DeleteWebhookReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteWebhookReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteWebhookReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteWebhookReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookReplyMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookReplyValidationError is the validation error returned by
+// DeleteWebhookReply.Validate if the designated constraints aren't met.
+type DeleteWebhookReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookReplyValidationError) ErrorName() string {
+	return "DeleteWebhookReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookReplyValidationError{}
+
+// Validate checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListWebhooksRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksRequestValidationError{}
+
+// Validate checks the field values on ListWebhooksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksReplyMultiError, or nil if none found.
+func (m *ListWebhooksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhooksReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhooksReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhooksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListWebhooksReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListWebhooksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksReplyMultiError) AllErrors() []error { return m }
+
+// ListWebhooksReplyValidationError is the validation error returned by
+// ListWebhooksReply.Validate if the designated constraints aren't met.
+type ListWebhooksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksReplyValidationError) ErrorName() string {
+	return "ListWebhooksReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksReplyValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubscriptionID() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "SubscriptionID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListWebhookDeliveriesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [0 10 20 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesRequestValidationError is the validation error returned
+// by ListWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesRequestValidationError{}
+
+var _ListWebhookDeliveriesRequest_Status_InLookup = map[int32]struct{}{
+	0:  {},
+	10: {},
+	20: {},
+	30: {},
+}
+
+// Validate checks the field values on WebhookDeliveryInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDeliveryInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDeliveryInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryInfoMultiError, or nil if none found.
+func (m *WebhookDeliveryInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDeliveryInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeliveryID
+
+	// no validation rules for Event
+
+	// no validation rules for Payload
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for ResponseCode
+
+	// no validation rules for LastError
+
+	// no validation rules for NextRetryAt
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return WebhookDeliveryInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryInfoMultiError is an error wrapping multiple validation
+// errors returned by WebhookDeliveryInfo.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookDeliveryInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryInfoMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryInfoValidationError is the validation error returned by
+// WebhookDeliveryInfo.Validate if the designated constraints aren't met.
+type WebhookDeliveryInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryInfoValidationError) ErrorName() string {
+	return "WebhookDeliveryInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDeliveryInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryInfoValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesReplyMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesReplyMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesReplyMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesReplyValidationError is the validation error returned
+// by ListWebhookDeliveriesReply.Validate if the designated constraints aren't met.
+type ListWebhookDeliveriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesReplyValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesReplyValidationError{}
@@ -19,9 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Business_ReplyReview_FullMethodName       = "/api.business.v1.Business/ReplyReview"
-	Business_AppealReview_FullMethodName      = "/api.business.v1.Business/AppealReview"
-	Business_ReplyReviewUpdate_FullMethodName = "/api.business.v1.Business/ReplyReviewUpdate"
+	Business_ReplyReview_FullMethodName           = "/api.business.v1.Business/ReplyReview"
+	Business_AppealReview_FullMethodName          = "/api.business.v1.Business/AppealReview"
+	Business_ReplyReviewUpdate_FullMethodName     = "/api.business.v1.Business/ReplyReviewUpdate"
+	Business_ListStoreReviews_FullMethodName      = "/api.business.v1.Business/ListStoreReviews"
+	Business_CreateReviewExport_FullMethodName    = "/api.business.v1.Business/CreateReviewExport"
+	Business_GetReviewExport_FullMethodName       = "/api.business.v1.Business/GetReviewExport"
+	Business_ListReviewExports_FullMethodName     = "/api.business.v1.Business/ListReviewExports"
+	Business_ListStoreAppeals_FullMethodName      = "/api.business.v1.Business/ListStoreAppeals"
+	Business_GetAppeal_FullMethodName             = "/api.business.v1.Business/GetAppeal"
+	Business_SupplementAppeal_FullMethodName      = "/api.business.v1.Business/SupplementAppeal"
+	Business_WithdrawAppeal_FullMethodName        = "/api.business.v1.Business/WithdrawAppeal"
+	Business_GetAppealHistory_FullMethodName      = "/api.business.v1.Business/GetAppealHistory"
+	Business_CreateReplyTemplate_FullMethodName   = "/api.business.v1.Business/CreateReplyTemplate"
+	Business_UpdateReplyTemplate_FullMethodName   = "/api.business.v1.Business/UpdateReplyTemplate"
+	Business_DeleteReplyTemplate_FullMethodName   = "/api.business.v1.Business/DeleteReplyTemplate"
+	Business_ListReplyTemplates_FullMethodName    = "/api.business.v1.Business/ListReplyTemplates"
+	Business_CreateAutoReplyRule_FullMethodName   = "/api.business.v1.Business/CreateAutoReplyRule"
+	Business_UpdateAutoReplyRule_FullMethodName   = "/api.business.v1.Business/UpdateAutoReplyRule"
+	Business_DeleteAutoReplyRule_FullMethodName   = "/api.business.v1.Business/DeleteAutoReplyRule"
+	Business_ListAutoReplyRules_FullMethodName    = "/api.business.v1.Business/ListAutoReplyRules"
+	Business_CreateWebhook_FullMethodName         = "/api.business.v1.Business/CreateWebhook"
+	Business_DeleteWebhook_FullMethodName         = "/api.business.v1.Business/DeleteWebhook"
+	Business_ListWebhooks_FullMethodName          = "/api.business.v1.Business/ListWebhooks"
+	Business_ListWebhookDeliveries_FullMethodName = "/api.business.v1.Business/ListWebhookDeliveries"
 )
 
 // BusinessClient is the client API for Business service.
@@ -34,6 +55,38 @@ type BusinessClient interface {
 	AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...grpc.CallOption) (*AppealReviewReply, error)
 	// B端修改回复
 	ReplyReviewUpdate(ctx context.Context, in *ReplyReviewUpdateRequest, opts ...grpc.CallOption) (*ReplyReviewUpdateReply, error)
+	// B端查询本店的评价
+	ListStoreReviews(ctx context.Context, in *ListStoreReviewsRequest, opts ...grpc.CallOption) (*ListStoreReviewsReply, error)
+	// B端按ListStoreReviews的条件异步导出本店评价，文件生成后通过GetReviewExport查看进度和下载
+	CreateReviewExport(ctx context.Context, in *CreateReviewExportRequest, opts ...grpc.CallOption) (*CreateReviewExportReply, error)
+	GetReviewExport(ctx context.Context, in *GetReviewExportRequest, opts ...grpc.CallOption) (*GetReviewExportReply, error)
+	ListReviewExports(ctx context.Context, in *ListReviewExportsRequest, opts ...grpc.CallOption) (*ListReviewExportsReply, error)
+	// B端查询本店的申诉
+	ListStoreAppeals(ctx context.Context, in *ListStoreAppealsRequest, opts ...grpc.CallOption) (*ListStoreAppealsReply, error)
+	// B端查询申诉详情
+	GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...grpc.CallOption) (*GetAppealReply, error)
+	// B端按运营要求补充申诉材料
+	SupplementAppeal(ctx context.Context, in *SupplementAppealRequest, opts ...grpc.CallOption) (*SupplementAppealReply, error)
+	// B端撤回处理中的申诉
+	WithdrawAppeal(ctx context.Context, in *WithdrawAppealRequest, opts ...grpc.CallOption) (*WithdrawAppealReply, error)
+	// B端查询评价的历次申诉和沟通记录
+	GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...grpc.CallOption) (*GetAppealHistoryReply, error)
+	// B端回复模板
+	CreateReplyTemplate(ctx context.Context, in *CreateReplyTemplateRequest, opts ...grpc.CallOption) (*CreateReplyTemplateReply, error)
+	UpdateReplyTemplate(ctx context.Context, in *UpdateReplyTemplateRequest, opts ...grpc.CallOption) (*UpdateReplyTemplateReply, error)
+	DeleteReplyTemplate(ctx context.Context, in *DeleteReplyTemplateRequest, opts ...grpc.CallOption) (*DeleteReplyTemplateReply, error)
+	ListReplyTemplates(ctx context.Context, in *ListReplyTemplatesRequest, opts ...grpc.CallOption) (*ListReplyTemplatesReply, error)
+	// B端自动回复规则
+	CreateAutoReplyRule(ctx context.Context, in *CreateAutoReplyRuleRequest, opts ...grpc.CallOption) (*CreateAutoReplyRuleReply, error)
+	UpdateAutoReplyRule(ctx context.Context, in *UpdateAutoReplyRuleRequest, opts ...grpc.CallOption) (*UpdateAutoReplyRuleReply, error)
+	DeleteAutoReplyRule(ctx context.Context, in *DeleteAutoReplyRuleRequest, opts ...grpc.CallOption) (*DeleteAutoReplyRuleReply, error)
+	ListAutoReplyRules(ctx context.Context, in *ListAutoReplyRulesRequest, opts ...grpc.CallOption) (*ListAutoReplyRulesReply, error)
+	// B端webhook订阅,本店收到差评、申诉结果等事件时回调
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookReply, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error)
+	// B端查询webhook投递记录
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesReply, error)
 }
 
 type businessClient struct {
@@ -71,6 +124,195 @@ func (c *businessClient) ReplyReviewUpdate(ctx context.Context, in *ReplyReviewU
 	return out, nil
 }
 
+func (c *businessClient) ListStoreReviews(ctx context.Context, in *ListStoreReviewsRequest, opts ...grpc.CallOption) (*ListStoreReviewsReply, error) {
+	out := new(ListStoreReviewsReply)
+	err := c.cc.Invoke(ctx, Business_ListStoreReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) CreateReviewExport(ctx context.Context, in *CreateReviewExportRequest, opts ...grpc.CallOption) (*CreateReviewExportReply, error) {
+	out := new(CreateReviewExportReply)
+	err := c.cc.Invoke(ctx, Business_CreateReviewExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) GetReviewExport(ctx context.Context, in *GetReviewExportRequest, opts ...grpc.CallOption) (*GetReviewExportReply, error) {
+	out := new(GetReviewExportReply)
+	err := c.cc.Invoke(ctx, Business_GetReviewExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) ListReviewExports(ctx context.Context, in *ListReviewExportsRequest, opts ...grpc.CallOption) (*ListReviewExportsReply, error) {
+	out := new(ListReviewExportsReply)
+	err := c.cc.Invoke(ctx, Business_ListReviewExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) ListStoreAppeals(ctx context.Context, in *ListStoreAppealsRequest, opts ...grpc.CallOption) (*ListStoreAppealsReply, error) {
+	out := new(ListStoreAppealsReply)
+	err := c.cc.Invoke(ctx, Business_ListStoreAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...grpc.CallOption) (*GetAppealReply, error) {
+	out := new(GetAppealReply)
+	err := c.cc.Invoke(ctx, Business_GetAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) SupplementAppeal(ctx context.Context, in *SupplementAppealRequest, opts ...grpc.CallOption) (*SupplementAppealReply, error) {
+	out := new(SupplementAppealReply)
+	err := c.cc.Invoke(ctx, Business_SupplementAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) WithdrawAppeal(ctx context.Context, in *WithdrawAppealRequest, opts ...grpc.CallOption) (*WithdrawAppealReply, error) {
+	out := new(WithdrawAppealReply)
+	err := c.cc.Invoke(ctx, Business_WithdrawAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...grpc.CallOption) (*GetAppealHistoryReply, error) {
+	out := new(GetAppealHistoryReply)
+	err := c.cc.Invoke(ctx, Business_GetAppealHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) CreateReplyTemplate(ctx context.Context, in *CreateReplyTemplateRequest, opts ...grpc.CallOption) (*CreateReplyTemplateReply, error) {
+	out := new(CreateReplyTemplateReply)
+	err := c.cc.Invoke(ctx, Business_CreateReplyTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) UpdateReplyTemplate(ctx context.Context, in *UpdateReplyTemplateRequest, opts ...grpc.CallOption) (*UpdateReplyTemplateReply, error) {
+	out := new(UpdateReplyTemplateReply)
+	err := c.cc.Invoke(ctx, Business_UpdateReplyTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) DeleteReplyTemplate(ctx context.Context, in *DeleteReplyTemplateRequest, opts ...grpc.CallOption) (*DeleteReplyTemplateReply, error) {
+	out := new(DeleteReplyTemplateReply)
+	err := c.cc.Invoke(ctx, Business_DeleteReplyTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) ListReplyTemplates(ctx context.Context, in *ListReplyTemplatesRequest, opts ...grpc.CallOption) (*ListReplyTemplatesReply, error) {
+	out := new(ListReplyTemplatesReply)
+	err := c.cc.Invoke(ctx, Business_ListReplyTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) CreateAutoReplyRule(ctx context.Context, in *CreateAutoReplyRuleRequest, opts ...grpc.CallOption) (*CreateAutoReplyRuleReply, error) {
+	out := new(CreateAutoReplyRuleReply)
+	err := c.cc.Invoke(ctx, Business_CreateAutoReplyRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) UpdateAutoReplyRule(ctx context.Context, in *UpdateAutoReplyRuleRequest, opts ...grpc.CallOption) (*UpdateAutoReplyRuleReply, error) {
+	out := new(UpdateAutoReplyRuleReply)
+	err := c.cc.Invoke(ctx, Business_UpdateAutoReplyRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) DeleteAutoReplyRule(ctx context.Context, in *DeleteAutoReplyRuleRequest, opts ...grpc.CallOption) (*DeleteAutoReplyRuleReply, error) {
+	out := new(DeleteAutoReplyRuleReply)
+	err := c.cc.Invoke(ctx, Business_DeleteAutoReplyRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) ListAutoReplyRules(ctx context.Context, in *ListAutoReplyRulesRequest, opts ...grpc.CallOption) (*ListAutoReplyRulesReply, error) {
+	out := new(ListAutoReplyRulesReply)
+	err := c.cc.Invoke(ctx, Business_ListAutoReplyRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookReply, error) {
+	out := new(CreateWebhookReply)
+	err := c.cc.Invoke(ctx, Business_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error) {
+	out := new(DeleteWebhookReply)
+	err := c.cc.Invoke(ctx, Business_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error) {
+	out := new(ListWebhooksReply)
+	err := c.cc.Invoke(ctx, Business_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesReply, error) {
+	out := new(ListWebhookDeliveriesReply)
+	err := c.cc.Invoke(ctx, Business_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BusinessServer is the server API for Business service.
 // All implementations must embed UnimplementedBusinessServer
 // for forward compatibility
@@ -81,6 +323,38 @@ type BusinessServer interface {
 	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
 	// B端修改回复
 	ReplyReviewUpdate(context.Context, *ReplyReviewUpdateRequest) (*ReplyReviewUpdateReply, error)
+	// B端查询本店的评价
+	ListStoreReviews(context.Context, *ListStoreReviewsRequest) (*ListStoreReviewsReply, error)
+	// B端按ListStoreReviews的条件异步导出本店评价，文件生成后通过GetReviewExport查看进度和下载
+	CreateReviewExport(context.Context, *CreateReviewExportRequest) (*CreateReviewExportReply, error)
+	GetReviewExport(context.Context, *GetReviewExportRequest) (*GetReviewExportReply, error)
+	ListReviewExports(context.Context, *ListReviewExportsRequest) (*ListReviewExportsReply, error)
+	// B端查询本店的申诉
+	ListStoreAppeals(context.Context, *ListStoreAppealsRequest) (*ListStoreAppealsReply, error)
+	// B端查询申诉详情
+	GetAppeal(context.Context, *GetAppealRequest) (*GetAppealReply, error)
+	// B端按运营要求补充申诉材料
+	SupplementAppeal(context.Context, *SupplementAppealRequest) (*SupplementAppealReply, error)
+	// B端撤回处理中的申诉
+	WithdrawAppeal(context.Context, *WithdrawAppealRequest) (*WithdrawAppealReply, error)
+	// B端查询评价的历次申诉和沟通记录
+	GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error)
+	// B端回复模板
+	CreateReplyTemplate(context.Context, *CreateReplyTemplateRequest) (*CreateReplyTemplateReply, error)
+	UpdateReplyTemplate(context.Context, *UpdateReplyTemplateRequest) (*UpdateReplyTemplateReply, error)
+	DeleteReplyTemplate(context.Context, *DeleteReplyTemplateRequest) (*DeleteReplyTemplateReply, error)
+	ListReplyTemplates(context.Context, *ListReplyTemplatesRequest) (*ListReplyTemplatesReply, error)
+	// B端自动回复规则
+	CreateAutoReplyRule(context.Context, *CreateAutoReplyRuleRequest) (*CreateAutoReplyRuleReply, error)
+	UpdateAutoReplyRule(context.Context, *UpdateAutoReplyRuleRequest) (*UpdateAutoReplyRuleReply, error)
+	DeleteAutoReplyRule(context.Context, *DeleteAutoReplyRuleRequest) (*DeleteAutoReplyRuleReply, error)
+	ListAutoReplyRules(context.Context, *ListAutoReplyRulesRequest) (*ListAutoReplyRulesReply, error)
+	// B端webhook订阅,本店收到差评、申诉结果等事件时回调
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
+	// B端查询webhook投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesReply, error)
 	mustEmbedUnimplementedBusinessServer()
 }
 
@@ -97,6 +371,69 @@ func (UnimplementedBusinessServer) AppealReview(context.Context, *AppealReviewRe
 func (UnimplementedBusinessServer) ReplyReviewUpdate(context.Context, *ReplyReviewUpdateRequest) (*ReplyReviewUpdateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplyReviewUpdate not implemented")
 }
+func (UnimplementedBusinessServer) ListStoreReviews(context.Context, *ListStoreReviewsRequest) (*ListStoreReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreReviews not implemented")
+}
+func (UnimplementedBusinessServer) CreateReviewExport(context.Context, *CreateReviewExportRequest) (*CreateReviewExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewExport not implemented")
+}
+func (UnimplementedBusinessServer) GetReviewExport(context.Context, *GetReviewExportRequest) (*GetReviewExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewExport not implemented")
+}
+func (UnimplementedBusinessServer) ListReviewExports(context.Context, *ListReviewExportsRequest) (*ListReviewExportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewExports not implemented")
+}
+func (UnimplementedBusinessServer) ListStoreAppeals(context.Context, *ListStoreAppealsRequest) (*ListStoreAppealsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreAppeals not implemented")
+}
+func (UnimplementedBusinessServer) GetAppeal(context.Context, *GetAppealRequest) (*GetAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppeal not implemented")
+}
+func (UnimplementedBusinessServer) SupplementAppeal(context.Context, *SupplementAppealRequest) (*SupplementAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplementAppeal not implemented")
+}
+func (UnimplementedBusinessServer) WithdrawAppeal(context.Context, *WithdrawAppealRequest) (*WithdrawAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAppeal not implemented")
+}
+func (UnimplementedBusinessServer) GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppealHistory not implemented")
+}
+func (UnimplementedBusinessServer) CreateReplyTemplate(context.Context, *CreateReplyTemplateRequest) (*CreateReplyTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplyTemplate not implemented")
+}
+func (UnimplementedBusinessServer) UpdateReplyTemplate(context.Context, *UpdateReplyTemplateRequest) (*UpdateReplyTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplyTemplate not implemented")
+}
+func (UnimplementedBusinessServer) DeleteReplyTemplate(context.Context, *DeleteReplyTemplateRequest) (*DeleteReplyTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplyTemplate not implemented")
+}
+func (UnimplementedBusinessServer) ListReplyTemplates(context.Context, *ListReplyTemplatesRequest) (*ListReplyTemplatesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplyTemplates not implemented")
+}
+func (UnimplementedBusinessServer) CreateAutoReplyRule(context.Context, *CreateAutoReplyRuleRequest) (*CreateAutoReplyRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAutoReplyRule not implemented")
+}
+func (UnimplementedBusinessServer) UpdateAutoReplyRule(context.Context, *UpdateAutoReplyRuleRequest) (*UpdateAutoReplyRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoReplyRule not implemented")
+}
+func (UnimplementedBusinessServer) DeleteAutoReplyRule(context.Context, *DeleteAutoReplyRuleRequest) (*DeleteAutoReplyRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoReplyRule not implemented")
+}
+func (UnimplementedBusinessServer) ListAutoReplyRules(context.Context, *ListAutoReplyRulesRequest) (*ListAutoReplyRulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoReplyRules not implemented")
+}
+func (UnimplementedBusinessServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedBusinessServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedBusinessServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedBusinessServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
 func (UnimplementedBusinessServer) mustEmbedUnimplementedBusinessServer() {}
 
 // UnsafeBusinessServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +501,384 @@ func _Business_ReplyReviewUpdate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Business_ListStoreReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListStoreReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListStoreReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListStoreReviews(ctx, req.(*ListStoreReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_CreateReviewExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).CreateReviewExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_CreateReviewExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).CreateReviewExport(ctx, req.(*CreateReviewExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_GetReviewExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).GetReviewExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_GetReviewExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).GetReviewExport(ctx, req.(*GetReviewExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_ListReviewExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListReviewExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListReviewExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListReviewExports(ctx, req.(*ListReviewExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_ListStoreAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListStoreAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListStoreAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListStoreAppeals(ctx, req.(*ListStoreAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_GetAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).GetAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_GetAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).GetAppeal(ctx, req.(*GetAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_SupplementAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplementAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).SupplementAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_SupplementAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).SupplementAppeal(ctx, req.(*SupplementAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_WithdrawAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).WithdrawAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_WithdrawAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).WithdrawAppeal(ctx, req.(*WithdrawAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_GetAppealHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).GetAppealHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_GetAppealHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).GetAppealHistory(ctx, req.(*GetAppealHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_CreateReplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplyTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).CreateReplyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_CreateReplyTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).CreateReplyTemplate(ctx, req.(*CreateReplyTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_UpdateReplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplyTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).UpdateReplyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_UpdateReplyTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).UpdateReplyTemplate(ctx, req.(*UpdateReplyTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_DeleteReplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplyTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).DeleteReplyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_DeleteReplyTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).DeleteReplyTemplate(ctx, req.(*DeleteReplyTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_ListReplyTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplyTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListReplyTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListReplyTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListReplyTemplates(ctx, req.(*ListReplyTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_CreateAutoReplyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAutoReplyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).CreateAutoReplyRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_CreateAutoReplyRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).CreateAutoReplyRule(ctx, req.(*CreateAutoReplyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_UpdateAutoReplyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAutoReplyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).UpdateAutoReplyRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_UpdateAutoReplyRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).UpdateAutoReplyRule(ctx, req.(*UpdateAutoReplyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_DeleteAutoReplyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoReplyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).DeleteAutoReplyRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_DeleteAutoReplyRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).DeleteAutoReplyRule(ctx, req.(*DeleteAutoReplyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_ListAutoReplyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoReplyRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListAutoReplyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListAutoReplyRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListAutoReplyRules(ctx, req.(*ListAutoReplyRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Business_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Business_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Business_ServiceDesc is the grpc.ServiceDesc for Business service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +898,90 @@ var Business_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReplyReviewUpdate",
 			Handler:    _Business_ReplyReviewUpdate_Handler,
 		},
+		{
+			MethodName: "ListStoreReviews",
+			Handler:    _Business_ListStoreReviews_Handler,
+		},
+		{
+			MethodName: "CreateReviewExport",
+			Handler:    _Business_CreateReviewExport_Handler,
+		},
+		{
+			MethodName: "GetReviewExport",
+			Handler:    _Business_GetReviewExport_Handler,
+		},
+		{
+			MethodName: "ListReviewExports",
+			Handler:    _Business_ListReviewExports_Handler,
+		},
+		{
+			MethodName: "ListStoreAppeals",
+			Handler:    _Business_ListStoreAppeals_Handler,
+		},
+		{
+			MethodName: "GetAppeal",
+			Handler:    _Business_GetAppeal_Handler,
+		},
+		{
+			MethodName: "SupplementAppeal",
+			Handler:    _Business_SupplementAppeal_Handler,
+		},
+		{
+			MethodName: "WithdrawAppeal",
+			Handler:    _Business_WithdrawAppeal_Handler,
+		},
+		{
+			MethodName: "GetAppealHistory",
+			Handler:    _Business_GetAppealHistory_Handler,
+		},
+		{
+			MethodName: "CreateReplyTemplate",
+			Handler:    _Business_CreateReplyTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateReplyTemplate",
+			Handler:    _Business_UpdateReplyTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteReplyTemplate",
+			Handler:    _Business_DeleteReplyTemplate_Handler,
+		},
+		{
+			MethodName: "ListReplyTemplates",
+			Handler:    _Business_ListReplyTemplates_Handler,
+		},
+		{
+			MethodName: "CreateAutoReplyRule",
+			Handler:    _Business_CreateAutoReplyRule_Handler,
+		},
+		{
+			MethodName: "UpdateAutoReplyRule",
+			Handler:    _Business_UpdateAutoReplyRule_Handler,
+		},
+		{
+			MethodName: "DeleteAutoReplyRule",
+			Handler:    _Business_DeleteAutoReplyRule_Handler,
+		},
+		{
+			MethodName: "ListAutoReplyRules",
+			Handler:    _Business_ListAutoReplyRules_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Business_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Business_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Business_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Business_ListWebhookDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "business/v1/business.proto",
@@ -20,16 +20,69 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationBusinessAppealReview = "/api.business.v1.Business/AppealReview"
+const OperationBusinessCreateAutoReplyRule = "/api.business.v1.Business/CreateAutoReplyRule"
+const OperationBusinessCreateReplyTemplate = "/api.business.v1.Business/CreateReplyTemplate"
+const OperationBusinessCreateReviewExport = "/api.business.v1.Business/CreateReviewExport"
+const OperationBusinessCreateWebhook = "/api.business.v1.Business/CreateWebhook"
+const OperationBusinessDeleteAutoReplyRule = "/api.business.v1.Business/DeleteAutoReplyRule"
+const OperationBusinessDeleteReplyTemplate = "/api.business.v1.Business/DeleteReplyTemplate"
+const OperationBusinessDeleteWebhook = "/api.business.v1.Business/DeleteWebhook"
+const OperationBusinessGetAppeal = "/api.business.v1.Business/GetAppeal"
+const OperationBusinessGetAppealHistory = "/api.business.v1.Business/GetAppealHistory"
+const OperationBusinessGetReviewExport = "/api.business.v1.Business/GetReviewExport"
+const OperationBusinessListAutoReplyRules = "/api.business.v1.Business/ListAutoReplyRules"
+const OperationBusinessListReplyTemplates = "/api.business.v1.Business/ListReplyTemplates"
+const OperationBusinessListReviewExports = "/api.business.v1.Business/ListReviewExports"
+const OperationBusinessListStoreAppeals = "/api.business.v1.Business/ListStoreAppeals"
+const OperationBusinessListStoreReviews = "/api.business.v1.Business/ListStoreReviews"
+const OperationBusinessListWebhookDeliveries = "/api.business.v1.Business/ListWebhookDeliveries"
+const OperationBusinessListWebhooks = "/api.business.v1.Business/ListWebhooks"
 const OperationBusinessReplyReview = "/api.business.v1.Business/ReplyReview"
 const OperationBusinessReplyReviewUpdate = "/api.business.v1.Business/ReplyReviewUpdate"
+const OperationBusinessSupplementAppeal = "/api.business.v1.Business/SupplementAppeal"
+const OperationBusinessUpdateAutoReplyRule = "/api.business.v1.Business/UpdateAutoReplyRule"
+const OperationBusinessUpdateReplyTemplate = "/api.business.v1.Business/UpdateReplyTemplate"
+const OperationBusinessWithdrawAppeal = "/api.business.v1.Business/WithdrawAppeal"
 
 type BusinessHTTPServer interface {
 	// AppealReview商家申诉用户评价
 	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
+	// CreateAutoReplyRuleB端自动回复规则
+	CreateAutoReplyRule(context.Context, *CreateAutoReplyRuleRequest) (*CreateAutoReplyRuleReply, error)
+	// CreateReplyTemplateB端回复模板
+	CreateReplyTemplate(context.Context, *CreateReplyTemplateRequest) (*CreateReplyTemplateReply, error)
+	// CreateReviewExportB端按ListStoreReviews的条件异步导出本店评价，文件生成后通过GetReviewExport查看进度和下载
+	CreateReviewExport(context.Context, *CreateReviewExportRequest) (*CreateReviewExportReply, error)
+	// CreateWebhookB端webhook订阅,本店收到差评、申诉结果等事件时回调
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error)
+	DeleteAutoReplyRule(context.Context, *DeleteAutoReplyRuleRequest) (*DeleteAutoReplyRuleReply, error)
+	DeleteReplyTemplate(context.Context, *DeleteReplyTemplateRequest) (*DeleteReplyTemplateReply, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
+	// GetAppealB端查询申诉详情
+	GetAppeal(context.Context, *GetAppealRequest) (*GetAppealReply, error)
+	// GetAppealHistoryB端查询评价的历次申诉和沟通记录
+	GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error)
+	GetReviewExport(context.Context, *GetReviewExportRequest) (*GetReviewExportReply, error)
+	ListAutoReplyRules(context.Context, *ListAutoReplyRulesRequest) (*ListAutoReplyRulesReply, error)
+	ListReplyTemplates(context.Context, *ListReplyTemplatesRequest) (*ListReplyTemplatesReply, error)
+	ListReviewExports(context.Context, *ListReviewExportsRequest) (*ListReviewExportsReply, error)
+	// ListStoreAppealsB端查询本店的申诉
+	ListStoreAppeals(context.Context, *ListStoreAppealsRequest) (*ListStoreAppealsReply, error)
+	// ListStoreReviewsB端查询本店的评价
+	ListStoreReviews(context.Context, *ListStoreReviewsRequest) (*ListStoreReviewsReply, error)
+	// ListWebhookDeliveriesB端查询webhook投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
 	// ReplyReview商家回复用户评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
 	// ReplyReviewUpdateB端修改回复
 	ReplyReviewUpdate(context.Context, *ReplyReviewUpdateRequest) (*ReplyReviewUpdateReply, error)
+	// SupplementAppealB端按运营要求补充申诉材料
+	SupplementAppeal(context.Context, *SupplementAppealRequest) (*SupplementAppealReply, error)
+	UpdateAutoReplyRule(context.Context, *UpdateAutoReplyRuleRequest) (*UpdateAutoReplyRuleReply, error)
+	UpdateReplyTemplate(context.Context, *UpdateReplyTemplateRequest) (*UpdateReplyTemplateReply, error)
+	// WithdrawAppealB端撤回处理中的申诉
+	WithdrawAppeal(context.Context, *WithdrawAppealRequest) (*WithdrawAppealReply, error)
 }
 
 func RegisterBusinessHTTPServer(s *http.Server, srv BusinessHTTPServer) {
@@ -37,6 +90,27 @@ func RegisterBusinessHTTPServer(s *http.Server, srv BusinessHTTPServer) {
 	r.POST("business/v1/review/reply", _Business_ReplyReview0_HTTP_Handler(srv))
 	r.POST("business/v1/review/appeal", _Business_AppealReview0_HTTP_Handler(srv))
 	r.PUT("business/v1/review/replyupdate", _Business_ReplyReviewUpdate0_HTTP_Handler(srv))
+	r.GET("business/v1/reviews", _Business_ListStoreReviews0_HTTP_Handler(srv))
+	r.POST("business/v1/review/export", _Business_CreateReviewExport0_HTTP_Handler(srv))
+	r.GET("business/v1/review/export/{exportID}", _Business_GetReviewExport0_HTTP_Handler(srv))
+	r.GET("business/v1/review/exports", _Business_ListReviewExports0_HTTP_Handler(srv))
+	r.GET("business/v1/appeals", _Business_ListStoreAppeals0_HTTP_Handler(srv))
+	r.GET("business/v1/appeal/{appealID}", _Business_GetAppeal0_HTTP_Handler(srv))
+	r.POST("business/v1/appeal/{appealID}/supplement", _Business_SupplementAppeal0_HTTP_Handler(srv))
+	r.POST("business/v1/appeal/{appealID}/withdraw", _Business_WithdrawAppeal0_HTTP_Handler(srv))
+	r.GET("business/v1/review/{reviewID}/appeals", _Business_GetAppealHistory0_HTTP_Handler(srv))
+	r.POST("business/v1/reply-template", _Business_CreateReplyTemplate0_HTTP_Handler(srv))
+	r.PUT("business/v1/reply-template/{templateID}", _Business_UpdateReplyTemplate0_HTTP_Handler(srv))
+	r.DELETE("business/v1/reply-template/{templateID}", _Business_DeleteReplyTemplate0_HTTP_Handler(srv))
+	r.GET("business/v1/reply-templates", _Business_ListReplyTemplates0_HTTP_Handler(srv))
+	r.POST("business/v1/auto-reply-rule", _Business_CreateAutoReplyRule0_HTTP_Handler(srv))
+	r.PUT("business/v1/auto-reply-rule/{ruleID}", _Business_UpdateAutoReplyRule0_HTTP_Handler(srv))
+	r.DELETE("business/v1/auto-reply-rule/{ruleID}", _Business_DeleteAutoReplyRule0_HTTP_Handler(srv))
+	r.GET("business/v1/auto-reply-rules", _Business_ListAutoReplyRules0_HTTP_Handler(srv))
+	r.POST("business/v1/webhook", _Business_CreateWebhook0_HTTP_Handler(srv))
+	r.DELETE("business/v1/webhook/{subscriptionID}", _Business_DeleteWebhook0_HTTP_Handler(srv))
+	r.GET("business/v1/webhooks", _Business_ListWebhooks0_HTTP_Handler(srv))
+	r.GET("business/v1/webhook/{subscriptionID}/deliveries", _Business_ListWebhookDeliveries0_HTTP_Handler(srv))
 }
 
 func _Business_ReplyReview0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
@@ -105,10 +179,487 @@ func _Business_ReplyReviewUpdate0_HTTP_Handler(srv BusinessHTTPServer) func(ctx
 	}
 }
 
+func _Business_ListStoreReviews0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStoreReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListStoreReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStoreReviews(ctx, req.(*ListStoreReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStoreReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_CreateReviewExport0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReviewExportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessCreateReviewExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReviewExport(ctx, req.(*CreateReviewExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReviewExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_GetReviewExport0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessGetReviewExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewExport(ctx, req.(*GetReviewExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_ListReviewExports0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReviewExportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListReviewExports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReviewExports(ctx, req.(*ListReviewExportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReviewExportsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_ListStoreAppeals0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStoreAppealsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListStoreAppeals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStoreAppeals(ctx, req.(*ListStoreAppealsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStoreAppealsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_GetAppeal0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppealRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessGetAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppeal(ctx, req.(*GetAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_SupplementAppeal0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SupplementAppealRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessSupplementAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SupplementAppeal(ctx, req.(*SupplementAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SupplementAppealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_WithdrawAppeal0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAppealRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessWithdrawAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAppeal(ctx, req.(*WithdrawAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAppealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_GetAppealHistory0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppealHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessGetAppealHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppealHistory(ctx, req.(*GetAppealHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_CreateReplyTemplate0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReplyTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessCreateReplyTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReplyTemplate(ctx, req.(*CreateReplyTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReplyTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_UpdateReplyTemplate0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReplyTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessUpdateReplyTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReplyTemplate(ctx, req.(*UpdateReplyTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReplyTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_DeleteReplyTemplate0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteReplyTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessDeleteReplyTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReplyTemplate(ctx, req.(*DeleteReplyTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteReplyTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_ListReplyTemplates0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReplyTemplatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListReplyTemplates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReplyTemplates(ctx, req.(*ListReplyTemplatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReplyTemplatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_CreateAutoReplyRule0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAutoReplyRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessCreateAutoReplyRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAutoReplyRule(ctx, req.(*CreateAutoReplyRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAutoReplyRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_UpdateAutoReplyRule0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateAutoReplyRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessUpdateAutoReplyRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateAutoReplyRule(ctx, req.(*UpdateAutoReplyRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateAutoReplyRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_DeleteAutoReplyRule0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAutoReplyRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessDeleteAutoReplyRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAutoReplyRule(ctx, req.(*DeleteAutoReplyRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAutoReplyRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_ListAutoReplyRules0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAutoReplyRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListAutoReplyRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAutoReplyRules(ctx, req.(*ListAutoReplyRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAutoReplyRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_CreateWebhook0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessCreateWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWebhook(ctx, req.(*CreateWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_DeleteWebhook0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWebhookRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessDeleteWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_ListWebhooks0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhooksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListWebhooks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhooks(ctx, req.(*ListWebhooksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhooksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Business_ListWebhookDeliveries0_HTTP_Handler(srv BusinessHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhookDeliveriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBusinessListWebhookDeliveries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhookDeliveriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type BusinessHTTPClient interface {
 	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
+	CreateAutoReplyRule(ctx context.Context, req *CreateAutoReplyRuleRequest, opts ...http.CallOption) (rsp *CreateAutoReplyRuleReply, err error)
+	CreateReplyTemplate(ctx context.Context, req *CreateReplyTemplateRequest, opts ...http.CallOption) (rsp *CreateReplyTemplateReply, err error)
+	CreateReviewExport(ctx context.Context, req *CreateReviewExportRequest, opts ...http.CallOption) (rsp *CreateReviewExportReply, err error)
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest, opts ...http.CallOption) (rsp *CreateWebhookReply, err error)
+	DeleteAutoReplyRule(ctx context.Context, req *DeleteAutoReplyRuleRequest, opts ...http.CallOption) (rsp *DeleteAutoReplyRuleReply, err error)
+	DeleteReplyTemplate(ctx context.Context, req *DeleteReplyTemplateRequest, opts ...http.CallOption) (rsp *DeleteReplyTemplateReply, err error)
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest, opts ...http.CallOption) (rsp *DeleteWebhookReply, err error)
+	GetAppeal(ctx context.Context, req *GetAppealRequest, opts ...http.CallOption) (rsp *GetAppealReply, err error)
+	GetAppealHistory(ctx context.Context, req *GetAppealHistoryRequest, opts ...http.CallOption) (rsp *GetAppealHistoryReply, err error)
+	GetReviewExport(ctx context.Context, req *GetReviewExportRequest, opts ...http.CallOption) (rsp *GetReviewExportReply, err error)
+	ListAutoReplyRules(ctx context.Context, req *ListAutoReplyRulesRequest, opts ...http.CallOption) (rsp *ListAutoReplyRulesReply, err error)
+	ListReplyTemplates(ctx context.Context, req *ListReplyTemplatesRequest, opts ...http.CallOption) (rsp *ListReplyTemplatesReply, err error)
+	ListReviewExports(ctx context.Context, req *ListReviewExportsRequest, opts ...http.CallOption) (rsp *ListReviewExportsReply, err error)
+	ListStoreAppeals(ctx context.Context, req *ListStoreAppealsRequest, opts ...http.CallOption) (rsp *ListStoreAppealsReply, err error)
+	ListStoreReviews(ctx context.Context, req *ListStoreReviewsRequest, opts ...http.CallOption) (rsp *ListStoreReviewsReply, err error)
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest, opts ...http.CallOption) (rsp *ListWebhookDeliveriesReply, err error)
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest, opts ...http.CallOption) (rsp *ListWebhooksReply, err error)
 	ReplyReview(ctx context.Context, req *ReplyReviewRequest, opts ...http.CallOption) (rsp *ReplyReviewReply, err error)
 	ReplyReviewUpdate(ctx context.Context, req *ReplyReviewUpdateRequest, opts ...http.CallOption) (rsp *ReplyReviewUpdateReply, err error)
+	SupplementAppeal(ctx context.Context, req *SupplementAppealRequest, opts ...http.CallOption) (rsp *SupplementAppealReply, err error)
+	UpdateAutoReplyRule(ctx context.Context, req *UpdateAutoReplyRuleRequest, opts ...http.CallOption) (rsp *UpdateAutoReplyRuleReply, err error)
+	UpdateReplyTemplate(ctx context.Context, req *UpdateReplyTemplateRequest, opts ...http.CallOption) (rsp *UpdateReplyTemplateReply, err error)
+	WithdrawAppeal(ctx context.Context, req *WithdrawAppealRequest, opts ...http.CallOption) (rsp *WithdrawAppealReply, err error)
 }
 
 type BusinessHTTPClientImpl struct {
@@ -132,6 +683,227 @@ func (c *BusinessHTTPClientImpl) AppealReview(ctx context.Context, in *AppealRev
 	return &out, nil
 }
 
+func (c *BusinessHTTPClientImpl) CreateAutoReplyRule(ctx context.Context, in *CreateAutoReplyRuleRequest, opts ...http.CallOption) (*CreateAutoReplyRuleReply, error) {
+	var out CreateAutoReplyRuleReply
+	pattern := "business/v1/auto-reply-rule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessCreateAutoReplyRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) CreateReplyTemplate(ctx context.Context, in *CreateReplyTemplateRequest, opts ...http.CallOption) (*CreateReplyTemplateReply, error) {
+	var out CreateReplyTemplateReply
+	pattern := "business/v1/reply-template"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessCreateReplyTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) CreateReviewExport(ctx context.Context, in *CreateReviewExportRequest, opts ...http.CallOption) (*CreateReviewExportReply, error) {
+	var out CreateReviewExportReply
+	pattern := "business/v1/review/export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessCreateReviewExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...http.CallOption) (*CreateWebhookReply, error) {
+	var out CreateWebhookReply
+	pattern := "business/v1/webhook"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessCreateWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) DeleteAutoReplyRule(ctx context.Context, in *DeleteAutoReplyRuleRequest, opts ...http.CallOption) (*DeleteAutoReplyRuleReply, error) {
+	var out DeleteAutoReplyRuleReply
+	pattern := "business/v1/auto-reply-rule/{ruleID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessDeleteAutoReplyRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) DeleteReplyTemplate(ctx context.Context, in *DeleteReplyTemplateRequest, opts ...http.CallOption) (*DeleteReplyTemplateReply, error) {
+	var out DeleteReplyTemplateReply
+	pattern := "business/v1/reply-template/{templateID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessDeleteReplyTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...http.CallOption) (*DeleteWebhookReply, error) {
+	var out DeleteWebhookReply
+	pattern := "business/v1/webhook/{subscriptionID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessDeleteWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...http.CallOption) (*GetAppealReply, error) {
+	var out GetAppealReply
+	pattern := "business/v1/appeal/{appealID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessGetAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...http.CallOption) (*GetAppealHistoryReply, error) {
+	var out GetAppealHistoryReply
+	pattern := "business/v1/review/{reviewID}/appeals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessGetAppealHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) GetReviewExport(ctx context.Context, in *GetReviewExportRequest, opts ...http.CallOption) (*GetReviewExportReply, error) {
+	var out GetReviewExportReply
+	pattern := "business/v1/review/export/{exportID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessGetReviewExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListAutoReplyRules(ctx context.Context, in *ListAutoReplyRulesRequest, opts ...http.CallOption) (*ListAutoReplyRulesReply, error) {
+	var out ListAutoReplyRulesReply
+	pattern := "business/v1/auto-reply-rules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListAutoReplyRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListReplyTemplates(ctx context.Context, in *ListReplyTemplatesRequest, opts ...http.CallOption) (*ListReplyTemplatesReply, error) {
+	var out ListReplyTemplatesReply
+	pattern := "business/v1/reply-templates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListReplyTemplates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListReviewExports(ctx context.Context, in *ListReviewExportsRequest, opts ...http.CallOption) (*ListReviewExportsReply, error) {
+	var out ListReviewExportsReply
+	pattern := "business/v1/review/exports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListReviewExports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListStoreAppeals(ctx context.Context, in *ListStoreAppealsRequest, opts ...http.CallOption) (*ListStoreAppealsReply, error) {
+	var out ListStoreAppealsReply
+	pattern := "business/v1/appeals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListStoreAppeals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListStoreReviews(ctx context.Context, in *ListStoreReviewsRequest, opts ...http.CallOption) (*ListStoreReviewsReply, error) {
+	var out ListStoreReviewsReply
+	pattern := "business/v1/reviews"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListStoreReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...http.CallOption) (*ListWebhookDeliveriesReply, error) {
+	var out ListWebhookDeliveriesReply
+	pattern := "business/v1/webhook/{subscriptionID}/deliveries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListWebhookDeliveries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...http.CallOption) (*ListWebhooksReply, error) {
+	var out ListWebhooksReply
+	pattern := "business/v1/webhooks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBusinessListWebhooks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BusinessHTTPClientImpl) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...http.CallOption) (*ReplyReviewReply, error) {
 	var out ReplyReviewReply
 	pattern := "business/v1/review/reply"
@@ -157,3 +929,55 @@ func (c *BusinessHTTPClientImpl) ReplyReviewUpdate(ctx context.Context, in *Repl
 	}
 	return &out, nil
 }
+
+func (c *BusinessHTTPClientImpl) SupplementAppeal(ctx context.Context, in *SupplementAppealRequest, opts ...http.CallOption) (*SupplementAppealReply, error) {
+	var out SupplementAppealReply
+	pattern := "business/v1/appeal/{appealID}/supplement"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessSupplementAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) UpdateAutoReplyRule(ctx context.Context, in *UpdateAutoReplyRuleRequest, opts ...http.CallOption) (*UpdateAutoReplyRuleReply, error) {
+	var out UpdateAutoReplyRuleReply
+	pattern := "business/v1/auto-reply-rule/{ruleID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessUpdateAutoReplyRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) UpdateReplyTemplate(ctx context.Context, in *UpdateReplyTemplateRequest, opts ...http.CallOption) (*UpdateReplyTemplateReply, error) {
+	var out UpdateReplyTemplateReply
+	pattern := "business/v1/reply-template/{templateID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessUpdateReplyTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BusinessHTTPClientImpl) WithdrawAppeal(ctx context.Context, in *WithdrawAppealRequest, opts ...http.CallOption) (*WithdrawAppealReply, error) {
+	var out WithdrawAppealReply
+	pattern := "business/v1/appeal/{appealID}/withdraw"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBusinessWithdrawAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
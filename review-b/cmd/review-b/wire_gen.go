@@ -23,20 +23,32 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	discovery := data.NewDiscovery(registry)
-	reviewClient := data.NewReviewServiceClient(discovery)
-	dataData, cleanup, err := data.NewData(confData, reviewClient, logger)
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, health *conf.Health, client *conf.Client, logger log.Logger) (*kratos.App, func(), error) {
+	apiClient, err := data.NewConsulClient(registry)
 	if err != nil {
 		return nil, nil, err
 	}
+	discovery := data.NewDiscovery(apiClient, logger)
+	clientConn, cleanup, err := data.NewReviewServiceConn(discovery, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	probes := data.NewHealthProbes(clientConn, apiClient)
+	healthHealth := server.NewHealth(health, probes, logger)
+	reviewClient := data.NewReviewServiceClient(clientConn)
+	dataData, cleanup2, err := data.NewData(confData, reviewClient, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	businessRepo := data.NewBusinessRepo(dataData, logger)
 	businessUsecase := biz.NewBusinessUsecase(businessRepo, logger)
 	businessService := service.NewBusinessService(businessUsecase)
-	grpcServer := server.NewGRPCServer(confServer, businessService, logger)
-	httpServer := server.NewHTTPServer(confServer, businessService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	grpcServer := server.NewGRPCServer(confServer, businessService, healthHealth, logger)
+	httpServer := server.NewHTTPServer(confServer, businessService, healthHealth, logger)
+	app := newApp(logger, healthHealth, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+type SupplementAppealParam struct {
+	StoreID   int64
+	AppealID  int64
+	Content   string
+	PicInfo   string
+	VideoInfo string
+}
+
+// AppealMessage 申诉沟通记录
+type AppealMessage struct {
+	MessageID  int64
+	AppealID   int64
+	SenderType int32 //1商家;2运营
+	Sender     string
+	Action     int32 //1提交;2补充材料;3要求补充;4审核;5撤回
+	Content    string
+	PicInfo    string
+	VideoInfo  string
+	CreateAt   time.Time
+}
+
+// AppealHistory 一条评价的历次申诉和沟通记录
+type AppealHistory struct {
+	Appeals  []*Appeal
+	Messages []*AppealMessage
+}
+
+// SupplementAppeal 按运营要求补充申诉材料，申诉回到待审核
+func (uc *BusinessUsecase) SupplementAppeal(ctx context.Context, param *SupplementAppealParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] SupplementAppeal, param:%v\n", param)
+	return uc.repo.SupplementAppeal(ctx, param)
+}
+
+// WithdrawAppeal 撤回处理中的申诉，撤回后可以重新申诉
+func (uc *BusinessUsecase) WithdrawAppeal(ctx context.Context, storeID int64, appealID int64, reason string) error {
+	uc.log.WithContext(ctx).Debugf("[biz] WithdrawAppeal, storeID:%v appealID:%v\n", storeID, appealID)
+	return uc.repo.WithdrawAppeal(ctx, storeID, appealID, reason)
+}
+
+// GetAppealHistory 查询本店评价的历次申诉和沟通记录
+func (uc *BusinessUsecase) GetAppealHistory(ctx context.Context, storeID int64, reviewID int64) (*AppealHistory, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetAppealHistory, storeID:%v reviewID:%v\n", storeID, reviewID)
+	return uc.repo.GetAppealHistory(ctx, storeID, reviewID)
+}
@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"io"
 )
 
 type ReplyParam struct {
@@ -36,6 +37,33 @@ type BusinessRepo interface {
 	Reply(context.Context, *ReplyParam) (int64, error)
 	Appeal(ctx context.Context, param *AppealParam) (int64, error)
 	Update(ctx context.Context, param *UpdateParam) (int64, error)
+
+	ListStoreReviews(ctx context.Context, param *StoreReviewParam) ([]*StoreReview, int64, error)
+	ListStoreAppeals(ctx context.Context, param *StoreAppealParam) ([]*Appeal, int64, error)
+	GetAppeal(ctx context.Context, storeID int64, appealID int64) (*Appeal, error)
+	SupplementAppeal(ctx context.Context, param *SupplementAppealParam) error
+	WithdrawAppeal(ctx context.Context, storeID int64, appealID int64, reason string) error
+	GetAppealHistory(ctx context.Context, storeID int64, reviewID int64) (*AppealHistory, error)
+
+	CreateReviewExport(ctx context.Context, param *ReviewExportParam) (int64, error)
+	GetReviewExport(ctx context.Context, storeID int64, exportID int64) (*ReviewExport, error)
+	ListReviewExports(ctx context.Context, storeID int64, page int32, size int32) ([]*ReviewExport, int64, error)
+	DownloadReviewExport(ctx context.Context, storeID int64, exportID int64) (io.ReadCloser, error)
+
+	CreateTemplate(ctx context.Context, template *ReplyTemplate) (int64, error)
+	UpdateTemplate(ctx context.Context, template *ReplyTemplate) error
+	DeleteTemplate(ctx context.Context, storeID int64, templateID int64) error
+	ListTemplates(ctx context.Context, storeID int64) ([]*ReplyTemplate, error)
+
+	CreateAutoReplyRule(ctx context.Context, rule *AutoReplyRule) (int64, error)
+	UpdateAutoReplyRule(ctx context.Context, rule *AutoReplyRule) error
+	DeleteAutoReplyRule(ctx context.Context, storeID int64, ruleID int64) error
+	ListAutoReplyRules(ctx context.Context, storeID int64) ([]*AutoReplyRule, error)
+
+	CreateWebhook(ctx context.Context, ownerType int32, webhook *Webhook) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, ownerType int32, storeID int64, subscriptionID int64) error
+	ListWebhooks(ctx context.Context, ownerType int32, storeID int64) ([]*Webhook, error)
+	ListWebhookDeliveries(ctx context.Context, ownerType int32, param *WebhookDeliveryParam) ([]*WebhookDelivery, int64, error)
 }
 
 // GreeterUsecase is a Greeter usecase.
@@ -0,0 +1,61 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ReviewExportParam 导出本店评价，筛选条件同ListStoreReviews
+type ReviewExportParam struct {
+	StoreReviewParam
+	Format string //csv或xlsx
+}
+
+// ReviewExport 导出任务
+type ReviewExport struct {
+	ExportID    int64
+	Format      string
+	Status      int32 //10排队中;20生成中;30已完成;40失败;50文件已过期
+	RowCount    int64
+	FileSize    int64
+	FileName    string
+	Error       string
+	DownloadURL string //对象存储的预签名地址，本地存储时为空
+	CreateAt    time.Time
+	FinishAt    time.Time
+	ExpireAt    time.Time
+}
+
+func (uc *BusinessUsecase) CreateReviewExport(ctx context.Context, param *ReviewExportParam) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateReviewExport, param:%v\n", param)
+	return uc.repo.CreateReviewExport(ctx, param)
+}
+
+func (uc *BusinessUsecase) GetReviewExport(ctx context.Context, storeID int64, exportID int64) (*ReviewExport, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReviewExport, storeID:%v exportID:%v\n", storeID, exportID)
+	return uc.repo.GetReviewExport(ctx, storeID, exportID)
+}
+
+func (uc *BusinessUsecase) ListReviewExports(ctx context.Context, storeID int64, page int32, size int32) ([]*ReviewExport, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewExports, storeID:%v\n", storeID)
+	return uc.repo.ListReviewExports(ctx, storeID, page, size)
+}
+
+// OpenReviewExport 下载导出文件，有预签名地址时不返回文件内容，由调用方跳转
+// 未完成的任务由review-service拒绝下载
+func (uc *BusinessUsecase) OpenReviewExport(ctx context.Context, storeID int64, exportID int64) (*ReviewExport, io.ReadCloser, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] OpenReviewExport, storeID:%v exportID:%v\n", storeID, exportID)
+	export, err := uc.repo.GetReviewExport(ctx, storeID, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.DownloadURL != "" {
+		return export, nil, nil
+	}
+	body, err := uc.repo.DownloadReviewExport(ctx, storeID, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return export, body, nil
+}
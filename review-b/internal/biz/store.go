@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+type StoreReviewParam struct {
+	StoreID   int64
+	Unreplied bool
+	MaxScore  int32
+	HasMedia  int32 //0不限;1有图或视频;2无
+	StartTime time.Time
+	EndTime   time.Time
+	Page      int32
+	Size      int32
+}
+
+type StoreAppealParam struct {
+	StoreID int64
+	Status  int32 //0不限
+	Page    int32
+	Size    int32
+}
+
+// StoreReview 商家看到的评价，匿名评价不带真实用户
+type StoreReview struct {
+	ReviewID     int64
+	UserID       int64
+	Nickname     string
+	OrderID      int64
+	Score        int32
+	ServiceScore int32
+	ExpressScore int32
+	Content      string
+	PicInfo      string
+	VideoInfo    string
+	Status       int32
+	Anonymous    bool
+	HasReply     bool
+	CreateAt     time.Time
+}
+
+type Appeal struct {
+	AppealID  int64
+	ReviewID  int64
+	StoreID   int64
+	Status    int32
+	Reason    string
+	Content   string
+	PicInfo   string
+	VideoInfo string
+	OpRemarks string
+	Round     int32 //第几轮申诉
+	CreateAt  time.Time
+	UpdateAt  time.Time
+}
+
+// ListStoreReviews 查询本店的评价，service层调用这个方法
+func (uc *BusinessUsecase) ListStoreReviews(ctx context.Context, param *StoreReviewParam) ([]*StoreReview, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListStoreReviews, param:%v\n", param)
+	return uc.repo.ListStoreReviews(ctx, param)
+}
+
+// ListStoreAppeals 查询本店的申诉
+func (uc *BusinessUsecase) ListStoreAppeals(ctx context.Context, param *StoreAppealParam) ([]*Appeal, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListStoreAppeals, param:%v\n", param)
+	return uc.repo.ListStoreAppeals(ctx, param)
+}
+
+// GetAppeal 查询本店的申诉详情，查看审核结果
+func (uc *BusinessUsecase) GetAppeal(ctx context.Context, storeID int64, appealID int64) (*Appeal, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetAppeal, storeID:%v appealID:%v\n", storeID, appealID)
+	return uc.repo.GetAppeal(ctx, storeID, appealID)
+}
@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// ReplyTemplate 回复模板，内容支持占位符{nickname}{product}
+type ReplyTemplate struct {
+	TemplateID int64
+	StoreID    int64
+	Name       string
+	Content    string
+	UpdateAt   time.Time
+}
+
+// AutoReplyRule 自动回复规则，由review-service的定时任务执行
+type AutoReplyRule struct {
+	RuleID       int64
+	StoreID      int64
+	TemplateID   int64
+	MinScore     int32
+	MaxScore     int32
+	OnlyEmpty    bool
+	DelaySeconds int32
+	Enabled      bool
+}
+
+func (uc *BusinessUsecase) CreateTemplate(ctx context.Context, template *ReplyTemplate) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateTemplate, template:%v\n", template)
+	return uc.repo.CreateTemplate(ctx, template)
+}
+
+func (uc *BusinessUsecase) UpdateTemplate(ctx context.Context, template *ReplyTemplate) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateTemplate, template:%v\n", template)
+	return uc.repo.UpdateTemplate(ctx, template)
+}
+
+func (uc *BusinessUsecase) DeleteTemplate(ctx context.Context, storeID int64, templateID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteTemplate, storeID:%v templateID:%v\n", storeID, templateID)
+	return uc.repo.DeleteTemplate(ctx, storeID, templateID)
+}
+
+func (uc *BusinessUsecase) ListTemplates(ctx context.Context, storeID int64) ([]*ReplyTemplate, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListTemplates, storeID:%v\n", storeID)
+	return uc.repo.ListTemplates(ctx, storeID)
+}
+
+func (uc *BusinessUsecase) CreateAutoReplyRule(ctx context.Context, rule *AutoReplyRule) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateAutoReplyRule, rule:%v\n", rule)
+	return uc.repo.CreateAutoReplyRule(ctx, rule)
+}
+
+func (uc *BusinessUsecase) UpdateAutoReplyRule(ctx context.Context, rule *AutoReplyRule) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateAutoReplyRule, rule:%v\n", rule)
+	return uc.repo.UpdateAutoReplyRule(ctx, rule)
+}
+
+func (uc *BusinessUsecase) DeleteAutoReplyRule(ctx context.Context, storeID int64, ruleID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteAutoReplyRule, storeID:%v ruleID:%v\n", storeID, ruleID)
+	return uc.repo.DeleteAutoReplyRule(ctx, storeID, ruleID)
+}
+
+func (uc *BusinessUsecase) ListAutoReplyRules(ctx context.Context, storeID int64) ([]*AutoReplyRule, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListAutoReplyRules, storeID:%v\n", storeID)
+	return uc.repo.ListAutoReplyRules(ctx, storeID)
+}
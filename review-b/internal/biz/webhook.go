@@ -0,0 +1,61 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// 店铺订阅，review-service只推送本店的事件
+const webhookOwnerStore = 1
+
+// Webhook 本店的webhook订阅
+type Webhook struct {
+	SubscriptionID int64
+	StoreID        int64
+	URL            string
+	Events         []string
+	Secret         string //只在创建时返回
+	CreateAt       time.Time
+}
+
+// WebhookDelivery 一次通知的投递记录
+type WebhookDelivery struct {
+	DeliveryID   int64
+	Event        string
+	Payload      string
+	Status       int32 //10待投递;20投递成功;30投递失败
+	Attempts     int32
+	ResponseCode int32
+	LastError    string
+	NextRetryAt  time.Time
+	CreateAt     time.Time
+}
+
+type WebhookDeliveryParam struct {
+	StoreID        int64
+	SubscriptionID int64
+	Status         int32
+	Page           int32
+	Size           int32
+}
+
+func (uc *BusinessUsecase) CreateWebhook(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateWebhook, webhook:%v\n", webhook)
+	return uc.repo.CreateWebhook(ctx, webhookOwnerStore, webhook)
+}
+
+func (uc *BusinessUsecase) DeleteWebhook(ctx context.Context, storeID int64, subscriptionID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteWebhook, storeID:%v subscriptionID:%v\n", storeID, subscriptionID)
+	return uc.repo.DeleteWebhook(ctx, webhookOwnerStore, storeID, subscriptionID)
+}
+
+func (uc *BusinessUsecase) ListWebhooks(ctx context.Context, storeID int64) ([]*Webhook, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListWebhooks, storeID:%v\n", storeID)
+	return uc.repo.ListWebhooks(ctx, webhookOwnerStore, storeID)
+}
+
+// ListWebhookDeliveries 查询本店订阅的投递记录
+func (uc *BusinessUsecase) ListWebhookDeliveries(ctx context.Context, param *WebhookDeliveryParam) ([]*WebhookDelivery, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListWebhookDeliveries, param:%v\n", param)
+	return uc.repo.ListWebhookDeliveries(ctx, webhookOwnerStore, param)
+}
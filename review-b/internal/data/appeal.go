@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	v1 "review-b/api/review/v1"
+	"review-b/internal/biz"
+	"time"
+)
+
+func (r *businessRepo) SupplementAppeal(ctx context.Context, param *biz.SupplementAppealParam) error {
+	r.log.WithContext(ctx).Infof("[data] SupplementAppeal,param:%v\n", param)
+	_, err := r.data.rc.SupplementAppeal(ctx, &v1.SupplementAppealRequest{
+		StoreID:   param.StoreID,
+		AppealID:  param.AppealID,
+		Content:   param.Content,
+		PicInfo:   param.PicInfo,
+		VideoInfo: param.VideoInfo,
+	})
+	return err
+}
+
+func (r *businessRepo) WithdrawAppeal(ctx context.Context, storeID int64, appealID int64, reason string) error {
+	r.log.WithContext(ctx).Infof("[data] WithdrawAppeal,storeID:%v appealID:%v\n", storeID, appealID)
+	_, err := r.data.rc.WithdrawAppeal(ctx, &v1.WithdrawAppealRequest{
+		StoreID:  storeID,
+		AppealID: appealID,
+		Reason:   reason,
+	})
+	return err
+}
+
+func (r *businessRepo) GetAppealHistory(ctx context.Context, storeID int64, reviewID int64) (*biz.AppealHistory, error) {
+	r.log.WithContext(ctx).Infof("[data] GetAppealHistory,storeID:%v reviewID:%v\n", storeID, reviewID)
+	reply, err := r.data.rc.GetAppealHistory(ctx, &v1.GetAppealHistoryRequest{
+		ReviewID: reviewID,
+		StoreID:  storeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	history := &biz.AppealHistory{
+		Appeals:  make([]*biz.Appeal, 0, len(reply.Appeals)),
+		Messages: make([]*biz.AppealMessage, 0, len(reply.Messages)),
+	}
+	for _, info := range reply.Appeals {
+		history.Appeals = append(history.Appeals, toAppeal(info))
+	}
+	for _, m := range reply.Messages {
+		history.Messages = append(history.Messages, &biz.AppealMessage{
+			MessageID:  m.GetMessageID(),
+			AppealID:   m.GetAppealID(),
+			SenderType: m.GetSenderType(),
+			Sender:     m.GetSender(),
+			Action:     m.GetAction(),
+			Content:    m.GetContent(),
+			PicInfo:    m.GetPicInfo(),
+			VideoInfo:  m.GetVideoInfo(),
+			CreateAt:   time.Unix(m.GetCreateAt(), 0),
+		})
+	}
+	return history, nil
+}
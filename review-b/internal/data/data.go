@@ -2,21 +2,25 @@ package data
 
 import (
 	"context"
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/hashicorp/consul/api"
+	ggrpc "google.golang.org/grpc"
 	v1 "review-b/api/review/v1"
 	"review-b/internal/conf"
+	"review-common/client"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewBusinessRepo, NewReviewServiceClient, NewDiscovery)
+var ProviderSet = wire.NewSet(NewData, NewBusinessRepo, NewReviewServiceConn, NewReviewServiceClient, NewConsulClient, NewDiscovery, NewHealthProbes)
 
 // Data .
 type Data struct {
@@ -34,33 +38,69 @@ func NewData(c *conf.Data, rc v1.ReviewClient, logger log.Logger) (*Data, func()
 	return &Data{rc: rc, log: log.NewHelper(logger)}, cleanup, nil
 }
 
-// 服务发现对象的构造函数
-func NewDiscovery(conf *conf.Registry) registry.Discovery {
+func NewConsulClient(conf *conf.Registry) (*api.Client, error) {
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
 	c.Scheme = conf.Consul.Scheme
-	client, err := api.NewClient(c)
-	if err != nil {
-		panic(err)
-	}
-	dis := consul.New(client, consul.WithHealthCheck(true))
-	return dis
+	return api.NewClient(c)
 }
 
 // 创建一个链接review-service的Grpc client端
-func NewReviewServiceClient(d registry.Discovery) v1.ReviewClient {
+// 连接不阻塞，consul或review-service暂时不可用时先启动，由健康检查报告未就绪
+func NewReviewServiceConn(d registry.Discovery, c *conf.Client) (*ggrpc.ClientConn, func(), error) {
+	if err := client.SetBalancer(c.GetBalancer()); err != nil {
+		return nil, nil, err
+	}
+	ms := []middleware.Middleware{
+		recovery.Recovery(),
+		//把链路上下文带给review-service
+		tracing.Client(),
+	}
+	if c.GetBreaker().GetEnable() {
+		ms = append(ms, client.Breaker(client.BreakerConfig{
+			Success: c.GetBreaker().GetSuccess(),
+			Request: c.GetBreaker().GetRequest(),
+			Window:  c.GetBreaker().GetWindow().AsDuration(),
+		}))
+	}
+	ms = append(ms,
+		validate.Validator(),
+		//告知review-service调用方是B端商家，用于匿名评价脱敏
+		client.SignRole("merchant", c.GetRoleSecret()),
+	)
 	conn, err := grpc.DialInsecure(
 		context.Background(),
 		grpc.WithEndpoint("discovery:///review-service"),
 		grpc.WithDiscovery(d),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-			validate.Validator(),
-		),
+		grpc.WithMiddleware(ms...),
+		//超时由resilience按方法设置
+		grpc.WithTimeout(0),
+		grpc.WithUnaryInterceptor(client.UnaryInterceptor(clientConfig(c))),
 		//grpc.WithEndpoint("127.0.0.1:9092"),
 	)
 	if err != nil {
-		panic(err)
+		return nil, nil, err
 	}
+	return conn, func() { conn.Close() }, nil
+}
+
+// clientConfig 调用超时和重试配置
+func clientConfig(c *conf.Client) client.Config {
+	cfg := client.Config{
+		Timeout:        c.GetTimeout().AsDuration(),
+		MethodTimeouts: make(map[string]time.Duration, len(c.GetMethodTimeouts())),
+		MaxRetries:     int(c.GetRetry().GetMaxRetries()),
+		PerTryTimeout:  c.GetRetry().GetPerTryTimeout().AsDuration(),
+		Backoff:        c.GetRetry().GetBackoff().AsDuration(),
+		HedgeDelay:     c.GetRetry().GetHedgeDelay().AsDuration(),
+		BudgetRatio:    c.GetRetry().GetBudgetRatio(),
+	}
+	for name, d := range c.GetMethodTimeouts() {
+		cfg.MethodTimeouts[name] = d.AsDuration()
+	}
+	return cfg
+}
+
+func NewReviewServiceClient(conn *ggrpc.ClientConn) v1.ReviewClient {
 	return v1.NewReviewClient(conn)
 }
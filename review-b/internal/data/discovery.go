@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/hashicorp/consul/api"
+	"sync"
+	"time"
+)
+
+const watchRetryInterval = 3 * time.Second
+
+// 服务发现对象的构造函数
+// consul不可用时Watch不返回错误，后台重试到连上为止，避免启动时consul故障导致进程退出
+func NewDiscovery(client *api.Client, logger log.Logger) registry.Discovery {
+	newDiscovery := func() registry.Discovery {
+		return consul.New(client, consul.WithHealthCheck(true))
+	}
+	return &retryDiscovery{dis: newDiscovery(), newDiscovery: newDiscovery, log: log.NewHelper(logger)}
+}
+
+type retryDiscovery struct {
+	mu           sync.Mutex
+	dis          registry.Discovery
+	newDiscovery func() registry.Discovery
+	log          *log.Helper
+}
+
+func (d *retryDiscovery) current() registry.Discovery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dis
+}
+
+// reset consul registry首次解析失败后会留下一个不再解析的服务集合，只能换一个新的registry重试
+func (d *retryDiscovery) reset(failed registry.Discovery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.dis == failed {
+		d.dis = d.newDiscovery()
+	}
+}
+
+func (d *retryDiscovery) GetService(ctx context.Context, name string) ([]*registry.ServiceInstance, error) {
+	return d.current().GetService(ctx, name)
+}
+
+func (d *retryDiscovery) Watch(ctx context.Context, name string) (registry.Watcher, error) {
+	dis := d.current()
+	w, err := dis.Watch(ctx, name)
+	if err == nil {
+		return w, nil
+	}
+	d.reset(dis)
+	d.log.Warnf("watch %s failed, retry in background, err:%v", name, err)
+	ctx, cancel := context.WithCancel(ctx)
+	return &retryWatcher{d: d, name: name, ctx: ctx, cancel: cancel}, nil
+}
+
+// retryWatcher 第一次Next时重试创建watcher，创建成功后直接转发
+type retryWatcher struct {
+	d      *retryDiscovery
+	name   string
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	w  registry.Watcher
+}
+
+func (w *retryWatcher) Next() ([]*registry.ServiceInstance, error) {
+	watcher, err := w.watcher()
+	if err != nil {
+		return nil, err
+	}
+	return watcher.Next()
+}
+
+func (w *retryWatcher) watcher() (registry.Watcher, error) {
+	w.mu.Lock()
+	watcher := w.w
+	w.mu.Unlock()
+	if watcher != nil {
+		return watcher, nil
+	}
+	for {
+		select {
+		case <-w.ctx.Done():
+			return nil, w.ctx.Err()
+		case <-time.After(watchRetryInterval):
+		}
+		dis := w.d.current()
+		watcher, err := dis.Watch(w.ctx, w.name)
+		if err != nil {
+			w.d.reset(dis)
+			continue
+		}
+		w.mu.Lock()
+		w.w = watcher
+		w.mu.Unlock()
+		w.d.log.Infof("watch %s recovered", w.name)
+		return watcher, nil
+	}
+}
+
+func (w *retryWatcher) Stop() error {
+	w.cancel()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.w != nil {
+		return w.w.Stop()
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io"
+	v1 "review-b/api/review/v1"
+	"review-b/internal/biz"
+
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+func (r *businessRepo) CreateReviewExport(ctx context.Context, param *biz.ReviewExportParam) (int64, error) {
+	r.log.WithContext(ctx).Infof("[data] CreateReviewExport,param:%v\n", param)
+	req := &v1.CreateReviewExportRequest{
+		StoreID:   param.StoreID,
+		Format:    param.Format,
+		Unreplied: param.Unreplied,
+		MaxScore:  param.MaxScore,
+		HasMedia:  param.HasMedia,
+	}
+	if !param.StartTime.IsZero() {
+		req.StartTime = param.StartTime.Unix()
+	}
+	if !param.EndTime.IsZero() {
+		req.EndTime = param.EndTime.Unix()
+	}
+	reply, err := r.data.rc.CreateReviewExport(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ExportID, nil
+}
+
+func (r *businessRepo) GetReviewExport(ctx context.Context, storeID int64, exportID int64) (*biz.ReviewExport, error) {
+	r.log.WithContext(ctx).Infof("[data] GetReviewExport,storeID:%v exportID:%v\n", storeID, exportID)
+	reply, err := r.data.rc.GetReviewExport(ctx, &v1.GetReviewExportRequest{StoreID: storeID, ExportID: exportID})
+	if err != nil {
+		return nil, err
+	}
+	return toReviewExport(reply.GetExport()), nil
+}
+
+func (r *businessRepo) ListReviewExports(ctx context.Context, storeID int64, page int32, size int32) ([]*biz.ReviewExport, int64, error) {
+	r.log.WithContext(ctx).Infof("[data] ListReviewExports,storeID:%v\n", storeID)
+	reply, err := r.data.rc.ListReviewExports(ctx, &v1.ListReviewExportsRequest{StoreID: storeID, Page: page, Size: size})
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*biz.ReviewExport, 0, len(reply.List))
+	for _, e := range reply.List {
+		list = append(list, toReviewExport(e))
+	}
+	return list, reply.Total, nil
+}
+
+// DownloadReviewExport 把review-service的分块流包装成io.ReadCloser
+func (r *businessRepo) DownloadReviewExport(ctx context.Context, storeID int64, exportID int64) (io.ReadCloser, error) {
+	r.log.WithContext(ctx).Infof("[data] DownloadReviewExport,storeID:%v exportID:%v\n", storeID, exportID)
+	//client中间件只作用于unary调用，流式调用需要自己带上角色
+	ctx, cancel := context.WithCancel(grpcmd.AppendToOutgoingContext(ctx, "x-md-local-role", "merchant"))
+	stream, err := r.data.rc.DownloadReviewExport(ctx, &v1.DownloadReviewExportRequest{StoreID: storeID, ExportID: exportID})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	//第一条消息才会带回review-service的错误，比如任务未完成
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		cancel()
+		return nil, err
+	}
+	return &exportReader{stream: stream, buf: first.GetChunk(), cancel: cancel}, nil
+}
+
+type exportReader struct {
+	stream v1.Review_DownloadReviewExportClient
+	buf    []byte
+	cancel context.CancelFunc
+}
+
+func (e *exportReader) Read(p []byte) (int, error) {
+	for len(e.buf) == 0 {
+		msg, err := e.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		e.buf = msg.GetChunk()
+	}
+	n := copy(p, e.buf)
+	e.buf = e.buf[n:]
+	return n, nil
+}
+
+func (e *exportReader) Close() error {
+	e.cancel()
+	return nil
+}
+
+func toReviewExport(e *v1.ReviewExportInfo) *biz.ReviewExport {
+	export := &biz.ReviewExport{
+		ExportID:    e.GetExportID(),
+		Format:      e.GetFormat(),
+		Status:      e.GetStatus(),
+		RowCount:    e.GetRowCount(),
+		FileSize:    e.GetFileSize(),
+		FileName:    e.GetFileName(),
+		Error:       e.GetError(),
+		DownloadURL: e.GetDownloadURL(),
+		CreateAt:    e.GetCreateAt().AsTime(),
+	}
+	if e.FinishAt != nil {
+		export.FinishAt = e.FinishAt.AsTime()
+	}
+	if e.ExpireAt != nil {
+		export.ExpireAt = e.ExpireAt.AsTime()
+	}
+	return export
+}
@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/consul/api"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"review-common/health"
+)
+
+// NewHealthProbes review-service不可用时未就绪，consul只影响发现新实例，算降级
+func NewHealthProbes(conn *ggrpc.ClientConn, client *api.Client) health.Probes {
+	hc := grpc_health_v1.NewHealthClient(conn)
+	return health.Probes{
+		{Name: "review-service", Critical: true, Check: func(ctx context.Context) error {
+			resp, err := hc.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+			if err != nil {
+				return err
+			}
+			if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+				return fmt.Errorf("review-service %s", resp.GetStatus())
+			}
+			return nil
+		}},
+		{Name: "consul", Check: func(ctx context.Context) error {
+			_, err := client.Status().LeaderWithQueryOptions((&api.QueryOptions{}).WithContext(ctx))
+			return err
+		}},
+	}
+}
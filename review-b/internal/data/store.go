@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	v1 "review-b/api/review/v1"
+	"review-b/internal/biz"
+	"time"
+)
+
+func (r *businessRepo) ListStoreReviews(ctx context.Context, param *biz.StoreReviewParam) ([]*biz.StoreReview, int64, error) {
+	r.log.WithContext(ctx).Infof("[data] ListStoreReviews,param:%v\n", param)
+	req := &v1.ListStoreReviewsRequest{
+		StoreID:   param.StoreID,
+		Unreplied: param.Unreplied,
+		MaxScore:  param.MaxScore,
+		HasMedia:  param.HasMedia,
+		Page:      param.Page,
+		Size:      param.Size,
+	}
+	if !param.StartTime.IsZero() {
+		req.StartTime = param.StartTime.Unix()
+	}
+	if !param.EndTime.IsZero() {
+		req.EndTime = param.EndTime.Unix()
+	}
+	reply, err := r.data.rc.ListStoreReviews(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*biz.StoreReview, 0, len(reply.List))
+	for _, info := range reply.List {
+		list = append(list, &biz.StoreReview{
+			ReviewID:     info.ReviewID,
+			UserID:       info.UserID,
+			Nickname:     info.Nickname,
+			OrderID:      info.OrderID,
+			Score:        info.Score,
+			ServiceScore: info.ServiceScore,
+			ExpressScore: info.ExpressScore,
+			Content:      info.Content,
+			PicInfo:      info.PicInfo,
+			VideoInfo:    info.VideoInfo,
+			Status:       info.Status,
+			Anonymous:    info.Anonymous,
+			HasReply:     info.HasReply,
+			CreateAt:     time.Unix(info.CreateAt, 0),
+		})
+	}
+	return list, reply.Total, nil
+}
+
+func (r *businessRepo) ListStoreAppeals(ctx context.Context, param *biz.StoreAppealParam) ([]*biz.Appeal, int64, error) {
+	r.log.WithContext(ctx).Infof("[data] ListStoreAppeals,param:%v\n", param)
+	reply, err := r.data.rc.ListStoreAppeals(ctx, &v1.ListStoreAppealsRequest{
+		StoreID: param.StoreID,
+		Status:  param.Status,
+		Page:    param.Page,
+		Size:    param.Size,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*biz.Appeal, 0, len(reply.List))
+	for _, info := range reply.List {
+		list = append(list, toAppeal(info))
+	}
+	return list, reply.Total, nil
+}
+
+func (r *businessRepo) GetAppeal(ctx context.Context, storeID int64, appealID int64) (*biz.Appeal, error) {
+	r.log.WithContext(ctx).Infof("[data] GetAppeal,storeID:%v appealID:%v\n", storeID, appealID)
+	reply, err := r.data.rc.GetAppeal(ctx, &v1.GetAppealRequest{
+		StoreID:  storeID,
+		AppealID: appealID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAppeal(reply.Appeal), nil
+}
+
+func toAppeal(info *v1.AppealInfo) *biz.Appeal {
+	return &biz.Appeal{
+		AppealID:  info.GetAppealID(),
+		ReviewID:  info.GetReviewID(),
+		StoreID:   info.GetStoreID(),
+		Status:    info.GetStatus(),
+		Reason:    info.GetReason(),
+		Content:   info.GetContent(),
+		PicInfo:   info.GetPicInfo(),
+		VideoInfo: info.GetVideoInfo(),
+		OpRemarks: info.GetOpRemarks(),
+		Round:     info.GetRound(),
+		CreateAt:  time.Unix(info.GetCreateAt(), 0),
+		UpdateAt:  time.Unix(info.GetUpdateAt(), 0),
+	}
+}
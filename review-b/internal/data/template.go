@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	v1 "review-b/api/review/v1"
+	"review-b/internal/biz"
+	"time"
+)
+
+func (r *businessRepo) CreateTemplate(ctx context.Context, template *biz.ReplyTemplate) (int64, error) {
+	r.log.WithContext(ctx).Infof("[data] CreateTemplate,template:%v\n", template)
+	reply, err := r.data.rc.CreateReplyTemplate(ctx, &v1.CreateReplyTemplateRequest{
+		StoreID: template.StoreID,
+		Name:    template.Name,
+		Content: template.Content,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.TemplateID, nil
+}
+
+func (r *businessRepo) UpdateTemplate(ctx context.Context, template *biz.ReplyTemplate) error {
+	r.log.WithContext(ctx).Infof("[data] UpdateTemplate,template:%v\n", template)
+	_, err := r.data.rc.UpdateReplyTemplate(ctx, &v1.UpdateReplyTemplateRequest{
+		StoreID:    template.StoreID,
+		TemplateID: template.TemplateID,
+		Name:       template.Name,
+		Content:    template.Content,
+	})
+	return err
+}
+
+func (r *businessRepo) DeleteTemplate(ctx context.Context, storeID int64, templateID int64) error {
+	r.log.WithContext(ctx).Infof("[data] DeleteTemplate,storeID:%v templateID:%v\n", storeID, templateID)
+	_, err := r.data.rc.DeleteReplyTemplate(ctx, &v1.DeleteReplyTemplateRequest{
+		StoreID:    storeID,
+		TemplateID: templateID,
+	})
+	return err
+}
+
+func (r *businessRepo) ListTemplates(ctx context.Context, storeID int64) ([]*biz.ReplyTemplate, error) {
+	r.log.WithContext(ctx).Infof("[data] ListTemplates,storeID:%v\n", storeID)
+	reply, err := r.data.rc.ListReplyTemplates(ctx, &v1.ListReplyTemplatesRequest{StoreID: storeID})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.ReplyTemplate, 0, len(reply.List))
+	for _, t := range reply.List {
+		list = append(list, &biz.ReplyTemplate{
+			TemplateID: t.TemplateID,
+			StoreID:    t.StoreID,
+			Name:       t.Name,
+			Content:    t.Content,
+			UpdateAt:   time.Unix(t.UpdateAt, 0),
+		})
+	}
+	return list, nil
+}
+
+func (r *businessRepo) CreateAutoReplyRule(ctx context.Context, rule *biz.AutoReplyRule) (int64, error) {
+	r.log.WithContext(ctx).Infof("[data] CreateAutoReplyRule,rule:%v\n", rule)
+	reply, err := r.data.rc.CreateAutoReplyRule(ctx, &v1.CreateAutoReplyRuleRequest{
+		StoreID:      rule.StoreID,
+		TemplateID:   rule.TemplateID,
+		MinScore:     rule.MinScore,
+		MaxScore:     rule.MaxScore,
+		OnlyEmpty:    rule.OnlyEmpty,
+		DelaySeconds: rule.DelaySeconds,
+		Enabled:      rule.Enabled,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reply.RuleID, nil
+}
+
+func (r *businessRepo) UpdateAutoReplyRule(ctx context.Context, rule *biz.AutoReplyRule) error {
+	r.log.WithContext(ctx).Infof("[data] UpdateAutoReplyRule,rule:%v\n", rule)
+	_, err := r.data.rc.UpdateAutoReplyRule(ctx, &v1.UpdateAutoReplyRuleRequest{
+		StoreID:      rule.StoreID,
+		RuleID:       rule.RuleID,
+		TemplateID:   rule.TemplateID,
+		MinScore:     rule.MinScore,
+		MaxScore:     rule.MaxScore,
+		OnlyEmpty:    rule.OnlyEmpty,
+		DelaySeconds: rule.DelaySeconds,
+		Enabled:      rule.Enabled,
+	})
+	return err
+}
+
+func (r *businessRepo) DeleteAutoReplyRule(ctx context.Context, storeID int64, ruleID int64) error {
+	r.log.WithContext(ctx).Infof("[data] DeleteAutoReplyRule,storeID:%v ruleID:%v\n", storeID, ruleID)
+	_, err := r.data.rc.DeleteAutoReplyRule(ctx, &v1.DeleteAutoReplyRuleRequest{
+		StoreID: storeID,
+		RuleID:  ruleID,
+	})
+	return err
+}
+
+func (r *businessRepo) ListAutoReplyRules(ctx context.Context, storeID int64) ([]*biz.AutoReplyRule, error) {
+	r.log.WithContext(ctx).Infof("[data] ListAutoReplyRules,storeID:%v\n", storeID)
+	reply, err := r.data.rc.ListAutoReplyRules(ctx, &v1.ListAutoReplyRulesRequest{StoreID: storeID})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.AutoReplyRule, 0, len(reply.List))
+	for _, rule := range reply.List {
+		list = append(list, &biz.AutoReplyRule{
+			RuleID:       rule.RuleID,
+			StoreID:      rule.StoreID,
+			TemplateID:   rule.TemplateID,
+			MinScore:     rule.MinScore,
+			MaxScore:     rule.MaxScore,
+			OnlyEmpty:    rule.OnlyEmpty,
+			DelaySeconds: rule.DelaySeconds,
+			Enabled:      rule.Enabled,
+		})
+	}
+	return list, nil
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	v1 "review-b/api/review/v1"
+	"review-b/internal/biz"
+	"time"
+)
+
+func (r *businessRepo) CreateWebhook(ctx context.Context, ownerType int32, webhook *biz.Webhook) (*biz.Webhook, error) {
+	r.log.WithContext(ctx).Infof("[data] CreateWebhook,webhook:%v\n", webhook)
+	reply, err := r.data.rc.CreateWebhook(ctx, &v1.CreateWebhookRequest{
+		OwnerType: ownerType,
+		OwnerID:   webhook.StoreID,
+		Url:       webhook.URL,
+		Events:    webhook.Events,
+	})
+	if err != nil {
+		return nil, err
+	}
+	webhook.SubscriptionID = reply.SubscriptionID
+	webhook.Secret = reply.Secret
+	return webhook, nil
+}
+
+func (r *businessRepo) DeleteWebhook(ctx context.Context, ownerType int32, storeID int64, subscriptionID int64) error {
+	r.log.WithContext(ctx).Infof("[data] DeleteWebhook,storeID:%v subscriptionID:%v\n", storeID, subscriptionID)
+	_, err := r.data.rc.DeleteWebhook(ctx, &v1.DeleteWebhookRequest{
+		OwnerType:      ownerType,
+		OwnerID:        storeID,
+		SubscriptionID: subscriptionID,
+	})
+	return err
+}
+
+func (r *businessRepo) ListWebhooks(ctx context.Context, ownerType int32, storeID int64) ([]*biz.Webhook, error) {
+	r.log.WithContext(ctx).Infof("[data] ListWebhooks,storeID:%v\n", storeID)
+	reply, err := r.data.rc.ListWebhooks(ctx, &v1.ListWebhooksRequest{
+		OwnerType: ownerType,
+		OwnerID:   storeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.Webhook, 0, len(reply.List))
+	for _, w := range reply.List {
+		list = append(list, &biz.Webhook{
+			SubscriptionID: w.GetSubscriptionID(),
+			StoreID:        w.GetOwnerID(),
+			URL:            w.GetUrl(),
+			Events:         w.GetEvents(),
+			CreateAt:       time.Unix(w.GetCreateAt(), 0),
+		})
+	}
+	return list, nil
+}
+
+func (r *businessRepo) ListWebhookDeliveries(ctx context.Context, ownerType int32, param *biz.WebhookDeliveryParam) ([]*biz.WebhookDelivery, int64, error) {
+	r.log.WithContext(ctx).Infof("[data] ListWebhookDeliveries,param:%v\n", param)
+	reply, err := r.data.rc.ListWebhookDeliveries(ctx, &v1.ListWebhookDeliveriesRequest{
+		OwnerType:      ownerType,
+		OwnerID:        param.StoreID,
+		SubscriptionID: param.SubscriptionID,
+		Status:         param.Status,
+		Page:           param.Page,
+		Size:           param.Size,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*biz.WebhookDelivery, 0, len(reply.List))
+	for _, d := range reply.List {
+		list = append(list, &biz.WebhookDelivery{
+			DeliveryID:   d.GetDeliveryID(),
+			Event:        d.GetEvent(),
+			Payload:      d.GetPayload(),
+			Status:       d.GetStatus(),
+			Attempts:     d.GetAttempts(),
+			ResponseCode: d.GetResponseCode(),
+			LastError:    d.GetLastError(),
+			NextRetryAt:  time.Unix(d.GetNextRetryAt(), 0),
+			CreateAt:     time.Unix(d.GetCreateAt(), 0),
+		})
+	}
+	return list, reply.Total, nil
+}
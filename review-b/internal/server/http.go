@@ -4,17 +4,23 @@ import (
 	v1 "review-b/api/business/v1"
 	"review-b/internal/conf"
 	"review-b/internal/service"
+	"review-common/health"
+	"review-common/telemetry"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, business *service.BusinessService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, business *service.BusinessService, h *health.Health, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			telemetry.ServerMetrics(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -27,6 +33,11 @@ func NewHTTPServer(c *conf.Server, business *service.BusinessService, logger log
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
+	srv.HandleFunc("/healthz", h.Liveness)
+	srv.HandleFunc("/readyz", h.Readiness)
 	v1.RegisterBusinessHTTPServer(srv, business)
+	//文件下载不是protobuf响应，单独注册
+	srv.Route("/").GET("/business/v1/review/export/{exportID}/download", business.DownloadReviewExport)
 	return srv
 }
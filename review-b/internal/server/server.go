@@ -1,8 +1,16 @@
 package server
 
 import (
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"review-b/internal/conf"
+	"review-common/health"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewHealth, NewGRPCServer, NewHTTPServer)
+
+// NewHealth 定时检查数据层提供的依赖
+func NewHealth(c *conf.Health, probes health.Probes, logger log.Logger) *health.Health {
+	return health.New(probes, c.GetInterval().AsDuration(), c.GetTimeout().AsDuration(), logger)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	pb "review-b/api/business/v1"
+	v1 "review-b/api/review/v1"
+	"testing"
+)
+
+func TestAppeals(t *testing.T) {
+	convey.Convey("merchants appeal, supplement and withdraw only on their own reviews", t, func() {
+		fake := &fakeReview{
+			stores:  map[int64]int64{1: 51, 2: 52},
+			appeals: []*v1.AppealInfo{{AppealID: 900, ReviewID: 2, StoreID: 52, Status: 10, Round: 1}},
+			nextID:  1000,
+		}
+		s := newTestService(t, fake)
+		ctx := context.Background()
+
+		//不是自己店的评价不能申诉
+		_, err := s.AppealReview(ctx, &pb.AppealReviewRequest{StoreID: 51, ReviewID: 2, Reason: "恶意差评啊", Content: "顾客没有到店消费"})
+		convey.So(v1.IsInvalidParams(err), convey.ShouldBeTrue)
+
+		appeal, err := s.AppealReview(ctx, &pb.AppealReviewRequest{StoreID: 51, ReviewID: 1, Reason: "恶意差评啊", Content: "顾客没有到店消费", PicInfo: "a.jpg"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeal.AppealID, convey.ShouldBeGreaterThan, 0)
+		//处理中的申诉不能重复发起
+		_, err = s.AppealReview(ctx, &pb.AppealReviewRequest{StoreID: 51, ReviewID: 1, Reason: "再申诉一次", Content: "顾客没有到店消费"})
+		convey.So(v1.IsReviewAppealed(err), convey.ShouldBeTrue)
+
+		_, err = s.SupplementAppeal(ctx, &pb.SupplementAppealRequest{StoreID: 51, AppealID: appeal.AppealID, Content: "补充监控截图", PicInfo: "b.jpg"})
+		convey.So(err, convey.ShouldBeNil)
+		//别家的申诉按不存在返回
+		_, err = s.SupplementAppeal(ctx, &pb.SupplementAppealRequest{StoreID: 51, AppealID: 900, Content: "补充别家的申诉"})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+		_, err = s.WithdrawAppeal(ctx, &pb.WithdrawAppealRequest{StoreID: 51, AppealID: 900})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		_, err = s.WithdrawAppeal(ctx, &pb.WithdrawAppealRequest{StoreID: 51, AppealID: appeal.AppealID, Reason: "和顾客协商好了"})
+		convey.So(err, convey.ShouldBeNil)
+		//撤回之后不能再补充，但可以发起下一轮
+		_, err = s.SupplementAppeal(ctx, &pb.SupplementAppealRequest{StoreID: 51, AppealID: appeal.AppealID, Content: "撤回后再补充"})
+		convey.So(v1.IsAppealAudited(err), convey.ShouldBeTrue)
+		next, err := s.AppealReview(ctx, &pb.AppealReviewRequest{StoreID: 51, ReviewID: 1, Reason: "重新申诉下", Content: "协商没有结果"})
+		convey.So(err, convey.ShouldBeNil)
+
+		history, err := s.GetAppealHistory(ctx, &pb.GetAppealHistoryRequest{StoreID: 51, ReviewID: 1})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(history.Appeals), convey.ShouldEqual, 2)
+		convey.So(history.Appeals[0].Status, convey.ShouldEqual, 50)
+		convey.So(history.Appeals[0].PicInfo, convey.ShouldEqual, "a.jpg")
+		convey.So(history.Appeals[1].AppealID, convey.ShouldEqual, next.AppealID)
+		convey.So(history.Appeals[1].Round, convey.ShouldEqual, 2)
+		convey.So(len(history.Messages), convey.ShouldEqual, 4)
+		var actions []int32
+		for _, m := range history.Messages {
+			actions = append(actions, m.Action)
+		}
+		convey.So(actions, convey.ShouldResemble, []int32{1, 2, 5, 1})
+		convey.So(history.Messages[1].PicInfo, convey.ShouldEqual, "b.jpg")
+		convey.So(history.Messages[2].Content, convey.ShouldEqual, "和顾客协商好了")
+		convey.So(history.Messages[2].Sender, convey.ShouldEqual, "51")
+		convey.So(history.Messages[2].CreateAt, convey.ShouldEqual, 1714536000)
+		//别家评价的申诉记录看不到
+		_, err = s.GetAppealHistory(ctx, &pb.GetAppealHistoryRequest{StoreID: 51, ReviewID: 2})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		for _, role := range fake.callerRoles() {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+	})
+}
@@ -3,7 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"io"
+	"net/http"
+	"net/url"
 	"review-b/internal/biz"
+	"strconv"
+	"time"
 
 	pb "review-b/api/business/v1"
 )
@@ -62,3 +69,442 @@ func (s *BusinessService) ReplyReviewUpdate(ctx context.Context, req *pb.ReplyRe
 	}
 	return &pb.ReplyReviewUpdateReply{ReplyID: req.ReplyID, Rowsaffected: rowaffectedd}, nil
 }
+
+func (s *BusinessService) ListStoreReviews(ctx context.Context, req *pb.ListStoreReviewsRequest) (*pb.ListStoreReviewsReply, error) {
+	fmt.Printf("[service] ListStoreReviews, req:#%v\n", req)
+	param := &biz.StoreReviewParam{
+		StoreID:   req.StoreID,
+		Unreplied: req.Unreplied,
+		MaxScore:  req.MaxScore,
+		HasMedia:  req.HasMedia,
+		Page:      req.Page,
+		Size:      req.Size,
+	}
+	if req.StartTime > 0 {
+		param.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		param.EndTime = time.Unix(req.EndTime, 0)
+	}
+	ret, total, err := s.uc.ListStoreReviews(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.StoreReviewInfo, 0, len(ret))
+	for _, r := range ret {
+		list = append(list, &pb.StoreReviewInfo{
+			ReviewID:     r.ReviewID,
+			UserID:       r.UserID,
+			Nickname:     r.Nickname,
+			OrderID:      r.OrderID,
+			Score:        r.Score,
+			ServiceScore: r.ServiceScore,
+			ExpressScore: r.ExpressScore,
+			Content:      r.Content,
+			PicInfo:      r.PicInfo,
+			VideoInfo:    r.VideoInfo,
+			Status:       r.Status,
+			Anonymous:    r.Anonymous,
+			HasReply:     r.HasReply,
+			CreateAt:     r.CreateAt.Unix(),
+		})
+	}
+	return &pb.ListStoreReviewsReply{List: list, Total: total}, nil
+}
+
+// CreateReviewExport 异步导出本店评价，筛选条件同ListStoreReviews
+func (s *BusinessService) CreateReviewExport(ctx context.Context, req *pb.CreateReviewExportRequest) (*pb.CreateReviewExportReply, error) {
+	fmt.Printf("[service] CreateReviewExport, req:#%v\n", req)
+	param := &biz.ReviewExportParam{
+		StoreReviewParam: biz.StoreReviewParam{
+			StoreID:   req.StoreID,
+			Unreplied: req.Unreplied,
+			MaxScore:  req.MaxScore,
+			HasMedia:  req.HasMedia,
+		},
+		Format: req.Format,
+	}
+	if req.StartTime > 0 {
+		param.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		param.EndTime = time.Unix(req.EndTime, 0)
+	}
+	exportID, err := s.uc.CreateReviewExport(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateReviewExportReply{ExportID: exportID}, nil
+}
+
+func (s *BusinessService) GetReviewExport(ctx context.Context, req *pb.GetReviewExportRequest) (*pb.GetReviewExportReply, error) {
+	fmt.Printf("[service] GetReviewExport, req:#%v\n", req)
+	export, err := s.uc.GetReviewExport(ctx, req.StoreID, req.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetReviewExportReply{Export: toExportInfo(export)}, nil
+}
+
+func (s *BusinessService) ListReviewExports(ctx context.Context, req *pb.ListReviewExportsRequest) (*pb.ListReviewExportsReply, error) {
+	fmt.Printf("[service] ListReviewExports, req:#%v\n", req)
+	ret, total, err := s.uc.ListReviewExports(ctx, req.StoreID, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewExportInfo, 0, len(ret))
+	for _, e := range ret {
+		list = append(list, toExportInfo(e))
+	}
+	return &pb.ListReviewExportsReply{List: list, Total: total}, nil
+}
+
+// DownloadReviewExport 下载导出文件，GET business/v1/review/export/{exportID}/download?storeID=
+// 对象存储跳转到预签名地址，本地存储由review-service分块传过来
+func (s *BusinessService) DownloadReviewExport(ctx khttp.Context) error {
+	var req pb.GetReviewExportRequest
+	if err := ctx.BindQuery(&req); err != nil {
+		return err
+	}
+	if err := ctx.BindVars(&req); err != nil {
+		return err
+	}
+	if err := req.Validate(); err != nil {
+		return errors.BadRequest("VALIDATOR", err.Error())
+	}
+	khttp.SetOperation(ctx, "/api.business.v1.Business/DownloadReviewExport")
+	type opened struct {
+		export *biz.ReviewExport
+		body   io.ReadCloser
+	}
+	h := ctx.Middleware(func(c context.Context, r interface{}) (interface{}, error) {
+		in := r.(*pb.GetReviewExportRequest)
+		export, body, err := s.uc.OpenReviewExport(c, in.StoreID, in.ExportID)
+		if err != nil {
+			return nil, err
+		}
+		return &opened{export: export, body: body}, nil
+	})
+	out, err := h(ctx, &req)
+	if err != nil {
+		return err
+	}
+	ret := out.(*opened)
+	if ret.body == nil {
+		http.Redirect(ctx.Response(), ctx.Request(), ret.export.DownloadURL, http.StatusFound)
+		return nil
+	}
+	defer ret.body.Close()
+	header := ctx.Response().Header()
+	header.Set("Content-Type", exportContentType[ret.export.Format])
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(ret.export.FileName)))
+	header.Set("Content-Length", strconv.FormatInt(ret.export.FileSize, 10))
+	//响应头已经发出，中途出错只能断开连接
+	_, err = io.Copy(ctx.Response(), ret.body)
+	return err
+}
+
+var exportContentType = map[string]string{
+	"csv":  "text/csv; charset=utf-8",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+func toExportInfo(e *biz.ReviewExport) *pb.ReviewExportInfo {
+	info := &pb.ReviewExportInfo{
+		ExportID:    e.ExportID,
+		Format:      e.Format,
+		Status:      e.Status,
+		RowCount:    e.RowCount,
+		FileSize:    e.FileSize,
+		FileName:    e.FileName,
+		Error:       e.Error,
+		DownloadURL: e.DownloadURL,
+		CreateAt:    e.CreateAt.Unix(),
+	}
+	if !e.FinishAt.IsZero() {
+		info.FinishAt = e.FinishAt.Unix()
+	}
+	if !e.ExpireAt.IsZero() {
+		info.ExpireAt = e.ExpireAt.Unix()
+	}
+	return info
+}
+
+func (s *BusinessService) ListStoreAppeals(ctx context.Context, req *pb.ListStoreAppealsRequest) (*pb.ListStoreAppealsReply, error) {
+	fmt.Printf("[service] ListStoreAppeals, req:#%v\n", req)
+	ret, total, err := s.uc.ListStoreAppeals(ctx, &biz.StoreAppealParam{
+		StoreID: req.StoreID,
+		Status:  req.Status,
+		Page:    req.Page,
+		Size:    req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AppealInfo, 0, len(ret))
+	for _, a := range ret {
+		list = append(list, toAppealInfo(a))
+	}
+	return &pb.ListStoreAppealsReply{List: list, Total: total}, nil
+}
+
+func (s *BusinessService) GetAppeal(ctx context.Context, req *pb.GetAppealRequest) (*pb.GetAppealReply, error) {
+	fmt.Printf("[service] GetAppeal, req:#%v\n", req)
+	appeal, err := s.uc.GetAppeal(ctx, req.StoreID, req.AppealID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAppealReply{Appeal: toAppealInfo(appeal)}, nil
+}
+
+func toAppealInfo(a *biz.Appeal) *pb.AppealInfo {
+	return &pb.AppealInfo{
+		AppealID:  a.AppealID,
+		ReviewID:  a.ReviewID,
+		StoreID:   a.StoreID,
+		Status:    a.Status,
+		Reason:    a.Reason,
+		Content:   a.Content,
+		PicInfo:   a.PicInfo,
+		VideoInfo: a.VideoInfo,
+		OpRemarks: a.OpRemarks,
+		Round:     a.Round,
+		CreateAt:  a.CreateAt.Unix(),
+		UpdateAt:  a.UpdateAt.Unix(),
+	}
+}
+
+func (s *BusinessService) SupplementAppeal(ctx context.Context, req *pb.SupplementAppealRequest) (*pb.SupplementAppealReply, error) {
+	fmt.Printf("[service] SupplementAppeal, req:#%v\n", req)
+	err := s.uc.SupplementAppeal(ctx, &biz.SupplementAppealParam{
+		StoreID:   req.StoreID,
+		AppealID:  req.AppealID,
+		Content:   req.Content,
+		PicInfo:   req.PicInfo,
+		VideoInfo: req.VideoInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SupplementAppealReply{}, nil
+}
+
+func (s *BusinessService) WithdrawAppeal(ctx context.Context, req *pb.WithdrawAppealRequest) (*pb.WithdrawAppealReply, error) {
+	fmt.Printf("[service] WithdrawAppeal, req:#%v\n", req)
+	if err := s.uc.WithdrawAppeal(ctx, req.StoreID, req.AppealID, req.Reason); err != nil {
+		return nil, err
+	}
+	return &pb.WithdrawAppealReply{}, nil
+}
+
+func (s *BusinessService) GetAppealHistory(ctx context.Context, req *pb.GetAppealHistoryRequest) (*pb.GetAppealHistoryReply, error) {
+	fmt.Printf("[service] GetAppealHistory, req:#%v\n", req)
+	history, err := s.uc.GetAppealHistory(ctx, req.StoreID, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	appeals := make([]*pb.AppealInfo, 0, len(history.Appeals))
+	for _, a := range history.Appeals {
+		appeals = append(appeals, toAppealInfo(a))
+	}
+	messages := make([]*pb.AppealMessageInfo, 0, len(history.Messages))
+	for _, m := range history.Messages {
+		messages = append(messages, &pb.AppealMessageInfo{
+			MessageID:  m.MessageID,
+			AppealID:   m.AppealID,
+			SenderType: m.SenderType,
+			Sender:     m.Sender,
+			Action:     m.Action,
+			Content:    m.Content,
+			PicInfo:    m.PicInfo,
+			VideoInfo:  m.VideoInfo,
+			CreateAt:   m.CreateAt.Unix(),
+		})
+	}
+	return &pb.GetAppealHistoryReply{Appeals: appeals, Messages: messages}, nil
+}
+
+func (s *BusinessService) CreateReplyTemplate(ctx context.Context, req *pb.CreateReplyTemplateRequest) (*pb.CreateReplyTemplateReply, error) {
+	fmt.Printf("[service] CreateReplyTemplate, req:#%v\n", req)
+	templateID, err := s.uc.CreateTemplate(ctx, &biz.ReplyTemplate{
+		StoreID: req.StoreID,
+		Name:    req.Name,
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateReplyTemplateReply{TemplateID: templateID}, nil
+}
+
+func (s *BusinessService) UpdateReplyTemplate(ctx context.Context, req *pb.UpdateReplyTemplateRequest) (*pb.UpdateReplyTemplateReply, error) {
+	fmt.Printf("[service] UpdateReplyTemplate, req:#%v\n", req)
+	err := s.uc.UpdateTemplate(ctx, &biz.ReplyTemplate{
+		StoreID:    req.StoreID,
+		TemplateID: req.TemplateID,
+		Name:       req.Name,
+		Content:    req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReplyTemplateReply{}, nil
+}
+
+func (s *BusinessService) DeleteReplyTemplate(ctx context.Context, req *pb.DeleteReplyTemplateRequest) (*pb.DeleteReplyTemplateReply, error) {
+	fmt.Printf("[service] DeleteReplyTemplate, req:#%v\n", req)
+	if err := s.uc.DeleteTemplate(ctx, req.StoreID, req.TemplateID); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteReplyTemplateReply{}, nil
+}
+
+func (s *BusinessService) ListReplyTemplates(ctx context.Context, req *pb.ListReplyTemplatesRequest) (*pb.ListReplyTemplatesReply, error) {
+	fmt.Printf("[service] ListReplyTemplates, req:#%v\n", req)
+	ret, err := s.uc.ListTemplates(ctx, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReplyTemplateInfo, 0, len(ret))
+	for _, t := range ret {
+		list = append(list, &pb.ReplyTemplateInfo{
+			TemplateID: t.TemplateID,
+			Name:       t.Name,
+			Content:    t.Content,
+			UpdateAt:   t.UpdateAt.Unix(),
+		})
+	}
+	return &pb.ListReplyTemplatesReply{List: list}, nil
+}
+
+func (s *BusinessService) CreateAutoReplyRule(ctx context.Context, req *pb.CreateAutoReplyRuleRequest) (*pb.CreateAutoReplyRuleReply, error) {
+	fmt.Printf("[service] CreateAutoReplyRule, req:#%v\n", req)
+	ruleID, err := s.uc.CreateAutoReplyRule(ctx, &biz.AutoReplyRule{
+		StoreID:      req.StoreID,
+		TemplateID:   req.TemplateID,
+		MinScore:     req.MinScore,
+		MaxScore:     req.MaxScore,
+		OnlyEmpty:    req.OnlyEmpty,
+		DelaySeconds: req.DelaySeconds,
+		Enabled:      req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateAutoReplyRuleReply{RuleID: ruleID}, nil
+}
+
+func (s *BusinessService) UpdateAutoReplyRule(ctx context.Context, req *pb.UpdateAutoReplyRuleRequest) (*pb.UpdateAutoReplyRuleReply, error) {
+	fmt.Printf("[service] UpdateAutoReplyRule, req:#%v\n", req)
+	err := s.uc.UpdateAutoReplyRule(ctx, &biz.AutoReplyRule{
+		StoreID:      req.StoreID,
+		RuleID:       req.RuleID,
+		TemplateID:   req.TemplateID,
+		MinScore:     req.MinScore,
+		MaxScore:     req.MaxScore,
+		OnlyEmpty:    req.OnlyEmpty,
+		DelaySeconds: req.DelaySeconds,
+		Enabled:      req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateAutoReplyRuleReply{}, nil
+}
+
+func (s *BusinessService) DeleteAutoReplyRule(ctx context.Context, req *pb.DeleteAutoReplyRuleRequest) (*pb.DeleteAutoReplyRuleReply, error) {
+	fmt.Printf("[service] DeleteAutoReplyRule, req:#%v\n", req)
+	if err := s.uc.DeleteAutoReplyRule(ctx, req.StoreID, req.RuleID); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAutoReplyRuleReply{}, nil
+}
+
+func (s *BusinessService) ListAutoReplyRules(ctx context.Context, req *pb.ListAutoReplyRulesRequest) (*pb.ListAutoReplyRulesReply, error) {
+	fmt.Printf("[service] ListAutoReplyRules, req:#%v\n", req)
+	ret, err := s.uc.ListAutoReplyRules(ctx, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AutoReplyRuleInfo, 0, len(ret))
+	for _, r := range ret {
+		list = append(list, &pb.AutoReplyRuleInfo{
+			RuleID:       r.RuleID,
+			TemplateID:   r.TemplateID,
+			MinScore:     r.MinScore,
+			MaxScore:     r.MaxScore,
+			OnlyEmpty:    r.OnlyEmpty,
+			DelaySeconds: r.DelaySeconds,
+			Enabled:      r.Enabled,
+		})
+	}
+	return &pb.ListAutoReplyRulesReply{List: list}, nil
+}
+
+func (s *BusinessService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookReply, error) {
+	fmt.Printf("[service] CreateWebhook, req:#%v\n", req)
+	webhook, err := s.uc.CreateWebhook(ctx, &biz.Webhook{
+		StoreID: req.StoreID,
+		URL:     req.Url,
+		Events:  req.Events,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateWebhookReply{SubscriptionID: webhook.SubscriptionID, Secret: webhook.Secret}, nil
+}
+
+func (s *BusinessService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookReply, error) {
+	fmt.Printf("[service] DeleteWebhook, req:#%v\n", req)
+	if err := s.uc.DeleteWebhook(ctx, req.StoreID, req.SubscriptionID); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteWebhookReply{}, nil
+}
+
+func (s *BusinessService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksReply, error) {
+	fmt.Printf("[service] ListWebhooks, req:#%v\n", req)
+	ret, err := s.uc.ListWebhooks(ctx, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.WebhookInfo, 0, len(ret))
+	for _, w := range ret {
+		list = append(list, &pb.WebhookInfo{
+			SubscriptionID: w.SubscriptionID,
+			Url:            w.URL,
+			Events:         w.Events,
+			CreateAt:       w.CreateAt.Unix(),
+		})
+	}
+	return &pb.ListWebhooksReply{List: list}, nil
+}
+
+func (s *BusinessService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesReply, error) {
+	fmt.Printf("[service] ListWebhookDeliveries, req:#%v\n", req)
+	ret, total, err := s.uc.ListWebhookDeliveries(ctx, &biz.WebhookDeliveryParam{
+		StoreID:        req.StoreID,
+		SubscriptionID: req.SubscriptionID,
+		Status:         req.Status,
+		Page:           req.Page,
+		Size:           req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.WebhookDeliveryInfo, 0, len(ret))
+	for _, d := range ret {
+		list = append(list, &pb.WebhookDeliveryInfo{
+			DeliveryID:   d.DeliveryID,
+			Event:        d.Event,
+			Payload:      d.Payload,
+			Status:       d.Status,
+			Attempts:     d.Attempts,
+			ResponseCode: d.ResponseCode,
+			LastError:    d.LastError,
+			NextRetryAt:  d.NextRetryAt.Unix(),
+			CreateAt:     d.CreateAt.Unix(),
+		})
+	}
+	return &pb.ListWebhookDeliveriesReply{List: list, Total: total}, nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	pb "review-b/api/business/v1"
+	v1 "review-b/api/review/v1"
+	"testing"
+	"time"
+)
+
+func TestReviewExports(t *testing.T) {
+	convey.Convey("merchants create and look up exports of their own store", t, func() {
+		fake := &fakeReview{
+			exports:      []*v1.ReviewExportInfo{{ExportID: 701, Format: "csv", Status: 30}},
+			exportStores: map[int64]int64{701: 72},
+			nextID:       1000,
+		}
+		s := newTestService(t, fake)
+		ctx := context.Background()
+
+		created, err := s.CreateReviewExport(ctx, &pb.CreateReviewExportRequest{StoreID: 71, Format: "xlsx", Unreplied: true, MaxScore: 2, HasMedia: 1, StartTime: 1714000000})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(created.ExportID, convey.ShouldBeGreaterThan, 0)
+		req := fake.exportReqs[0]
+		convey.So(req.StoreID, convey.ShouldEqual, 71)
+		convey.So(req.Format, convey.ShouldEqual, "xlsx")
+		convey.So(req.Unreplied, convey.ShouldBeTrue)
+		convey.So(req.MaxScore, convey.ShouldEqual, 2)
+		convey.So(req.HasMedia, convey.ShouldEqual, 1)
+		convey.So(req.StartTime, convey.ShouldEqual, 1714000000)
+		//没传结束时间时不限制
+		convey.So(req.EndTime, convey.ShouldEqual, 0)
+
+		//review-service生成完成后回填的字段
+		done := fake.exports[1]
+		done.Status, done.RowCount, done.FileSize, done.FileName = 30, 120, 4096, "评价导出.xlsx"
+		done.FinishAt = timestamppb.New(time.Unix(1714536060, 0))
+		done.ExpireAt = timestamppb.New(time.Unix(1715140860, 0))
+		got, err := s.GetReviewExport(ctx, &pb.GetReviewExportRequest{StoreID: 71, ExportID: created.ExportID})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(got.Export.Format, convey.ShouldEqual, "xlsx")
+		convey.So(got.Export.RowCount, convey.ShouldEqual, 120)
+		convey.So(got.Export.FileName, convey.ShouldEqual, "评价导出.xlsx")
+		convey.So(got.Export.CreateAt, convey.ShouldEqual, 1714536000)
+		convey.So(got.Export.FinishAt, convey.ShouldEqual, 1714536060)
+		convey.So(got.Export.ExpireAt, convey.ShouldEqual, 1715140860)
+
+		list, err := s.ListReviewExports(ctx, &pb.ListReviewExportsRequest{StoreID: 71, Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(list.Total, convey.ShouldEqual, 1)
+		convey.So(list.List[0].ExportID, convey.ShouldEqual, created.ExportID)
+		//排队中的任务没有完成和过期时间
+		fake.exports[1].FinishAt, fake.exports[1].ExpireAt = nil, nil
+		list, err = s.ListReviewExports(ctx, &pb.ListReviewExportsRequest{StoreID: 71, Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(list.List[0].FinishAt, convey.ShouldEqual, 0)
+		convey.So(list.List[0].ExpireAt, convey.ShouldEqual, 0)
+
+		//别家的导出任务查不到
+		_, err = s.GetReviewExport(ctx, &pb.GetReviewExportRequest{StoreID: 71, ExportID: 701})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		for _, role := range fake.callerRoles() {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+	})
+}
+
+func TestDownloadReviewExport(t *testing.T) {
+	fake := &fakeReview{
+		exports: []*v1.ReviewExportInfo{
+			{ExportID: 801, Format: "csv", Status: 30, FileName: "评价 导出.csv", FileSize: 23},
+			{ExportID: 802, Format: "xlsx", Status: 30, DownloadURL: "https://oss.example.com/export/802.xlsx?sign=abc"},
+			{ExportID: 803, Format: "csv", Status: 20},
+			{ExportID: 804, Format: "csv", Status: 30, FileName: "别家.csv", FileSize: 6},
+		},
+		exportStores: map[int64]int64{801: 81, 802: 81, 803: 81, 804: 82},
+		files:        map[int64][]byte{801: []byte("reviewID,score\n1,5\n2,4\n"), 804: []byte("secret")},
+	}
+	s := newTestService(t, fake)
+	srv := khttp.NewServer()
+	srv.Route("/").GET("/business/v1/review/export/{exportID}/download", s.DownloadReviewExport)
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	get := func(path string) (*http.Response, string) {
+		resp, err := client.Get(ts.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp, string(body)
+	}
+
+	convey.Convey("a locally stored file is streamed from review-service in chunks", t, func() {
+		resp, body := get("/business/v1/review/export/801/download?storeID=81")
+		convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusOK)
+		convey.So(body, convey.ShouldEqual, "reviewID,score\n1,5\n2,4\n")
+		convey.So(resp.Header.Get("Content-Type"), convey.ShouldEqual, "text/csv; charset=utf-8")
+		convey.So(resp.Header.Get("Content-Disposition"), convey.ShouldEqual, "attachment; filename*=UTF-8''%E8%AF%84%E4%BB%B7%20%E5%AF%BC%E5%87%BA.csv")
+		convey.So(resp.Header.Get("Content-Length"), convey.ShouldEqual, "23")
+	})
+
+	convey.Convey("a file in object storage redirects to the presigned url", t, func() {
+		resp, _ := get("/business/v1/review/export/802/download?storeID=81")
+		convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusFound)
+		convey.So(resp.Header.Get("Location"), convey.ShouldEqual, "https://oss.example.com/export/802.xlsx?sign=abc")
+	})
+
+	convey.Convey("unfinished and other stores' exports are refused before any body is sent", t, func() {
+		resp, body := get("/business/v1/review/export/803/download?storeID=81")
+		//review-service在第一条消息就返回错误，不会先发响应头
+		convey.So(resp.Header.Get("Content-Disposition"), convey.ShouldBeEmpty)
+		convey.So(body, convey.ShouldContainSubstring, v1.ErrorReason_INVALID_PARAMS.String())
+		resp, body = get("/business/v1/review/export/804/download?storeID=81")
+		convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusNotFound)
+		convey.So(body, convey.ShouldNotContainSubstring, "secret")
+	})
+
+	convey.Convey("the stream carries the merchant role and unary calls are signed", t, func() {
+		convey.So(len(fake.streamRoles), convey.ShouldEqual, 2)
+		for _, role := range fake.streamRoles {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+		for _, role := range fake.callerRoles() {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+	})
+}
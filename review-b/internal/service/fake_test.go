@@ -0,0 +1,551 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+	v1 "review-b/api/review/v1"
+	"review-b/internal/biz"
+	"review-b/internal/conf"
+	"review-b/internal/data"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testRoleSecret = "merchant-secret"
+
+// fakeReview review-service的假实现，和真实服务一样只返回请求里店铺的数据
+type fakeReview struct {
+	v1.UnimplementedReviewServer
+
+	mu    sync.Mutex
+	roles []string //每次调用校验通过的角色，签名不对时记为空
+
+	reviews  []*v1.StoreReviewInfo
+	stores   map[int64]int64 //评价ID到店铺ID
+	appeals  []*v1.AppealInfo
+	messages []*v1.AppealMessageInfo
+
+	nextID    int64
+	templates []*v1.ReplyTemplateInfo
+	rules     []*v1.AutoReplyRuleInfo
+
+	webhooks   []*v1.WebhookInfo
+	deliveries map[int64][]*v1.WebhookDeliveryInfo //订阅ID到投递记录
+
+	exports      []*v1.ReviewExportInfo
+	exportStores map[int64]int64 //导出任务ID到店铺ID
+	exportReqs   []*v1.CreateReviewExportRequest
+	files        map[int64][]byte //导出任务ID到文件内容
+	streamRoles  []string         //流式调用带的角色，review-service的流式接口不走中间件，不带签名
+}
+
+// role 记录调用方角色，和review-service一样校验签名
+func (f *fakeReview) role(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	role, sign := first(md.Get("x-md-local-role")), first(md.Get("x-role-sign"))
+	ts, _, _ := strings.Cut(sign, ":")
+	mac := hmac.New(sha256.New, []byte(testRoleSecret))
+	mac.Write([]byte(role + ":" + ts))
+	if sign != ts+":"+hex.EncodeToString(mac.Sum(nil)) {
+		role = ""
+	}
+	f.mu.Lock()
+	f.roles = append(f.roles, role)
+	f.mu.Unlock()
+}
+
+func (f *fakeReview) callerRoles() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.roles...)
+}
+
+func first(vals []string) string {
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+func (f *fakeReview) ListStoreReviews(ctx context.Context, req *v1.ListStoreReviewsRequest) (*v1.ListStoreReviewsReply, error) {
+	f.role(ctx)
+	var list []*v1.StoreReviewInfo
+	for _, r := range f.reviews {
+		if f.stores[r.ReviewID] != req.StoreID {
+			continue
+		}
+		if req.Unreplied && r.HasReply {
+			continue
+		}
+		if req.MaxScore > 0 && r.Score > req.MaxScore {
+			continue
+		}
+		if req.StartTime > 0 && r.CreateAt < req.StartTime || req.EndTime > 0 && r.CreateAt >= req.EndTime {
+			continue
+		}
+		list = append(list, r)
+	}
+	return &v1.ListStoreReviewsReply{List: list, Total: int64(len(list))}, nil
+}
+
+func (f *fakeReview) ListStoreAppeals(ctx context.Context, req *v1.ListStoreAppealsRequest) (*v1.ListStoreAppealsReply, error) {
+	f.role(ctx)
+	var list []*v1.AppealInfo
+	for _, a := range f.appeals {
+		if a.StoreID == req.StoreID && (req.Status == 0 || a.Status == req.Status) {
+			list = append(list, a)
+		}
+	}
+	return &v1.ListStoreAppealsReply{List: list, Total: int64(len(list))}, nil
+}
+
+func (f *fakeReview) GetAppeal(ctx context.Context, req *v1.GetAppealRequest) (*v1.GetAppealReply, error) {
+	f.role(ctx)
+	for _, a := range f.appeals {
+		if a.AppealID == req.AppealID && a.StoreID == req.StoreID {
+			return &v1.GetAppealReply{Appeal: a}, nil
+		}
+	}
+	return nil, v1.ErrorNotFound("没有这个申诉")
+}
+
+func (f *fakeReview) AppealReview(ctx context.Context, req *v1.AppealReviewRequest) (*v1.AppealReviewReply, error) {
+	f.role(ctx)
+	if f.stores[req.ReviewID] != req.StoreID {
+		return nil, v1.ErrorInvalidParams("参数有误，StoreID不匹配")
+	}
+	var round int32
+	for _, a := range f.appeals {
+		if a.ReviewID != req.ReviewID {
+			continue
+		}
+		if a.Status == 10 || a.Status == 40 {
+			return nil, v1.ErrorReviewAppealed("该评价有处理中的申诉")
+		}
+		if a.Round > round {
+			round = a.Round
+		}
+	}
+	a := &v1.AppealInfo{
+		AppealID:  f.genID(),
+		ReviewID:  req.ReviewID,
+		StoreID:   req.StoreID,
+		Status:    10,
+		Reason:    req.Reason,
+		Content:   req.Content,
+		PicInfo:   req.PicInfo,
+		VideoInfo: req.VideoInfo,
+		Round:     round + 1,
+	}
+	f.appeals = append(f.appeals, a)
+	f.message(a, 1, req.Content, req.PicInfo, req.VideoInfo)
+	return &v1.AppealReviewReply{AppealID: a.AppealID}, nil
+}
+
+// transit 处理中的申诉才能补充或撤回
+func (f *fakeReview) transit(storeID, appealID int64, status, action int32, content, picInfo, videoInfo string) error {
+	for _, a := range f.appeals {
+		if a.AppealID != appealID || a.StoreID != storeID {
+			continue
+		}
+		if a.Status != 10 && a.Status != 40 {
+			return v1.ErrorAppealAudited("申诉已处理")
+		}
+		a.Status = status
+		f.message(a, action, content, picInfo, videoInfo)
+		return nil
+	}
+	return v1.ErrorNotFound("没有这个申诉")
+}
+
+func (f *fakeReview) message(a *v1.AppealInfo, action int32, content, picInfo, videoInfo string) {
+	f.messages = append(f.messages, &v1.AppealMessageInfo{
+		MessageID:  f.genID(),
+		AppealID:   a.AppealID,
+		SenderType: 1,
+		Sender:     strconv.FormatInt(a.StoreID, 10),
+		Action:     action,
+		Content:    content,
+		PicInfo:    picInfo,
+		VideoInfo:  videoInfo,
+		CreateAt:   1714536000,
+	})
+}
+
+func (f *fakeReview) SupplementAppeal(ctx context.Context, req *v1.SupplementAppealRequest) (*v1.SupplementAppealReply, error) {
+	f.role(ctx)
+	if err := f.transit(req.StoreID, req.AppealID, 10, 2, req.Content, req.PicInfo, req.VideoInfo); err != nil {
+		return nil, err
+	}
+	return &v1.SupplementAppealReply{}, nil
+}
+
+func (f *fakeReview) WithdrawAppeal(ctx context.Context, req *v1.WithdrawAppealRequest) (*v1.WithdrawAppealReply, error) {
+	f.role(ctx)
+	if err := f.transit(req.StoreID, req.AppealID, 50, 5, req.Reason, "", ""); err != nil {
+		return nil, err
+	}
+	return &v1.WithdrawAppealReply{}, nil
+}
+
+func (f *fakeReview) GetAppealHistory(ctx context.Context, req *v1.GetAppealHistoryRequest) (*v1.GetAppealHistoryReply, error) {
+	f.role(ctx)
+	reply := &v1.GetAppealHistoryReply{}
+	ids := make(map[int64]bool)
+	for _, a := range f.appeals {
+		if a.ReviewID != req.ReviewID {
+			continue
+		}
+		if a.StoreID != req.StoreID {
+			return nil, v1.ErrorNotFound("没有这个申诉")
+		}
+		reply.Appeals = append(reply.Appeals, a)
+		ids[a.AppealID] = true
+	}
+	for _, m := range f.messages {
+		if ids[m.AppealID] {
+			reply.Messages = append(reply.Messages, m)
+		}
+	}
+	return reply, nil
+}
+
+func (f *fakeReview) genID() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	return f.nextID
+}
+
+// template 只在店铺自己的模板里找
+func (f *fakeReview) template(storeID, templateID int64) *v1.ReplyTemplateInfo {
+	for _, t := range f.templates {
+		if t.TemplateID == templateID && t.StoreID == storeID {
+			return t
+		}
+	}
+	return nil
+}
+
+func (f *fakeReview) rule(storeID, ruleID int64) int {
+	for i, r := range f.rules {
+		if r.RuleID == ruleID && r.StoreID == storeID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *fakeReview) CreateReplyTemplate(ctx context.Context, req *v1.CreateReplyTemplateRequest) (*v1.CreateReplyTemplateReply, error) {
+	f.role(ctx)
+	t := &v1.ReplyTemplateInfo{TemplateID: f.genID(), StoreID: req.StoreID, Name: req.Name, Content: req.Content, UpdateAt: 1714536000}
+	f.templates = append(f.templates, t)
+	return &v1.CreateReplyTemplateReply{TemplateID: t.TemplateID}, nil
+}
+
+func (f *fakeReview) UpdateReplyTemplate(ctx context.Context, req *v1.UpdateReplyTemplateRequest) (*v1.UpdateReplyTemplateReply, error) {
+	f.role(ctx)
+	t := f.template(req.StoreID, req.TemplateID)
+	if t == nil {
+		return nil, v1.ErrorNotFound("没有这个模板")
+	}
+	t.Name, t.Content = req.Name, req.Content
+	return &v1.UpdateReplyTemplateReply{}, nil
+}
+
+func (f *fakeReview) DeleteReplyTemplate(ctx context.Context, req *v1.DeleteReplyTemplateRequest) (*v1.DeleteReplyTemplateReply, error) {
+	f.role(ctx)
+	if f.template(req.StoreID, req.TemplateID) == nil {
+		return nil, v1.ErrorNotFound("没有这个模板")
+	}
+	for _, r := range f.rules {
+		if r.TemplateID == req.TemplateID {
+			return nil, v1.ErrorInvalidParams("模板正在被自动回复规则使用")
+		}
+	}
+	var list []*v1.ReplyTemplateInfo
+	for _, t := range f.templates {
+		if t.TemplateID != req.TemplateID {
+			list = append(list, t)
+		}
+	}
+	f.templates = list
+	return &v1.DeleteReplyTemplateReply{}, nil
+}
+
+func (f *fakeReview) ListReplyTemplates(ctx context.Context, req *v1.ListReplyTemplatesRequest) (*v1.ListReplyTemplatesReply, error) {
+	f.role(ctx)
+	var list []*v1.ReplyTemplateInfo
+	for _, t := range f.templates {
+		if t.StoreID == req.StoreID {
+			list = append(list, t)
+		}
+	}
+	return &v1.ListReplyTemplatesReply{List: list}, nil
+}
+
+func (f *fakeReview) CreateAutoReplyRule(ctx context.Context, req *v1.CreateAutoReplyRuleRequest) (*v1.CreateAutoReplyRuleReply, error) {
+	f.role(ctx)
+	if f.template(req.StoreID, req.TemplateID) == nil {
+		return nil, v1.ErrorNotFound("没有这个模板")
+	}
+	r := &v1.AutoReplyRuleInfo{
+		RuleID:       f.genID(),
+		StoreID:      req.StoreID,
+		TemplateID:   req.TemplateID,
+		MinScore:     req.MinScore,
+		MaxScore:     req.MaxScore,
+		OnlyEmpty:    req.OnlyEmpty,
+		DelaySeconds: req.DelaySeconds,
+		Enabled:      req.Enabled,
+	}
+	f.rules = append(f.rules, r)
+	return &v1.CreateAutoReplyRuleReply{RuleID: r.RuleID}, nil
+}
+
+func (f *fakeReview) UpdateAutoReplyRule(ctx context.Context, req *v1.UpdateAutoReplyRuleRequest) (*v1.UpdateAutoReplyRuleReply, error) {
+	f.role(ctx)
+	i := f.rule(req.StoreID, req.RuleID)
+	if i < 0 || f.template(req.StoreID, req.TemplateID) == nil {
+		return nil, v1.ErrorNotFound("没有这个规则或模板")
+	}
+	f.rules[i] = &v1.AutoReplyRuleInfo{
+		RuleID:       req.RuleID,
+		StoreID:      req.StoreID,
+		TemplateID:   req.TemplateID,
+		MinScore:     req.MinScore,
+		MaxScore:     req.MaxScore,
+		OnlyEmpty:    req.OnlyEmpty,
+		DelaySeconds: req.DelaySeconds,
+		Enabled:      req.Enabled,
+	}
+	return &v1.UpdateAutoReplyRuleReply{}, nil
+}
+
+func (f *fakeReview) DeleteAutoReplyRule(ctx context.Context, req *v1.DeleteAutoReplyRuleRequest) (*v1.DeleteAutoReplyRuleReply, error) {
+	f.role(ctx)
+	i := f.rule(req.StoreID, req.RuleID)
+	if i < 0 {
+		return nil, v1.ErrorNotFound("没有这个规则")
+	}
+	f.rules = append(f.rules[:i], f.rules[i+1:]...)
+	return &v1.DeleteAutoReplyRuleReply{}, nil
+}
+
+func (f *fakeReview) ListAutoReplyRules(ctx context.Context, req *v1.ListAutoReplyRulesRequest) (*v1.ListAutoReplyRulesReply, error) {
+	f.role(ctx)
+	var list []*v1.AutoReplyRuleInfo
+	for _, r := range f.rules {
+		if r.StoreID == req.StoreID {
+			list = append(list, r)
+		}
+	}
+	return &v1.ListAutoReplyRulesReply{List: list}, nil
+}
+
+// webhook 订阅只归属于同一种owner下的同一个ID
+func (f *fakeReview) webhook(ownerType int32, ownerID, subscriptionID int64) int {
+	for i, w := range f.webhooks {
+		if w.SubscriptionID == subscriptionID && w.OwnerType == ownerType && w.OwnerID == ownerID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *fakeReview) CreateWebhook(ctx context.Context, req *v1.CreateWebhookRequest) (*v1.CreateWebhookReply, error) {
+	f.role(ctx)
+	w := &v1.WebhookInfo{
+		SubscriptionID: f.genID(),
+		OwnerType:      req.OwnerType,
+		OwnerID:        req.OwnerID,
+		Url:            req.Url,
+		Events:         req.Events,
+		CreateAt:       1714536000,
+	}
+	f.webhooks = append(f.webhooks, w)
+	return &v1.CreateWebhookReply{SubscriptionID: w.SubscriptionID, Secret: fmt.Sprintf("whsec-%d", w.SubscriptionID)}, nil
+}
+
+func (f *fakeReview) DeleteWebhook(ctx context.Context, req *v1.DeleteWebhookRequest) (*v1.DeleteWebhookReply, error) {
+	f.role(ctx)
+	i := f.webhook(req.OwnerType, req.OwnerID, req.SubscriptionID)
+	if i < 0 {
+		return nil, v1.ErrorNotFound("没有这个订阅")
+	}
+	f.webhooks = append(f.webhooks[:i], f.webhooks[i+1:]...)
+	return &v1.DeleteWebhookReply{}, nil
+}
+
+func (f *fakeReview) ListWebhooks(ctx context.Context, req *v1.ListWebhooksRequest) (*v1.ListWebhooksReply, error) {
+	f.role(ctx)
+	var list []*v1.WebhookInfo
+	for _, w := range f.webhooks {
+		if w.OwnerType == req.OwnerType && w.OwnerID == req.OwnerID {
+			list = append(list, w)
+		}
+	}
+	return &v1.ListWebhooksReply{List: list}, nil
+}
+
+func (f *fakeReview) ListWebhookDeliveries(ctx context.Context, req *v1.ListWebhookDeliveriesRequest) (*v1.ListWebhookDeliveriesReply, error) {
+	f.role(ctx)
+	if f.webhook(req.OwnerType, req.OwnerID, req.SubscriptionID) < 0 {
+		return nil, v1.ErrorNotFound("没有这个订阅")
+	}
+	var list []*v1.WebhookDeliveryInfo
+	for _, d := range f.deliveries[req.SubscriptionID] {
+		if req.Status == 0 || d.Status == req.Status {
+			list = append(list, d)
+		}
+	}
+	return &v1.ListWebhookDeliveriesReply{List: list, Total: int64(len(list))}, nil
+}
+
+func (f *fakeReview) CreateReviewExport(ctx context.Context, req *v1.CreateReviewExportRequest) (*v1.CreateReviewExportReply, error) {
+	f.role(ctx)
+	e := &v1.ReviewExportInfo{ExportID: f.genID(), Format: req.Format, Status: 10, CreateAt: timestamppb.New(time.Unix(1714536000, 0))}
+	f.mu.Lock()
+	f.exports = append(f.exports, e)
+	f.exportStores[e.ExportID] = req.StoreID
+	f.exportReqs = append(f.exportReqs, req)
+	f.mu.Unlock()
+	return &v1.CreateReviewExportReply{ExportID: e.ExportID}, nil
+}
+
+func (f *fakeReview) export(storeID int64, exportID int64) *v1.ReviewExportInfo {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, e := range f.exports {
+		if e.ExportID == exportID && f.exportStores[exportID] == storeID {
+			return e
+		}
+	}
+	return nil
+}
+
+func (f *fakeReview) GetReviewExport(ctx context.Context, req *v1.GetReviewExportRequest) (*v1.GetReviewExportReply, error) {
+	f.role(ctx)
+	e := f.export(req.StoreID, req.ExportID)
+	if e == nil {
+		return nil, v1.ErrorNotFound("没有这个导出任务")
+	}
+	return &v1.GetReviewExportReply{Export: e}, nil
+}
+
+func (f *fakeReview) ListReviewExports(ctx context.Context, req *v1.ListReviewExportsRequest) (*v1.ListReviewExportsReply, error) {
+	f.role(ctx)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var list []*v1.ReviewExportInfo
+	for _, e := range f.exports {
+		if f.exportStores[e.ExportID] == req.StoreID {
+			list = append(list, e)
+		}
+	}
+	return &v1.ListReviewExportsReply{List: list, Total: int64(len(list))}, nil
+}
+
+// DownloadReviewExport 按3字节一块发送，验证分块拼接
+func (f *fakeReview) DownloadReviewExport(req *v1.DownloadReviewExportRequest, stream v1.Review_DownloadReviewExportServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	f.mu.Lock()
+	f.streamRoles = append(f.streamRoles, first(md.Get("x-md-local-role")))
+	f.mu.Unlock()
+	e := f.export(req.StoreID, req.ExportID)
+	if e == nil {
+		return v1.ErrorNotFound("没有这个导出任务")
+	}
+	if e.Status != 30 {
+		return v1.ErrorInvalidParams("导出文件不可下载，状态:%d", e.Status)
+	}
+	reply := &v1.DownloadReviewExportReply{FileName: e.FileName, FileSize: e.FileSize}
+	for b := f.files[e.ExportID]; len(b) > 0; {
+		n := 3
+		if len(b) < n {
+			n = len(b)
+		}
+		reply.Chunk, b = b[:n], b[n:]
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+		reply = &v1.DownloadReviewExportReply{}
+	}
+	return nil
+}
+
+// staticDiscovery 固定返回一个review-service实例
+type staticDiscovery struct {
+	instance *registry.ServiceInstance
+}
+
+func (d *staticDiscovery) GetService(context.Context, string) ([]*registry.ServiceInstance, error) {
+	return []*registry.ServiceInstance{d.instance}, nil
+}
+
+func (d *staticDiscovery) Watch(ctx context.Context, _ string) (registry.Watcher, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &staticWatcher{d: d, ctx: ctx, cancel: cancel}, nil
+}
+
+type staticWatcher struct {
+	d      *staticDiscovery
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   bool
+}
+
+// Next 第一次返回实例，之后一直等到Stop
+func (w *staticWatcher) Next() ([]*registry.ServiceInstance, error) {
+	if !w.sent {
+		w.sent = true
+		return []*registry.ServiceInstance{w.d.instance}, nil
+	}
+	<-w.ctx.Done()
+	return nil, w.ctx.Err()
+}
+
+func (w *staticWatcher) Stop() error {
+	w.cancel()
+	return nil
+}
+
+// newTestService 起一个假的review-service，review-b按线上的方式连过去
+func newTestService(t *testing.T, fake *fakeReview) *BusinessService {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := ggrpc.NewServer()
+	v1.RegisterReviewServer(srv, fake)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	dis := &staticDiscovery{instance: &registry.ServiceInstance{
+		ID:        "review-service-test",
+		Name:      "review-service",
+		Endpoints: []string{fmt.Sprintf("grpc://%s", lis.Addr())},
+	}}
+	conn, cleanup, err := data.NewReviewServiceConn(dis, &conf.Client{RoleSecret: testRoleSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	d, _, err := data.NewData(&conf.Data{}, data.NewReviewServiceClient(conn), log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewBusinessService(biz.NewBusinessUsecase(data.NewBusinessRepo(d, log.DefaultLogger), log.DefaultLogger))
+}
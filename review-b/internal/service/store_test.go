@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	pb "review-b/api/business/v1"
+	v1 "review-b/api/review/v1"
+	"testing"
+	"time"
+)
+
+func TestStoreReads(t *testing.T) {
+	convey.Convey("merchants only read their own store through review-service", t, func() {
+		start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+		fake := &fakeReview{
+			reviews: []*v1.StoreReviewInfo{
+				{ReviewID: 1, Score: 5, HasReply: true, CreateAt: start.Unix()},
+				{ReviewID: 2, Score: 1, Anonymous: true, Nickname: "匿名用户", CreateAt: start.Add(time.Hour).Unix()},
+				{ReviewID: 3, Score: 2, CreateAt: start.Add(2 * time.Hour).Unix()},
+				{ReviewID: 4, Score: 1, CreateAt: start.Unix()},
+			},
+			stores: map[int64]int64{1: 31, 2: 31, 3: 31, 4: 32},
+			appeals: []*v1.AppealInfo{
+				{AppealID: 101, ReviewID: 2, StoreID: 31, Status: 30, OpRemarks: "证据不足", Round: 1},
+				{AppealID: 102, ReviewID: 2, StoreID: 31, Status: 10, Round: 2},
+				{AppealID: 103, ReviewID: 4, StoreID: 32, Status: 10, Round: 1},
+			},
+		}
+		s := newTestService(t, fake)
+		ctx := context.Background()
+
+		reviews, err := s.ListStoreReviews(ctx, &pb.ListStoreReviewsRequest{StoreID: 31, Unreplied: true, MaxScore: 2, Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(reviews.Total, convey.ShouldEqual, 2)
+		convey.So(reviews.List[0].ReviewID, convey.ShouldEqual, 2)
+		convey.So(reviews.List[0].Anonymous, convey.ShouldBeTrue)
+		convey.So(reviews.List[0].UserID, convey.ShouldEqual, 0)
+		convey.So(reviews.List[0].Nickname, convey.ShouldEqual, "匿名用户")
+		convey.So(reviews.List[0].CreateAt, convey.ShouldEqual, start.Add(time.Hour).Unix())
+		convey.So(reviews.List[1].ReviewID, convey.ShouldEqual, 3)
+		//时间范围原样带给review-service
+		reviews, err = s.ListStoreReviews(ctx, &pb.ListStoreReviewsRequest{StoreID: 31, StartTime: start.Add(time.Hour).Unix(), EndTime: start.Add(2 * time.Hour).Unix(), Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(reviews.Total, convey.ShouldEqual, 1)
+		convey.So(reviews.List[0].ReviewID, convey.ShouldEqual, 2)
+
+		appeals, err := s.ListStoreAppeals(ctx, &pb.ListStoreAppealsRequest{StoreID: 31, Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeals.Total, convey.ShouldEqual, 2)
+		appeals, err = s.ListStoreAppeals(ctx, &pb.ListStoreAppealsRequest{StoreID: 31, Status: 30, Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(appeals.List), convey.ShouldEqual, 1)
+		convey.So(appeals.List[0].OpRemarks, convey.ShouldEqual, "证据不足")
+
+		appeal, err := s.GetAppeal(ctx, &pb.GetAppealRequest{StoreID: 31, AppealID: 102})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeal.Appeal.Round, convey.ShouldEqual, 2)
+		//别家的申诉按不存在返回
+		_, err = s.GetAppeal(ctx, &pb.GetAppealRequest{StoreID: 31, AppealID: 103})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		//每次调用都带上签过名的商家角色
+		roles := fake.callerRoles()
+		convey.So(len(roles), convey.ShouldEqual, 6)
+		for _, role := range roles {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+	})
+}
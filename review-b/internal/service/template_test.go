@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	pb "review-b/api/business/v1"
+	v1 "review-b/api/review/v1"
+	"testing"
+)
+
+func TestReplyTemplates(t *testing.T) {
+	convey.Convey("merchants manage their own templates and auto-reply rules", t, func() {
+		fake := &fakeReview{}
+		s := newTestService(t, fake)
+		ctx := context.Background()
+
+		created, err := s.CreateReplyTemplate(ctx, &pb.CreateReplyTemplateRequest{StoreID: 41, Name: "好评", Content: "感谢您的好评，欢迎再来"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(created.TemplateID, convey.ShouldBeGreaterThan, 0)
+		other, err := s.CreateReplyTemplate(ctx, &pb.CreateReplyTemplateRequest{StoreID: 42, Name: "差评", Content: "非常抱歉给您带来不好的体验"})
+		convey.So(err, convey.ShouldBeNil)
+
+		_, err = s.UpdateReplyTemplate(ctx, &pb.UpdateReplyTemplateRequest{StoreID: 41, TemplateID: created.TemplateID, Name: "好评回复", Content: "感谢支持，期待再次光临"})
+		convey.So(err, convey.ShouldBeNil)
+		templates, err := s.ListReplyTemplates(ctx, &pb.ListReplyTemplatesRequest{StoreID: 41})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(templates.List), convey.ShouldEqual, 1)
+		convey.So(templates.List[0].TemplateID, convey.ShouldEqual, created.TemplateID)
+		convey.So(templates.List[0].Name, convey.ShouldEqual, "好评回复")
+		convey.So(templates.List[0].Content, convey.ShouldEqual, "感谢支持，期待再次光临")
+		convey.So(templates.List[0].UpdateAt, convey.ShouldEqual, 1714536000)
+
+		//别家的模板不能改也不能删
+		_, err = s.UpdateReplyTemplate(ctx, &pb.UpdateReplyTemplateRequest{StoreID: 41, TemplateID: other.TemplateID, Name: "改别人的", Content: "改别人的模板"})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+		_, err = s.DeleteReplyTemplate(ctx, &pb.DeleteReplyTemplateRequest{StoreID: 41, TemplateID: other.TemplateID})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		rule, err := s.CreateAutoReplyRule(ctx, &pb.CreateAutoReplyRuleRequest{StoreID: 41, TemplateID: created.TemplateID, MinScore: 4, MaxScore: 5, OnlyEmpty: true, DelaySeconds: 600, Enabled: true})
+		convey.So(err, convey.ShouldBeNil)
+		//规则不能引用别家的模板
+		_, err = s.CreateAutoReplyRule(ctx, &pb.CreateAutoReplyRuleRequest{StoreID: 41, TemplateID: other.TemplateID, MinScore: 1, MaxScore: 2})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+		rules, err := s.ListAutoReplyRules(ctx, &pb.ListAutoReplyRulesRequest{StoreID: 41})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(rules.List), convey.ShouldEqual, 1)
+		convey.So(rules.List[0].RuleID, convey.ShouldEqual, rule.RuleID)
+		convey.So(rules.List[0].TemplateID, convey.ShouldEqual, created.TemplateID)
+		convey.So(rules.List[0].MinScore, convey.ShouldEqual, 4)
+		convey.So(rules.List[0].MaxScore, convey.ShouldEqual, 5)
+		convey.So(rules.List[0].OnlyEmpty, convey.ShouldBeTrue)
+		convey.So(rules.List[0].DelaySeconds, convey.ShouldEqual, 600)
+		convey.So(rules.List[0].Enabled, convey.ShouldBeTrue)
+
+		_, err = s.UpdateAutoReplyRule(ctx, &pb.UpdateAutoReplyRuleRequest{StoreID: 41, RuleID: rule.RuleID, TemplateID: created.TemplateID, MinScore: 5, MaxScore: 5})
+		convey.So(err, convey.ShouldBeNil)
+		rules, err = s.ListAutoReplyRules(ctx, &pb.ListAutoReplyRulesRequest{StoreID: 41})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(rules.List[0].MinScore, convey.ShouldEqual, 5)
+		convey.So(rules.List[0].Enabled, convey.ShouldBeFalse)
+		_, err = s.UpdateAutoReplyRule(ctx, &pb.UpdateAutoReplyRuleRequest{StoreID: 42, RuleID: rule.RuleID, TemplateID: other.TemplateID, MinScore: 1, MaxScore: 1})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+		_, err = s.DeleteAutoReplyRule(ctx, &pb.DeleteAutoReplyRuleRequest{StoreID: 42, RuleID: rule.RuleID})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		//规则还在用时模板不能删，规则删掉之后才行
+		_, err = s.DeleteReplyTemplate(ctx, &pb.DeleteReplyTemplateRequest{StoreID: 41, TemplateID: created.TemplateID})
+		convey.So(v1.IsInvalidParams(err), convey.ShouldBeTrue)
+		_, err = s.DeleteAutoReplyRule(ctx, &pb.DeleteAutoReplyRuleRequest{StoreID: 41, RuleID: rule.RuleID})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = s.DeleteReplyTemplate(ctx, &pb.DeleteReplyTemplateRequest{StoreID: 41, TemplateID: created.TemplateID})
+		convey.So(err, convey.ShouldBeNil)
+		templates, err = s.ListReplyTemplates(ctx, &pb.ListReplyTemplatesRequest{StoreID: 41})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(templates.List), convey.ShouldEqual, 0)
+
+		for _, role := range fake.callerRoles() {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+	})
+}
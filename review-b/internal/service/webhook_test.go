@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	pb "review-b/api/business/v1"
+	v1 "review-b/api/review/v1"
+	"testing"
+)
+
+func TestWebhooks(t *testing.T) {
+	convey.Convey("merchants manage webhooks as store owners", t, func() {
+		fake := &fakeReview{
+			webhooks: []*v1.WebhookInfo{
+				//运营侧同ID的订阅和别家店铺的订阅都不能碰
+				{SubscriptionID: 801, OwnerType: 2, OwnerID: 61, Url: "https://op.example.com/hook", Events: []string{"review.created"}},
+				{SubscriptionID: 802, OwnerType: 1, OwnerID: 62, Url: "https://b.example.com/hook", Events: []string{"review.created"}},
+			},
+			deliveries: map[int64][]*v1.WebhookDeliveryInfo{
+				802: {{DeliveryID: 1, Event: "review.created", Status: 20}},
+			},
+			nextID: 1000,
+		}
+		s := newTestService(t, fake)
+		ctx := context.Background()
+
+		created, err := s.CreateWebhook(ctx, &pb.CreateWebhookRequest{StoreID: 61, Url: "https://a.example.com/hook", Events: []string{"review.created", "review.replied"}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(created.SubscriptionID, convey.ShouldBeGreaterThan, 0)
+		//密钥只在创建时返回
+		convey.So(created.Secret, convey.ShouldNotBeEmpty)
+		convey.So(fake.webhooks[2].OwnerType, convey.ShouldEqual, 1)
+		convey.So(fake.webhooks[2].OwnerID, convey.ShouldEqual, 61)
+
+		hooks, err := s.ListWebhooks(ctx, &pb.ListWebhooksRequest{StoreID: 61})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(hooks.List), convey.ShouldEqual, 1)
+		convey.So(hooks.List[0].SubscriptionID, convey.ShouldEqual, created.SubscriptionID)
+		convey.So(hooks.List[0].Url, convey.ShouldEqual, "https://a.example.com/hook")
+		convey.So(hooks.List[0].Events, convey.ShouldResemble, []string{"review.created", "review.replied"})
+		convey.So(hooks.List[0].CreateAt, convey.ShouldEqual, 1714536000)
+
+		fake.deliveries[created.SubscriptionID] = []*v1.WebhookDeliveryInfo{
+			{DeliveryID: 11, Event: "review.created", Payload: `{"reviewID":1}`, Status: 20, Attempts: 1, ResponseCode: 200, CreateAt: 1714536000},
+			{DeliveryID: 12, Event: "review.replied", Status: 30, Attempts: 5, ResponseCode: 500, LastError: "status 500", NextRetryAt: 1714539600, CreateAt: 1714536100},
+		}
+		deliveries, err := s.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{StoreID: 61, SubscriptionID: created.SubscriptionID, Status: 30, Page: 1, Size: 10})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(deliveries.Total, convey.ShouldEqual, 1)
+		convey.So(deliveries.List[0].DeliveryID, convey.ShouldEqual, 12)
+		convey.So(deliveries.List[0].Attempts, convey.ShouldEqual, 5)
+		convey.So(deliveries.List[0].ResponseCode, convey.ShouldEqual, 500)
+		convey.So(deliveries.List[0].LastError, convey.ShouldEqual, "status 500")
+		convey.So(deliveries.List[0].NextRetryAt, convey.ShouldEqual, 1714539600)
+
+		//别家的订阅查不到投递记录，也删不掉
+		_, err = s.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{StoreID: 61, SubscriptionID: 802, Page: 1, Size: 10})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+		_, err = s.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{StoreID: 61, SubscriptionID: 802})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+		_, err = s.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{StoreID: 61, SubscriptionID: 801})
+		convey.So(v1.IsNotFound(err), convey.ShouldBeTrue)
+
+		_, err = s.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{StoreID: 61, SubscriptionID: created.SubscriptionID})
+		convey.So(err, convey.ShouldBeNil)
+		hooks, err = s.ListWebhooks(ctx, &pb.ListWebhooksRequest{StoreID: 61})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(hooks.List), convey.ShouldEqual, 0)
+		convey.So(len(fake.webhooks), convey.ShouldEqual, 2)
+
+		for _, role := range fake.callerRoles() {
+			convey.So(role, convey.ShouldEqual, "merchant")
+		}
+	})
+}
@@ -0,0 +1,352 @@
+// Package client review-b和review-o调用review-service共用的客户端治理：负载均衡、熔断、超时、重试和对冲
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	kcb "github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/p2c"
+	"github.com/go-kratos/kratos/v2/selector/wrr"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultClientTimeout = 2 * time.Second
+	defaultBreakerWindow = 3 * time.Second
+	defaultRetryBudget   = 0.1
+	retryBudgetWindow    = 10 * time.Second
+	//请求很少时每个窗口也允许的重试数
+	retryBudgetMinRetries = 10
+)
+
+var (
+	metricBreakerOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "client",
+		Subsystem: "breaker",
+		Name:      "open",
+		Help:      "1 when the circuit breaker rejected calls to review-service within the last window.",
+	}, []string{"operation"})
+	metricBreakerRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "client",
+		Subsystem: "breaker",
+		Name:      "rejected_total",
+		Help:      "Calls to review-service rejected locally by the circuit breaker.",
+	}, []string{"operation"})
+	metricRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "client",
+		Name:      "retries_total",
+		Help:      "Extra attempts sent to review-service by kind (retry/hedge).",
+	}, []string{"operation", "kind"})
+	metricBudgetExhausted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "client",
+		Name:      "retry_budget_exhausted_total",
+		Help:      "Retries or hedges skipped because the retry budget was exhausted.",
+	}, []string{"operation"})
+)
+
+// SetBalancer kratos的grpc客户端都用全局selector选节点，name为p2c或wrr，默认p2c
+func SetBalancer(name string) error {
+	switch name {
+	case "", "p2c":
+		selector.SetGlobalSelector(p2c.NewBuilder())
+	case "wrr":
+		selector.SetGlobalSelector(wrr.NewBuilder())
+	default:
+		return fmt.Errorf("unknown balancer %q", name)
+	}
+	return nil
+}
+
+// BreakerConfig 熔断配置，零值用默认值
+type BreakerConfig struct {
+	//期望成功率，默认0.6
+	Success float64
+	//窗口内请求数少于Request时不熔断，默认100
+	Request int64
+	//统计窗口，默认3s
+	Window time.Duration
+}
+
+// Breaker 按方法熔断，review-service返回5xx或超时算失败，本地拒绝时返回503
+func Breaker(c BreakerConfig) middleware.Middleware {
+	window := c.Window
+	if window <= 0 {
+		window = defaultBreakerWindow
+	}
+	opts := []sre.Option{sre.WithWindow(window)}
+	if c.Success > 0 {
+		opts = append(opts, sre.WithSuccess(c.Success))
+	}
+	if c.Request > 0 {
+		opts = append(opts, sre.WithRequest(c.Request))
+	}
+	var breakers sync.Map
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var op string
+			if info, ok := transport.FromClientContext(ctx); ok {
+				op = info.Operation()
+			}
+			v, ok := breakers.Load(op)
+			if !ok {
+				v, _ = breakers.LoadOrStore(op, &operationBreaker{CircuitBreaker: sre.NewBreaker(opts...), op: op, window: window})
+			}
+			b := v.(*operationBreaker)
+			if err := b.Allow(); err != nil {
+				//本地拒绝也记失败，让拒绝比例跟着升高
+				b.MarkFailed()
+				b.rejected()
+				return nil, kcb.ErrNotAllowed
+			}
+			b.allowed()
+			reply, err := handler(ctx, req)
+			if err != nil && (errors.IsInternalServer(err) || errors.IsServiceUnavailable(err) || errors.IsGatewayTimeout(err)) {
+				b.MarkFailed()
+			} else {
+				b.MarkSuccess()
+			}
+			return reply, err
+		}
+	}
+}
+
+// operationBreaker sre熔断器不暴露状态，最近一个窗口内拒绝过请求就算打开
+type operationBreaker struct {
+	circuitbreaker.CircuitBreaker
+	op         string
+	window     time.Duration
+	lastReject atomic.Int64
+}
+
+func (b *operationBreaker) rejected() {
+	b.lastReject.Store(time.Now().UnixNano())
+	metricBreakerRejected.WithLabelValues(b.op).Inc()
+	metricBreakerOpen.WithLabelValues(b.op).Set(1)
+}
+
+func (b *operationBreaker) allowed() {
+	last := b.lastReject.Load()
+	if last == 0 || time.Since(time.Unix(0, last)) < b.window {
+		return
+	}
+	if b.lastReject.CompareAndSwap(last, 0) {
+		metricBreakerOpen.WithLabelValues(b.op).Set(0)
+	}
+}
+
+// Config 调用超时和重试配置，零值用默认超时、不重试
+type Config struct {
+	//整个调用(含重试)的超时，默认2s
+	Timeout time.Duration
+	//按方法名覆盖调用超时
+	MethodTimeouts map[string]time.Duration
+	//最多重试次数，不含第一次，只对幂等的Get/List方法生效
+	MaxRetries int
+	//每次尝试的超时，为0时只受整个调用的超时限制
+	PerTryTimeout time.Duration
+	//失败后等待多久重试
+	Backoff time.Duration
+	//第一次尝试超过HedgeDelay没返回时再发一个请求(算一次重试)，0不对冲
+	HedgeDelay time.Duration
+	//重试和对冲请求不超过正常请求的比例，默认0.1
+	BudgetRatio float64
+}
+
+// UnaryInterceptor 按Config设置每个调用的超时，幂等方法失败时重试和对冲
+func UnaryInterceptor(c Config) ggrpc.UnaryClientInterceptor {
+	return newResilience(c).intercept
+}
+
+// resilience 每个调用的超时，以及幂等方法的重试和对冲
+// 作为grpc拦截器在kratos中间件之内执行，熔断器看到的是重试后的结果
+type resilience struct {
+	timeout        time.Duration
+	methodTimeouts map[string]time.Duration
+	maxRetries     int
+	perTry         time.Duration
+	backoff        time.Duration
+	hedgeDelay     time.Duration
+	budget         *retryBudget
+}
+
+func newResilience(c Config) *resilience {
+	r := &resilience{
+		timeout:        c.Timeout,
+		methodTimeouts: c.MethodTimeouts,
+		maxRetries:     c.MaxRetries,
+		perTry:         c.PerTryTimeout,
+		backoff:        c.Backoff,
+		hedgeDelay:     c.HedgeDelay,
+		budget:         &retryBudget{ratio: c.BudgetRatio},
+	}
+	if r.timeout <= 0 {
+		r.timeout = defaultClientTimeout
+	}
+	if r.budget.ratio <= 0 {
+		r.budget.ratio = defaultRetryBudget
+	}
+	return r
+}
+
+func (r *resilience) intercept(ctx context.Context, method string, req, reply interface{}, cc *ggrpc.ClientConn, invoker ggrpc.UnaryInvoker, opts ...ggrpc.CallOption) error {
+	name := method[strings.LastIndex(method, "/")+1:]
+	timeout := r.timeout
+	if d, ok := r.methodTimeouts[name]; ok {
+		timeout = d
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	msg, ok := reply.(proto.Message)
+	if !ok || r.maxRetries <= 0 || !idempotent(name) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	r.budget.request()
+	return r.invoke(ctx, method, req, msg, cc, invoker, opts...)
+}
+
+type attemptResult struct {
+	reply proto.Message
+	err   error
+}
+
+// invoke 失败后等backoff重试，开启对冲时上一次尝试超过hedge_delay没返回就并发再发一次
+// 每次尝试用单独的reply，先成功的合并到调用方的reply里
+func (r *resilience) invoke(ctx context.Context, method string, req interface{}, reply proto.Message, cc *ggrpc.ClientConn, invoker ggrpc.UnaryInvoker, opts ...ggrpc.CallOption) error {
+	//返回时取消还在进行的尝试
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan attemptResult, r.maxRetries+1)
+	attempt := func() {
+		go func() {
+			actx := ctx
+			if r.perTry > 0 {
+				var acancel context.CancelFunc
+				actx, acancel = context.WithTimeout(ctx, r.perTry)
+				defer acancel()
+			}
+			out := reply.ProtoReflect().New().Interface()
+			err := invoker(actx, method, req, out, cc, opts...)
+			results <- attemptResult{reply: out, err: err}
+		}()
+	}
+
+	var (
+		next    <-chan time.Time
+		kind    string
+		lastErr error
+	)
+	attempt()
+	sent, pending := 1, 1
+	if r.hedgeDelay > 0 {
+		next, kind = time.After(r.hedgeDelay), "hedge"
+	}
+	for {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				proto.Merge(reply, res.reply)
+				return nil
+			}
+			if !retryable(ctx, res.err) {
+				return res.err
+			}
+			lastErr = res.err
+			if sent > r.maxRetries {
+				if pending == 0 {
+					return lastErr
+				}
+				continue
+			}
+			if pending == 0 {
+				next, kind = time.After(r.backoff), "retry"
+			}
+		case <-next:
+			next = nil
+			if sent > r.maxRetries {
+				continue
+			}
+			if !r.budget.allow() {
+				metricBudgetExhausted.WithLabelValues(method).Inc()
+				if pending == 0 {
+					return lastErr
+				}
+				continue
+			}
+			metricRetries.WithLabelValues(method, kind).Inc()
+			attempt()
+			sent++
+			pending++
+			if r.hedgeDelay > 0 && sent <= r.maxRetries {
+				next, kind = time.After(r.hedgeDelay), "hedge"
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// idempotent 只有查询方法可以安全地重复发送
+func idempotent(name string) bool {
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+// retryable 服务不可用，或者单次尝试超时而整个调用还没超时
+func retryable(ctx context.Context, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.DeadlineExceeded:
+		return ctx.Err() == nil
+	}
+	return false
+}
+
+// retryBudget 固定窗口内重试数不超过正常请求数的ratio，review-service整体故障时避免重试放大流量
+type retryBudget struct {
+	ratio float64
+
+	mu       sync.Mutex
+	start    time.Time
+	requests int
+	retries  int
+}
+
+func (b *retryBudget) roll() {
+	if now := time.Now(); now.Sub(b.start) >= retryBudgetWindow {
+		b.start, b.requests, b.retries = now, 0, 0
+	}
+}
+
+func (b *retryBudget) request() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll()
+	b.requests++
+}
+
+func (b *retryBudget) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll()
+	if float64(b.retries) >= retryBudgetMinRetries+b.ratio*float64(b.requests) {
+		return false
+	}
+	b.retries++
+	return true
+}
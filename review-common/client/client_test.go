@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	getMethod    = "/api.review.v1.Review/GetReview"
+	createMethod = "/api.review.v1.Review/CreateReview"
+)
+
+// invokerOf 按调用次序返回结果，第n次调用执行steps[n]，超出时用最后一个
+func invokerOf(calls *int32, steps ...func(ctx context.Context, reply *wrapperspb.StringValue) error) ggrpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *ggrpc.ClientConn, opts ...ggrpc.CallOption) error {
+		n := int(atomic.AddInt32(calls, 1)) - 1
+		if n >= len(steps) {
+			n = len(steps) - 1
+		}
+		return steps[n](ctx, reply.(*wrapperspb.StringValue))
+	}
+}
+
+func fail(code codes.Code) func(context.Context, *wrapperspb.StringValue) error {
+	return func(context.Context, *wrapperspb.StringValue) error {
+		return status.Error(code, code.String())
+	}
+}
+
+func ok(v string) func(context.Context, *wrapperspb.StringValue) error {
+	return func(_ context.Context, reply *wrapperspb.StringValue) error {
+		reply.Value = v
+		return nil
+	}
+}
+
+// block 等到这次尝试的ctx结束
+func block(done chan<- struct{}) func(context.Context, *wrapperspb.StringValue) error {
+	return func(ctx context.Context, _ *wrapperspb.StringValue) error {
+		<-ctx.Done()
+		if done != nil {
+			close(done)
+		}
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	convey.Convey("retries a query on unavailable", t, func() {
+		var calls int32
+		intercept := UnaryInterceptor(Config{MaxRetries: 2, Backoff: time.Millisecond})
+		reply := new(wrapperspb.StringValue)
+		err := intercept(ctx, getMethod, nil, reply, nil, invokerOf(&calls, fail(codes.Unavailable), ok("second")))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(reply.Value, convey.ShouldEqual, "second")
+		convey.So(calls, convey.ShouldEqual, 2)
+	})
+
+	convey.Convey("gives up after max retries", t, func() {
+		var calls int32
+		intercept := UnaryInterceptor(Config{MaxRetries: 2, Backoff: time.Millisecond})
+		err := intercept(ctx, getMethod, nil, new(wrapperspb.StringValue), nil, invokerOf(&calls, fail(codes.Unavailable)))
+		convey.So(status.Code(err), convey.ShouldEqual, codes.Unavailable)
+		convey.So(calls, convey.ShouldEqual, 3)
+	})
+
+	convey.Convey("does not retry business errors", t, func() {
+		for _, code := range []codes.Code{codes.InvalidArgument, codes.NotFound, codes.Internal} {
+			var calls int32
+			intercept := UnaryInterceptor(Config{MaxRetries: 2, Backoff: time.Millisecond})
+			err := intercept(ctx, getMethod, nil, new(wrapperspb.StringValue), nil, invokerOf(&calls, fail(code), ok("second")))
+			convey.So(status.Code(err), convey.ShouldEqual, code)
+			convey.So(calls, convey.ShouldEqual, 1)
+		}
+	})
+
+	convey.Convey("retries when a single try times out", t, func() {
+		var calls int32
+		intercept := UnaryInterceptor(Config{Timeout: time.Second, MaxRetries: 1, PerTryTimeout: 20 * time.Millisecond})
+		reply := new(wrapperspb.StringValue)
+		err := intercept(ctx, getMethod, nil, reply, nil, invokerOf(&calls, block(nil), ok("second")))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(reply.Value, convey.ShouldEqual, "second")
+		convey.So(calls, convey.ShouldEqual, 2)
+	})
+
+	convey.Convey("does not retry when the whole call timed out", t, func() {
+		var calls int32
+		intercept := UnaryInterceptor(Config{Timeout: 20 * time.Millisecond, MaxRetries: 2, Backoff: time.Millisecond})
+		err := intercept(ctx, getMethod, nil, new(wrapperspb.StringValue), nil, invokerOf(&calls, block(nil), ok("second")))
+		convey.So(status.Code(err), convey.ShouldEqual, codes.DeadlineExceeded)
+		convey.So(calls, convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("writes are sent once", t, func() {
+		var calls int32
+		intercept := UnaryInterceptor(Config{MaxRetries: 2, Backoff: time.Millisecond, HedgeDelay: time.Millisecond})
+		err := intercept(ctx, createMethod, nil, new(wrapperspb.StringValue), nil, invokerOf(&calls, fail(codes.Unavailable), ok("second")))
+		convey.So(status.Code(err), convey.ShouldEqual, codes.Unavailable)
+		convey.So(calls, convey.ShouldEqual, 1)
+
+		//慢的写请求也不对冲
+		calls = 0
+		intercept = UnaryInterceptor(Config{MaxRetries: 2, HedgeDelay: time.Millisecond})
+		slow := func(context.Context, *wrapperspb.StringValue) error {
+			time.Sleep(30 * time.Millisecond)
+			return nil
+		}
+		err = intercept(ctx, createMethod, nil, new(wrapperspb.StringValue), nil, invokerOf(&calls, slow))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(calls, convey.ShouldEqual, 1)
+	})
+}
+
+func TestHedge(t *testing.T) {
+	convey.Convey("the first reply wins and the losing attempt is cancelled", t, func() {
+		var calls int32
+		cancelled := make(chan struct{})
+		intercept := UnaryInterceptor(Config{MaxRetries: 1, HedgeDelay: 10 * time.Millisecond})
+		reply := new(wrapperspb.StringValue)
+		err := intercept(context.Background(), getMethod, nil, reply, nil, invokerOf(&calls, block(cancelled), ok("hedge")))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(reply.Value, convey.ShouldEqual, "hedge")
+		convey.So(calls, convey.ShouldEqual, 2)
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Fatal("losing hedge was not cancelled")
+		}
+	})
+}
+
+func TestRetryBudget(t *testing.T) {
+	convey.Convey("retries stop once the budget is spent", t, func() {
+		b := &retryBudget{ratio: 0.1}
+		for i := 0; i < 100; i++ {
+			b.request()
+		}
+		//每个窗口至少retryBudgetMinRetries次，再加上请求数的ratio
+		allowed := 0
+		for i := 0; i < 100; i++ {
+			if b.allow() {
+				allowed++
+			}
+		}
+		convey.So(allowed, convey.ShouldEqual, retryBudgetMinRetries+10)
+	})
+
+	convey.Convey("an exhausted budget returns the last error without retrying", t, func() {
+		r := newResilience(Config{MaxRetries: 2, Backoff: time.Millisecond})
+		//当前窗口里的重试已经远超预算
+		r.budget.request()
+		r.budget.retries = 100
+		var calls int32
+		err := r.intercept(context.Background(), getMethod, nil, new(wrapperspb.StringValue), nil, invokerOf(&calls, fail(codes.Unavailable), ok("second")))
+		convey.So(status.Code(err), convey.ShouldEqual, codes.Unavailable)
+		convey.So(calls, convey.ShouldEqual, 1)
+	})
+}
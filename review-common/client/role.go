@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"strconv"
+	"time"
+)
+
+// 调用方角色和签名的请求头，review-service校验签名后才认这个角色
+const (
+	roleKey     = "x-md-local-role"
+	roleSignKey = "x-role-sign"
+)
+
+// SignRole 请求头带上角色和签名，签名为 时间戳:hex(hmac_sha256(密钥, 角色:时间戳))
+func SignRole(role string, secret string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				tr.RequestHeader().Set(roleKey, role)
+				tr.RequestHeader().Set(roleSignKey, roleSign(secret, role, time.Now().Unix()))
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func roleSign(secret string, role string, ts int64) string {
+	t := strconv.FormatInt(ts, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(role + ":" + t))
+	return t + ":" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/smartystreets/goconvey/convey"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// headerCarrier 测试用的请求头
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string        { return h[key] }
+func (h headerCarrier) Set(key string, value string) { h[key] = value }
+func (h headerCarrier) Add(key string, value string) { h[key] = value }
+func (h headerCarrier) Keys() []string               { return nil }
+func (h headerCarrier) Values(key string) []string   { return []string{h[key]} }
+
+type testTransport struct {
+	transport.Transporter
+	header headerCarrier
+}
+
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+
+func TestSignRole(t *testing.T) {
+	convey.Convey("role and signature go into the request headers", t, func() {
+		tr := &testTransport{header: headerCarrier{}}
+		ctx := transport.NewClientContext(context.Background(), tr)
+		_, err := SignRole("merchant", "secret")(func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})(ctx, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tr.header[roleKey], convey.ShouldEqual, "merchant")
+		prefix, _, ok := strings.Cut(tr.header[roleSignKey], ":")
+		convey.So(ok, convey.ShouldBeTrue)
+		ts, err := strconv.ParseInt(prefix, 10, 64)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tr.header[roleSignKey], convey.ShouldEqual, roleSign("secret", "merchant", ts))
+		convey.So(roleSign("other", "merchant", ts), convey.ShouldNotEqual, tr.header[roleSignKey])
+	})
+}
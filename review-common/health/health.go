@@ -0,0 +1,264 @@
+// Package health 各服务共用的依赖检查，汇总成/readyz、grpc health和注册中心的就绪状态
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// 就绪状态
+const (
+	StatusReady    = "ready"
+	StatusDegraded = "degraded" //非关键依赖不可用，仍然接流量
+	StatusNotReady = "not_ready"
+)
+
+const (
+	defaultInterval = 5 * time.Second
+	defaultTimeout  = time.Second
+)
+
+// Probe 一个依赖的检查
+type Probe struct {
+	Name string
+	//关键依赖不可用时服务未就绪，其他依赖不可用只算降级
+	Critical bool
+	Check    func(ctx context.Context) error
+}
+
+// Probes 各层提供的依赖检查
+type Probes []Probe
+
+// Result 单个依赖的检查结果
+type Result struct {
+	Up        bool   `json:"up"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report 一轮检查的结果
+type Report struct {
+	Status    string             `json:"status"`
+	Checks    map[string]*Result `json:"checks"`
+	CheckedAt time.Time          `json:"checked_at"`
+}
+
+// Health 定时检查依赖，结果用于/readyz、grpc health服务和注册中心
+// 实现transport.Server随app启停；实现registry.Registrar，未就绪时从注册中心摘除
+type Health struct {
+	probes    Probes
+	interval  time.Duration
+	timeout   time.Duration
+	registrar registry.Registrar
+	grpc      *health.Server
+	log       *log.Helper
+	stop      chan struct{}
+
+	mu     sync.Mutex
+	report *Report
+
+	regMu      sync.Mutex
+	instance   *registry.ServiceInstance
+	registered bool
+	stopped    bool
+}
+
+type Option func(*Health)
+
+// WithRegistrar 注册中心，未就绪时注销，恢复后重新注册
+func WithRegistrar(r registry.Registrar) Option {
+	return func(h *Health) {
+		h.registrar = r
+	}
+}
+
+func New(probes Probes, interval, timeout time.Duration, logger log.Logger, opts ...Option) *Health {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	h := &Health{
+		probes:   probes,
+		interval: interval,
+		timeout:  timeout,
+		grpc:     health.NewServer(),
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(h)
+	}
+	return h
+}
+
+// Check 并发执行所有检查，每个检查单独超时
+func (h *Health) Check(ctx context.Context) *Report {
+	report := &Report{Status: StatusReady, Checks: make(map[string]*Result, len(h.probes)), CheckedAt: time.Now()}
+	results := make([]*Result, len(h.probes))
+	var wg sync.WaitGroup
+	for i, p := range h.probes {
+		wg.Add(1)
+		go func(i int, p Probe) {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+			start := time.Now()
+			err := p.Check(cctx)
+			results[i] = &Result{Up: err == nil, Critical: p.Critical, LatencyMs: time.Since(start).Milliseconds()}
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, p)
+	}
+	wg.Wait()
+	for i, p := range h.probes {
+		report.Checks[p.Name] = results[i]
+		if results[i].Up {
+			continue
+		}
+		if p.Critical {
+			report.Status = StatusNotReady
+		} else if report.Status == StatusReady {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// Report 最近一轮的检查结果，还没检查过时立即检查一次
+func (h *Health) Report(ctx context.Context) *Report {
+	h.mu.Lock()
+	report := h.report
+	h.mu.Unlock()
+	if report != nil {
+		return report
+	}
+	return h.refresh(ctx)
+}
+
+func (h *Health) refresh(ctx context.Context) *Report {
+	report := h.Check(ctx)
+	h.mu.Lock()
+	h.report = report
+	h.mu.Unlock()
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	if report.Status == StatusNotReady {
+		status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	h.grpc.SetServingStatus("", status)
+	h.syncRegistry(ctx, report.Status != StatusNotReady)
+	return report
+}
+
+// syncRegistry 就绪状态变化时注册或注销，注册失败的下一轮再试
+func (h *Health) syncRegistry(ctx context.Context, ready bool) {
+	if h.registrar == nil {
+		return
+	}
+	h.regMu.Lock()
+	defer h.regMu.Unlock()
+	if h.instance == nil || h.stopped || ready == h.registered {
+		return
+	}
+	rctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	if ready {
+		if err := h.registrar.Register(rctx, h.instance); err != nil {
+			h.log.Errorf("register %s failed, err:%v", h.instance.Name, err)
+			return
+		}
+		h.log.Infof("service ready, registered %s", h.instance.ID)
+	} else {
+		if err := h.registrar.Deregister(rctx, h.instance); err != nil {
+			h.log.Errorf("deregister %s failed, err:%v", h.instance.Name, err)
+			return
+		}
+		h.log.Warnf("service not ready, deregistered %s", h.instance.ID)
+	}
+	h.registered = ready
+}
+
+// Register app启动后调用，注册中心不可用时不让app退出，由定时检查重试
+func (h *Health) Register(ctx context.Context, ins *registry.ServiceInstance) error {
+	h.regMu.Lock()
+	defer h.regMu.Unlock()
+	h.instance = ins
+	if h.registrar == nil {
+		return nil
+	}
+	if err := h.registrar.Register(ctx, ins); err != nil {
+		h.log.Errorf("register %s failed, will retry, err:%v", ins.Name, err)
+		return nil
+	}
+	h.registered = true
+	return nil
+}
+
+// Deregister app退出时调用，之后不再重新注册
+func (h *Health) Deregister(ctx context.Context, ins *registry.ServiceInstance) error {
+	h.regMu.Lock()
+	defer h.regMu.Unlock()
+	h.stopped = true
+	if h.registrar == nil || !h.registered {
+		return nil
+	}
+	h.registered = false
+	return h.registrar.Deregister(ctx, ins)
+}
+
+func (h *Health) Start(ctx context.Context) error {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.stop:
+			return nil
+		case <-ticker.C:
+			h.refresh(ctx)
+		}
+	}
+}
+
+func (h *Health) Stop(ctx context.Context) error {
+	close(h.stop)
+	h.grpc.Shutdown()
+	return nil
+}
+
+// GRPC 标准grpc health服务，未就绪时返回NOT_SERVING
+func (h *Health) GRPC() grpc_health_v1.HealthServer {
+	return h.grpc
+}
+
+// Liveness /healthz 进程能处理请求就算存活，不检查依赖，避免依赖故障时被重启
+func (h *Health) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readiness /readyz 未就绪时返回503，降级仍返回200
+func (h *Health) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := h.Report(r.Context())
+	code := http.StatusOK
+	if report.Status == StatusNotReady {
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, report)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,67 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+type fakeRegistrar struct {
+	registered bool
+}
+
+func (r *fakeRegistrar) Register(ctx context.Context, ins *registry.ServiceInstance) error {
+	r.registered = true
+	return nil
+}
+
+func (r *fakeRegistrar) Deregister(ctx context.Context, ins *registry.ServiceInstance) error {
+	r.registered = false
+	return nil
+}
+
+func TestHealth(t *testing.T) {
+	var mysqlErr error
+	probes := Probes{
+		{Name: "mysql", Critical: true, Check: func(ctx context.Context) error { return mysqlErr }},
+		{Name: "redis", Check: func(ctx context.Context) error { return errors.New("timeout") }},
+		{Name: "slow", Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	}
+	reg := &fakeRegistrar{}
+	h := New(probes, time.Second, 10*time.Millisecond, log.DefaultLogger, WithRegistrar(reg))
+	ctx := context.Background()
+	ins := &registry.ServiceInstance{ID: "1", Name: "review-service"}
+
+	convey.Convey("non critical failures and timeouts degrade", t, func() {
+		report := h.refresh(ctx)
+		convey.So(report.Status, convey.ShouldEqual, StatusDegraded)
+		convey.So(report.Checks["mysql"].Up, convey.ShouldBeTrue)
+		convey.So(report.Checks["slow"].Up, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("deregister when not ready and register again after recovery", t, func() {
+		convey.So(h.Register(ctx, ins), convey.ShouldBeNil)
+		convey.So(reg.registered, convey.ShouldBeTrue)
+
+		mysqlErr = errors.New("connection refused")
+		convey.So(h.refresh(ctx).Status, convey.ShouldEqual, StatusNotReady)
+		convey.So(reg.registered, convey.ShouldBeFalse)
+
+		mysqlErr = nil
+		h.refresh(ctx)
+		convey.So(reg.registered, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("no register after app deregistered", t, func() {
+		convey.So(h.Deregister(ctx, ins), convey.ShouldBeNil)
+		h.refresh(ctx)
+		convey.So(reg.registered, convey.ShouldBeFalse)
+	})
+}
@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	kmetrics "github.com/go-kratos/kratos/v2/metrics"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// 每个接口的请求数(按错误码)和耗时，http和grpc用kind区分
+var (
+	metricRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "server",
+		Subsystem: "requests",
+		Name:      "code_total",
+		Help:      "The total number of processed requests.",
+	}, []string{"kind", "operation", "code", "reason"})
+	metricSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "server",
+		Subsystem: "requests",
+		Name:      "seconds",
+		Help:      "Requests duration(sec).",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1},
+	}, []string{"kind", "operation"})
+)
+
+// ServerMetrics http和grpc server共用的指标中间件，/metrics由各服务自己注册
+func ServerMetrics() middleware.Middleware {
+	return metrics.Server(
+		metrics.WithRequests(counter{vec: metricRequests}),
+		metrics.WithSeconds(histogram{vec: metricSeconds}),
+	)
+}
+
+// counter 把prometheus的CounterVec适配成kratos metrics.Counter
+type counter struct {
+	vec *prometheus.CounterVec
+	lvs []string
+}
+
+func (c counter) With(lvs ...string) kmetrics.Counter {
+	return counter{vec: c.vec, lvs: lvs}
+}
+
+func (c counter) Inc() {
+	c.vec.WithLabelValues(c.lvs...).Inc()
+}
+
+func (c counter) Add(delta float64) {
+	c.vec.WithLabelValues(c.lvs...).Add(delta)
+}
+
+// histogram 把prometheus的HistogramVec适配成kratos metrics.Observer
+type histogram struct {
+	vec *prometheus.HistogramVec
+	lvs []string
+}
+
+func (h histogram) With(lvs ...string) kmetrics.Observer {
+	return histogram{vec: h.vec, lvs: lvs}
+}
+
+func (h histogram) Observe(value float64) {
+	h.vec.WithLabelValues(h.lvs...).Observe(value)
+}
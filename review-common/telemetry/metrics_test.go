@@ -0,0 +1,41 @@
+package telemetry
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+type serverTransport struct {
+	transport.Transporter
+	operation string
+}
+
+func (t *serverTransport) Kind() transport.Kind { return transport.KindGRPC }
+func (t *serverTransport) Operation() string    { return t.operation }
+
+func TestServerMetrics(t *testing.T) {
+	convey.Convey("requests are counted by code and reason, successes as code 0", t, func() {
+		op := "/api.review.v1.Review/ListReviews"
+		ctx := transport.NewServerContext(context.Background(), &serverTransport{operation: op})
+		ok := ServerMetrics()(func(context.Context, interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		failed := ServerMetrics()(func(context.Context, interface{}) (interface{}, error) {
+			return nil, errors.NotFound("NOT_FOUND", "没有这个评价")
+		})
+		_, err := ok(ctx, nil)
+		convey.So(err, convey.ShouldBeNil)
+		_, err = failed(ctx, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = failed(ctx, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.So(testutil.ToFloat64(metricRequests.WithLabelValues("grpc", op, "0", "")), convey.ShouldEqual, 1)
+		convey.So(testutil.ToFloat64(metricRequests.WithLabelValues("grpc", op, "404", "NOT_FOUND")), convey.ShouldEqual, 2)
+		convey.So(testutil.CollectAndCount(metricSeconds), convey.ShouldEqual, 1)
+	})
+}
@@ -0,0 +1,117 @@
+// Package telemetry 各服务共用的链路追踪初始化和接口指标
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"os"
+	"time"
+)
+
+// TraceConfig 各服务conf.Trace生成的取值方法，配置为nil时不上报
+type TraceConfig interface {
+	GetExporter() string
+	GetEndpoint() string
+	GetInsecure() bool
+	GetFile() string
+	GetSampleRatio() float64
+}
+
+// Service 上报span时带上的服务信息
+type Service struct {
+	Name       string
+	Version    string
+	InstanceID string
+}
+
+// SetTracerProvider 按配置设置全局TracerProvider，tracing中间件、gorm和ES的span都从全局取
+// 返回的函数在退出前调用，把缓冲里的span发完
+func SetTracerProvider(c TraceConfig, s Service) (func(), error) {
+	exp, err := newTraceExporter(c)
+	if err != nil || exp == nil {
+		return func() {}, err
+	}
+	ratio := c.GetSampleRatio()
+	if ratio <= 0 {
+		ratio = 1
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(s.Name),
+			semconv.ServiceVersion(s.Version),
+			semconv.ServiceInstanceID(s.InstanceID),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+	}, nil
+}
+
+func newTraceExporter(c TraceConfig) (sdktrace.SpanExporter, error) {
+	switch c.GetExporter() {
+	case "":
+		return nil, nil
+	case "otlp":
+		return otlptrace.New(context.Background(), &otlpClient{endpoint: c.GetEndpoint(), insecure: c.GetInsecure()})
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "file":
+		//本地调试用，一行一个span
+		f, err := os.OpenFile(c.GetFile(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		return stdouttrace.New(stdouttrace.WithWriter(f))
+	}
+	return nil, fmt.Errorf("unsupported trace exporter %q", c.GetExporter())
+}
+
+// otlpClient 通过grpc把span发给otlp collector
+// otlptracegrpc要求的grpc版本比各服务用的高，这里直接调collector的Export接口
+type otlpClient struct {
+	endpoint string
+	insecure bool
+	conn     *grpc.ClientConn
+	client   coltracepb.TraceServiceClient
+}
+
+func (c *otlpClient) Start(ctx context.Context) error {
+	creds := credentials.NewTLS(nil)
+	if c.insecure {
+		creds = insecure.NewCredentials()
+	}
+	conn, err := grpc.DialContext(ctx, c.endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+	c.client = coltracepb.NewTraceServiceClient(conn)
+	return nil
+}
+
+func (c *otlpClient) Stop(ctx context.Context) error {
+	return c.conn.Close()
+}
+
+func (c *otlpClient) UploadTraces(ctx context.Context, spans []*tracepb.ResourceSpans) error {
+	_, err := c.client.Export(ctx, &coltracepb.ExportTraceServiceRequest{ResourceSpans: spans})
+	return err
+}
@@ -0,0 +1,49 @@
+package telemetry
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type traceConf struct {
+	exporter string
+	file     string
+}
+
+func (c *traceConf) GetExporter() string     { return c.exporter }
+func (c *traceConf) GetEndpoint() string     { return "" }
+func (c *traceConf) GetInsecure() bool       { return true }
+func (c *traceConf) GetFile() string         { return c.file }
+func (c *traceConf) GetSampleRatio() float64 { return 0 }
+
+func TestSetTracerProvider(t *testing.T) {
+	convey.Convey("no exporter configured", t, func() {
+		shutdown, err := SetTracerProvider(&traceConf{}, Service{Name: "review-test"})
+		convey.So(err, convey.ShouldBeNil)
+		shutdown()
+	})
+
+	convey.Convey("unsupported exporter", t, func() {
+		_, err := SetTracerProvider(&traceConf{exporter: "jaeger"}, Service{Name: "review-test"})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("spans are written to the file with the service name", t, func() {
+		file := filepath.Join(t.TempDir(), "trace.log")
+		shutdown, err := SetTracerProvider(&traceConf{exporter: "file", file: file}, Service{Name: "review-test", Version: "v1", InstanceID: "host-1"})
+		convey.So(err, convey.ShouldBeNil)
+		_, span := otel.Tracer("test").Start(context.Background(), "ListReviews")
+		span.End()
+		//退出前把缓冲里的span发完
+		shutdown()
+		out, err := os.ReadFile(file)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(out), convey.ShouldContainSubstring, "ListReviews")
+		convey.So(string(out), convey.ShouldContainSubstring, "review-test")
+		convey.So(string(out), convey.ShouldContainSubstring, "host-1")
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"review-job/internal/job"
 
+	"review-common/health"
+	"review-common/telemetry"
 	"review-job/internal/conf"
 
 	"github.com/go-kratos/kratos/v2"
@@ -21,7 +23,8 @@ import (
 // go build -ldflags "-X main.Version=x.y.z"
 var (
 	// Name is the name of the compiled software.
-	Name string
+	//链路追踪里的服务名称
+	Name string = "review-job"
 	// Version is the version of the compiled software.
 	Version string
 	// flagconf is the config flag.
@@ -34,7 +37,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWorker) *kratos.App {
+func newApp(logger log.Logger, h *health.Health, gs *grpc.Server, hs *http.Server, js *job.JobWorker) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,8 +46,10 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWork
 		kratos.Logger(logger),
 		kratos.Server(
 			//gs,
-			//hs,
+			//http只用来暴露/metrics和健康检查
+			hs,
 			js,
+			h,
 		),
 	)
 }
@@ -76,7 +81,14 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Elasticsearch, bc.Kafka, logger)
+	//在创建ES客户端之前设置，ES客户端创建时取全局TracerProvider
+	shutdown, err := telemetry.SetTracerProvider(bc.Trace, telemetry.Service{Name: Name, Version: Version, InstanceID: id})
+	if err != nil {
+		panic(err)
+	}
+	defer shutdown()
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Elasticsearch, bc.Kafka, bc.Health, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -24,7 +24,10 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, elasticSearch *conf.ElasticSearch, kafka *conf.Kafka, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, elasticSearch *conf.ElasticSearch, kafka *conf.Kafka, health *conf.Health, logger log.Logger) (*kratos.App, func(), error) {
+	esClient := job.NewEsclient(elasticSearch)
+	probes := job.NewHealthProbes(kafka, esClient)
+	healthHealth := server.NewHealth(health, probes, logger)
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -32,12 +35,11 @@ func wireApp(confServer *conf.Server, confData *conf.Data, elasticSearch *conf.E
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, healthHealth, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, healthHealth, logger)
 	reader := job.NewKafkaReader(kafka)
-	esClient := job.NewEsclient(elasticSearch)
 	jobWorker := job.NewJobWorker(reader, esClient, logger)
-	app := newApp(logger, grpcServer, httpServer, jobWorker)
+	app := newApp(logger, healthHealth, grpcServer, httpServer, jobWorker)
 	return app, func() {
 		cleanup()
 	}, nil
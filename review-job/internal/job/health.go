@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	"net/http"
+	"review-common/health"
+	"review-job/internal/conf"
+)
+
+// NewHealthProbes kafka和ES任一不可用都无法同步，都是关键依赖
+func NewHealthProbes(cfg *conf.Kafka, es *ESClient) health.Probes {
+	return health.Probes{
+		{Name: "kafka", Critical: true, Check: func(ctx context.Context) error {
+			return pingKafka(ctx, cfg.Brokers)
+		}},
+		{Name: "elasticsearch", Critical: true, Check: func(ctx context.Context) error {
+			return pingES(ctx, es)
+		}},
+	}
+}
+
+// pingKafka 能连上任意一个broker就算可用
+func pingKafka(ctx context.Context, brokers []string) error {
+	err := errors.New("no kafka brokers configured")
+	for _, b := range brokers {
+		var conn *kafka.Conn
+		if conn, err = kafka.DialContext(ctx, "tcp", b); err == nil {
+			return conn.Close()
+		}
+	}
+	return err
+}
+
+// pingES 用底层Perform请求根路径，typed api每次调用都会产生一个span
+func pingES(ctx context.Context, es *ESClient) error {
+	if es == nil {
+		return errors.New("elasticsearch client not initialized")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, "/", nil)
+	if err != nil {
+		return err
+	}
+	res, err := es.Perform(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("elasticsearch status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -2,4 +2,4 @@ package job
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewKafkaReader, NewEsclient, NewJobWorker)
+var ProviderSet = wire.NewSet(NewKafkaReader, NewEsclient, NewJobWorker, NewHealthProbes)
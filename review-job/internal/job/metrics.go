@@ -0,0 +1,49 @@
+package job
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var (
+	metricKafkaLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "review_job",
+		Name:      "kafka_lag",
+		Help:      "Messages behind the partition high watermark after the last read.",
+	}, []string{"partition"})
+	metricBatchRows = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "review_job",
+		Name:      "batch_rows",
+		Help:      "Rows carried by one canal message.",
+		Buckets:   []float64{1, 2, 5, 10, 20, 50, 100, 200, 500},
+	})
+	metricESSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "review_job",
+		Subsystem: "es",
+		Name:      "request_seconds",
+		Help:      "Duration of elasticsearch writes by operation.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"operation"})
+	metricESFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "review_job",
+		Subsystem: "es",
+		Name:      "failures_total",
+		Help:      "Failed elasticsearch writes by operation.",
+	}, []string{"operation"})
+	//从mysql执行到写入ES完成的延迟，用canal消息里binlog的执行时间计算
+	metricIndexDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "review_job",
+		Name:      "index_delay_seconds",
+		Help:      "Delay from the binlog event to the elasticsearch write.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+	})
+)
+
+// observeES 在ES请求返回后调用
+func observeES(op string, start time.Time, err error) {
+	metricESSeconds.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metricESFailures.WithLabelValues(op).Inc()
+	}
+}
@@ -8,7 +8,14 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
 	"review-job/internal/conf"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -31,6 +38,10 @@ type Msg struct {
 	Table    string `json:"table"`
 	IsDdl    bool   `json:"isDdl"`
 	Data     []map[string]interface{}
+	//UPDATE时每行被修改字段的旧值
+	Old []map[string]interface{} `json:"old"`
+	//binlog的执行时间(毫秒)
+	Es int64 `json:"es"`
 }
 
 func NewJobWorker(kafkaReader *kafka.Reader, esclient *ESClient, logger log.Logger) *JobWorker {
@@ -54,7 +65,11 @@ func NewKafkaReader(cfg *conf.Kafka) *kafka.Reader {
 
 func NewEsclient(config *conf.ElasticSearch) *ESClient {
 	// ES 配置
-	cfg := elasticsearch.Config{Addresses: config.Addresses}
+	cfg := elasticsearch.Config{
+		Addresses: config.Addresses,
+		//每次请求一个span，不记录请求体
+		Instrumentation: elasticsearch.NewOpenTelemetryInstrumentation(otel.GetTracerProvider(), false),
+	}
 
 	// 创建客户端连接
 	client, err := elasticsearch.NewTypedClient(cfg)
@@ -79,29 +94,34 @@ func (jw JobWorker) Start(ctx context.Context) error {
 		if err != nil {
 			jw.log.Errorf("readmessage failed,err:%v\n", err)
 		}
-		fmt.Printf("message at topic/partition/offset %v/%v/%v: %s = %s\n", m.Topic, m.Partition, m.Offset, string(m.Key), string(m.Value))
-		// 2.将评价数据完整写入ES
-		msg := new(Msg)
-		err = json.Unmarshal(m.Value, msg)
-		if err != nil {
-			log.Errorf("unmarshal from kafka failed, err:%v\n", err)
-			continue
-		}
-		//data process...
-		if msg.Type == "INSERT" {
-			//add
-			for i := range msg.Data {
-				jw.indexDocument(msg.Data[i])
-			}
-		} else {
-			//update
-			for i := range msg.Data {
-				jw.updateDocument(msg.Data[i])
-			}
-		}
+		jw.handleMessage(m)
+	}
+}
+
+// handleMessage 处理一条canal消息：记录分区积压，逐行写入ES，记录binlog到写入ES的延迟
+func (jw JobWorker) handleMessage(m kafka.Message) {
+	fmt.Printf("message at topic/partition/offset %v/%v/%v: %s = %s\n", m.Topic, m.Partition, m.Offset, string(m.Key), string(m.Value))
+	metricKafkaLag.WithLabelValues(strconv.Itoa(m.Partition)).Set(float64(m.HighWaterMark - m.Offset - 1))
+	// 2.将评价数据完整写入ES
+	msg := new(Msg)
+	err := json.Unmarshal(m.Value, msg)
+	if err != nil {
+		log.Errorf("unmarshal from kafka failed, err:%v\n", err)
+		return
+	}
+	//data process...
+	for i := range msg.Data {
+		normalizeDocument(msg.Data[i])
+	}
+	metricBatchRows.Observe(float64(len(msg.Data)))
+	for i := range msg.Data {
+		jw.syncDocument(m, msg, i)
+	}
+	if msg.Es > 0 && len(msg.Data) > 0 {
+		metricIndexDelay.Observe(time.Since(time.UnixMilli(msg.Es)).Seconds())
 	}
-	return nil
 }
+
 func (jw JobWorker) Stop(ctx context.Context) (err error) {
 	jw.log.Debug("job worker stop.")
 	if err = jw.kafkaReader.Close(); err != nil {
@@ -127,29 +147,81 @@ type Tag struct {
 	Title string `json:"title"`
 }
 
+// numericFields canal传过来的字段都是字符串，需要在ES中排序的计数字段转成数值再写入
+var numericFields = []string{"helpful_count"}
+
+// normalizeDocument 把计数字段转成数值
+func normalizeDocument(d map[string]interface{}) {
+	for _, f := range numericFields {
+		v, ok := d[f].(string)
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			d[f] = n
+		}
+	}
+}
+
+// syncDocument 一行数据写入ES，每行一个span，接到写这行数据的请求链路上
+func (jw JobWorker) syncDocument(m kafka.Message, msg *Msg, i int) {
+	parent := rowTraceContext(context.Background(), m, msg, i)
+	delete(msg.Data[i], traceColumn)
+	ctx, span := otel.Tracer("review-job").Start(parent,
+		"review-job."+strings.ToLower(msg.Type),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("kafka"),
+			semconv.MessagingDestinationName(m.Topic),
+			semconv.MessagingKafkaDestinationPartition(m.Partition),
+			semconv.MessagingKafkaMessageOffset(int(m.Offset)),
+			attribute.String("review.id", fmt.Sprint(msg.Data[i]["review_id"])),
+		),
+	)
+	defer span.End()
+	var err error
+	if msg.Type == "INSERT" {
+		//add
+		err = jw.indexDocument(ctx, msg.Data[i])
+	} else {
+		//update
+		err = jw.updateDocument(ctx, msg.Data[i])
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
 // indexDocument 索引文档
-func (jw JobWorker) indexDocument(d map[string]interface{}) {
+func (jw JobWorker) indexDocument(ctx context.Context, d map[string]interface{}) error {
 	// 添加文档
+	start := time.Now()
 	resp, err := jw.esClient.Index(jw.esClient.index).
 		Id(d["review_id"].(string)).
 		Document(d).
-		Do(context.Background())
+		Do(ctx)
+	observeES("index", start, err)
 	if err != nil {
 		fmt.Printf("indexing document failed, err:%v\n", err)
-		return
+		return err
 	}
 	fmt.Printf("result:%#v\n", resp.Result)
+	return nil
 }
 
 // updateDocument 更新文档
-func (jw JobWorker) updateDocument(d map[string]interface{}) {
+func (jw JobWorker) updateDocument(ctx context.Context, d map[string]interface{}) error {
 	// 修改后的结构体变量
+	start := time.Now()
 	resp, err := jw.esClient.Update(jw.esClient.index, d["review_id"].(string)).
 		Doc(d). // 使用结构体变量更新
-		Do(context.Background())
+		Do(ctx)
+	observeES("update", start, err)
 	if err != nil {
 		jw.log.Errorf("update document failed, err:%v\n", err)
-		return
+		return err
 	}
 	jw.log.Errorf("result:%v\n", resp.Result)
+	return nil
 }
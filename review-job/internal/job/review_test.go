@@ -0,0 +1,193 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/segmentio/kafka-go"
+	"github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"review-job/internal/conf"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	headerTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	rowTraceID    = "0af7651916cd43dd8448eb211c80319c"
+)
+
+func traceparent(traceID string) string {
+	return "00-" + traceID + "-00f067aa0ba902b7-01"
+}
+
+// traceCtx review-service写在trace_ctx列里的链路
+func traceCtx(traceID string) string {
+	b, _ := json.Marshal(map[string]string{"traceparent": traceparent(traceID)})
+	return string(b)
+}
+
+func TestNormalizeDocument(t *testing.T) {
+	convey.Convey("counters become numbers, everything else is left as canal sent it", t, func() {
+		d := map[string]interface{}{"review_id": "7", "score": "5", "helpful_count": "12"}
+		normalizeDocument(d)
+		convey.So(d["helpful_count"], convey.ShouldEqual, int64(12))
+		convey.So(d["score"], convey.ShouldEqual, "5")
+		convey.So(d["review_id"], convey.ShouldEqual, "7")
+
+		//不是数字或者没有这个字段时原样保留
+		d = map[string]interface{}{"helpful_count": "abc"}
+		normalizeDocument(d)
+		convey.So(d["helpful_count"], convey.ShouldEqual, "abc")
+		d = map[string]interface{}{"review_id": "8"}
+		normalizeDocument(d)
+		_, ok := d["helpful_count"]
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func TestRowTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	ctx := context.Background()
+	traceID := func(c context.Context) string {
+		sc := trace.SpanContextFromContext(c)
+		if !sc.IsValid() {
+			return ""
+		}
+		return sc.TraceID().String()
+	}
+
+	convey.Convey("the kafka header wins over the trace_ctx column", t, func() {
+		m := kafka.Message{Headers: []kafka.Header{{Key: "traceparent", Value: []byte(traceparent(headerTraceID))}}}
+		msg := &Msg{Type: "INSERT", Data: []map[string]interface{}{{traceColumn: traceCtx(rowTraceID)}}}
+		convey.So(traceID(rowTraceContext(ctx, m, msg, 0)), convey.ShouldEqual, headerTraceID)
+	})
+
+	convey.Convey("an insert continues the trace stored in trace_ctx", t, func() {
+		msg := &Msg{Type: "INSERT", Data: []map[string]interface{}{{}, {traceColumn: traceCtx(rowTraceID)}}}
+		convey.So(traceID(rowTraceContext(ctx, kafka.Message{}, msg, 1)), convey.ShouldEqual, rowTraceID)
+		convey.So(traceID(rowTraceContext(ctx, kafka.Message{}, msg, 0)), convey.ShouldEqual, "")
+	})
+
+	convey.Convey("an update only continues the trace when trace_ctx changed", t, func() {
+		msg := &Msg{
+			Type: "UPDATE",
+			Data: []map[string]interface{}{{traceColumn: traceCtx(rowTraceID)}, {traceColumn: traceCtx(rowTraceID)}},
+			Old:  []map[string]interface{}{{"helpful_count": "1"}, {traceColumn: nil}},
+		}
+		convey.So(traceID(rowTraceContext(ctx, kafka.Message{}, msg, 0)), convey.ShouldEqual, "")
+		convey.So(traceID(rowTraceContext(ctx, kafka.Message{}, msg, 1)), convey.ShouldEqual, rowTraceID)
+		//old缺行时不沿用
+		msg.Old = nil
+		convey.So(traceID(rowTraceContext(ctx, kafka.Message{}, msg, 1)), convey.ShouldEqual, "")
+	})
+
+	convey.Convey("a broken trace_ctx is ignored", t, func() {
+		msg := &Msg{Type: "INSERT", Data: []map[string]interface{}{{traceColumn: "{"}}}
+		convey.So(traceID(rowTraceContext(ctx, kafka.Message{}, msg, 0)), convey.ShouldEqual, "")
+	})
+}
+
+// fakeES 记录写入的文档，按ES的格式返回
+type fakeES struct {
+	mu   sync.Mutex
+	docs map[string]map[string]interface{} //请求路径到文档
+}
+
+func (f *fakeES) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var doc map[string]interface{}
+	_ = json.Unmarshal(body, &doc)
+	f.mu.Lock()
+	f.docs[r.Method+" "+r.URL.Path] = doc
+	f.mu.Unlock()
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+	result := "created"
+	if r.Method == http.MethodPost {
+		result = "updated"
+	}
+	fmt.Fprintf(w, `{"_index":"reviews","_id":"1","_version":1,"result":%q,"_shards":{"total":1,"successful":1,"failed":0},"_seq_no":0,"_primary_term":1}`, result)
+}
+
+func histogramCount(h interface{ Write(*dto.Metric) error }) uint64 {
+	m := &dto.Metric{}
+	_ = h.Write(m)
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestHandleMessage(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	rec := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	es := &fakeES{docs: map[string]map[string]interface{}{}}
+	srv := httptest.NewServer(es)
+	defer srv.Close()
+	jw := NewJobWorker(nil, NewEsclient(&conf.ElasticSearch{Addresses: []string{srv.URL}, Index: "reviews"}), log.DefaultLogger)
+
+	convey.Convey("a canal message is written to ES with lag and delay recorded", t, func() {
+		delays := histogramCount(metricIndexDelay)
+		writes := histogramCount(metricESSeconds.WithLabelValues("index").(prometheus.Histogram))
+		value, _ := json.Marshal(&Msg{
+			Type: "INSERT",
+			Data: []map[string]interface{}{
+				{"review_id": "1", "helpful_count": "3", traceColumn: traceCtx(rowTraceID)},
+				{"review_id": "2", "helpful_count": "0"},
+			},
+			Es: time.Now().Add(-2 * time.Second).UnixMilli(),
+		})
+		jw.handleMessage(kafka.Message{Topic: "review", Partition: 3, Offset: 10, HighWaterMark: 15, Value: value})
+
+		//读完offset 10之后分区里还剩11~14
+		convey.So(testutil.ToFloat64(metricKafkaLag.WithLabelValues("3")), convey.ShouldEqual, 4)
+		convey.So(histogramCount(metricIndexDelay), convey.ShouldEqual, delays+1)
+		convey.So(histogramCount(metricESSeconds.WithLabelValues("index").(prometheus.Histogram)), convey.ShouldEqual, writes+2)
+
+		doc := es.docs["PUT /reviews/_doc/1"]
+		convey.So(doc, convey.ShouldNotBeNil)
+		convey.So(doc["helpful_count"], convey.ShouldEqual, 3)
+		_, ok := doc[traceColumn]
+		convey.So(ok, convey.ShouldBeFalse)
+		convey.So(es.docs["PUT /reviews/_doc/2"], convey.ShouldNotBeNil)
+
+		//带trace_ctx的行接到写评价的请求链路上
+		var parents []string
+		for _, s := range rec.Ended() {
+			if s.Name() == "review-job.insert" {
+				parents = append(parents, s.Parent().TraceID().String())
+			}
+		}
+		convey.So(len(parents), convey.ShouldEqual, 2)
+		convey.So(parents[0], convey.ShouldEqual, rowTraceID)
+		convey.So(parents[1], convey.ShouldNotEqual, rowTraceID)
+	})
+
+	convey.Convey("an update goes to the update api and an empty message records no delay", t, func() {
+		value, _ := json.Marshal(&Msg{
+			Type: "UPDATE",
+			Data: []map[string]interface{}{{"review_id": "1", "helpful_count": "4"}},
+			Old:  []map[string]interface{}{{"helpful_count": "3"}},
+		})
+		jw.handleMessage(kafka.Message{Topic: "review", Partition: 0, Offset: 7, HighWaterMark: 8, Value: value})
+		convey.So(testutil.ToFloat64(metricKafkaLag.WithLabelValues("0")), convey.ShouldEqual, 0)
+		doc := es.docs["POST /reviews/_update/1"]
+		convey.So(doc, convey.ShouldNotBeNil)
+		convey.So(doc["doc"].(map[string]interface{})["helpful_count"], convey.ShouldEqual, 4)
+
+		delays := histogramCount(metricIndexDelay)
+		jw.handleMessage(kafka.Message{Topic: "review", Partition: 0, Offset: 8, HighWaterMark: 9, Value: []byte("not json")})
+		convey.So(histogramCount(metricIndexDelay), convey.ShouldEqual, delays)
+	})
+}
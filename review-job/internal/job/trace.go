@@ -0,0 +1,70 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// kafkaHeaderCarrier 用kafka消息头传递链路，生产者Inject，消费者Extract
+type kafkaHeaderCarrier []kafka.Header
+
+func (c *kafkaHeaderCarrier) Get(key string) string {
+	for _, h := range *c {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c *kafkaHeaderCarrier) Set(key, value string) {
+	for i := range *c {
+		if (*c)[i].Key == key {
+			(*c)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c = append(*c, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c *kafkaHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c))
+	for _, h := range *c {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
+
+// traceColumn review-service写评价时记录请求链路的列，只用来接链路，不写入ES
+const traceColumn = "trace_ctx"
+
+// rowTraceContext 消息头带了链路时以消息头为准
+// canal投递的binlog消息没有自定义消息头，review-service写评价时把链路记在了trace_ctx列里
+func rowTraceContext(ctx context.Context, m kafka.Message, msg *Msg, i int) context.Context {
+	carrier := kafkaHeaderCarrier(m.Headers)
+	if c := otel.GetTextMapPropagator().Extract(ctx, &carrier); trace.SpanContextFromContext(c).IsValid() {
+		return c
+	}
+	//UPDATE时trace_ctx没变，说明这次修改不是带链路的请求写的，不能沿用上一次的链路
+	if msg.Type == "UPDATE" {
+		if i >= len(msg.Old) {
+			return ctx
+		}
+		if _, ok := msg.Old[i][traceColumn]; !ok {
+			return ctx
+		}
+	}
+	tc, _ := msg.Data[i][traceColumn].(string)
+	if tc == "" {
+		return ctx
+	}
+	var mc propagation.MapCarrier
+	if err := json.Unmarshal([]byte(tc), &mc); err != nil {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, mc)
+}
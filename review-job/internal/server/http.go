@@ -1,20 +1,26 @@
 package server
 
 import (
+	"review-common/health"
+	"review-common/telemetry"
 	v1 "review-job/api/helloworld/v1"
 	"review-job/internal/conf"
 	"review-job/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, h *health.Health, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			telemetry.ServerMetrics(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -27,6 +33,9 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
+	srv.HandleFunc("/healthz", h.Liveness)
+	srv.HandleFunc("/readyz", h.Readiness)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
 	return srv
 }
@@ -544,3 +544,3795 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AuditReviewReplyValidationError{}
+
+// Validate checks the field values on BatchAuditReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchAuditReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAuditReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAuditReviewsRequestMultiError, or nil if none found.
+func (m *BatchAuditReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAuditReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetReviewIDs()); l < 1 || l > 100 {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "ReviewIDs",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchAuditReviewsRequest_ReviewIDs_Unique := make(map[int64]struct{}, len(m.GetReviewIDs()))
+
+	for idx, item := range m.GetReviewIDs() {
+		_, _ = idx, item
+
+		if _, exists := _BatchAuditReviewsRequest_ReviewIDs_Unique[item]; exists {
+			err := BatchAuditReviewsRequestValidationError{
+				field:  fmt.Sprintf("ReviewIDs[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchAuditReviewsRequest_ReviewIDs_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchAuditReviewsRequestValidationError{
+				field:  fmt.Sprintf("ReviewIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _BatchAuditReviewsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [10 20 30 40]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpRemarks
+
+	if len(errors) > 0 {
+		return BatchAuditReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAuditReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchAuditReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAuditReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAuditReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAuditReviewsRequestMultiError) AllErrors() []error { return m }
+
+// BatchAuditReviewsRequestValidationError is the validation error returned by
+// BatchAuditReviewsRequest.Validate if the designated constraints aren't met.
+type BatchAuditReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAuditReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAuditReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAuditReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAuditReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAuditReviewsRequestValidationError) ErrorName() string {
+	return "BatchAuditReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchAuditReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAuditReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAuditReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAuditReviewsRequestValidationError{}
+
+var _BatchAuditReviewsRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on BatchAuditAppealsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchAuditAppealsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAuditAppealsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAuditAppealsRequestMultiError, or nil if none found.
+func (m *BatchAuditAppealsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAuditAppealsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetAppealIDs()); l < 1 || l > 100 {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "AppealIDs",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchAuditAppealsRequest_AppealIDs_Unique := make(map[int64]struct{}, len(m.GetAppealIDs()))
+
+	for idx, item := range m.GetAppealIDs() {
+		_, _ = idx, item
+
+		if _, exists := _BatchAuditAppealsRequest_AppealIDs_Unique[item]; exists {
+			err := BatchAuditAppealsRequestValidationError{
+				field:  fmt.Sprintf("AppealIDs[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchAuditAppealsRequest_AppealIDs_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchAuditAppealsRequestValidationError{
+				field:  fmt.Sprintf("AppealIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _BatchAuditAppealsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [10 20 30 40]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpRemarks
+
+	if len(errors) > 0 {
+		return BatchAuditAppealsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAuditAppealsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchAuditAppealsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAuditAppealsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAuditAppealsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAuditAppealsRequestMultiError) AllErrors() []error { return m }
+
+// BatchAuditAppealsRequestValidationError is the validation error returned by
+// BatchAuditAppealsRequest.Validate if the designated constraints aren't met.
+type BatchAuditAppealsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAuditAppealsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAuditAppealsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAuditAppealsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAuditAppealsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAuditAppealsRequestValidationError) ErrorName() string {
+	return "BatchAuditAppealsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchAuditAppealsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAuditAppealsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAuditAppealsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAuditAppealsRequestValidationError{}
+
+var _BatchAuditAppealsRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on AuditResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditResultMultiError, or
+// nil if none found.
+func (m *AuditResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Success
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return AuditResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditResultMultiError is an error wrapping multiple validation errors
+// returned by AuditResult.ValidateAll() if the designated constraints aren't met.
+type AuditResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditResultMultiError) AllErrors() []error { return m }
+
+// AuditResultValidationError is the validation error returned by
+// AuditResult.Validate if the designated constraints aren't met.
+type AuditResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditResultValidationError) ErrorName() string { return "AuditResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditResultValidationError{}
+
+// Validate checks the field values on BatchAuditReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchAuditReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAuditReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAuditReplyMultiError, or nil if none found.
+func (m *BatchAuditReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAuditReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchAuditReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchAuditReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchAuditReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return BatchAuditReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAuditReplyMultiError is an error wrapping multiple validation errors
+// returned by BatchAuditReply.ValidateAll() if the designated constraints
+// aren't met.
+type BatchAuditReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAuditReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAuditReplyMultiError) AllErrors() []error { return m }
+
+// BatchAuditReplyValidationError is the validation error returned by
+// BatchAuditReply.Validate if the designated constraints aren't met.
+type BatchAuditReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAuditReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAuditReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAuditReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAuditReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAuditReplyValidationError) ErrorName() string { return "BatchAuditReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchAuditReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAuditReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAuditReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAuditReplyValidationError{}
+
+// Validate checks the field values on ListReportedReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportedReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportedReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportedReviewsRequestMultiError, or nil if none found.
+func (m *ListReportedReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportedReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() <= 0 {
+		err := ListReportedReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListReportedReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReportedReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportedReviewsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReportedReviewsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReportedReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportedReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportedReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportedReviewsRequestValidationError is the validation error returned
+// by ListReportedReviewsRequest.Validate if the designated constraints aren't met.
+type ListReportedReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportedReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportedReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportedReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportedReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportedReviewsRequestValidationError) ErrorName() string {
+	return "ListReportedReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportedReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportedReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportedReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportedReviewsRequestValidationError{}
+
+// Validate checks the field values on ReportReasonCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportReasonCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReasonCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReasonCountMultiError, or nil if none found.
+func (m *ReportReasonCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReasonCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReportReasonCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReasonCountMultiError is an error wrapping multiple validation errors
+// returned by ReportReasonCount.ValidateAll() if the designated constraints
+// aren't met.
+type ReportReasonCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReasonCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReasonCountMultiError) AllErrors() []error { return m }
+
+// ReportReasonCountValidationError is the validation error returned by
+// ReportReasonCount.Validate if the designated constraints aren't met.
+type ReportReasonCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReasonCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReasonCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReasonCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReasonCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReasonCountValidationError) ErrorName() string {
+	return "ReportReasonCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReasonCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReasonCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReasonCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReasonCountValidationError{}
+
+// Validate checks the field values on ReportedReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportedReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportedReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportedReviewInfoMultiError, or nil if none found.
+func (m *ReportedReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportedReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for UserID
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for ReportCount
+
+	for idx, item := range m.GetReasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportedReviewInfoValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportedReviewInfoValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportedReviewInfoValidationError{
+					field:  fmt.Sprintf("Reasons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReportedReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportedReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by ReportedReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReportedReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportedReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportedReviewInfoMultiError) AllErrors() []error { return m }
+
+// ReportedReviewInfoValidationError is the validation error returned by
+// ReportedReviewInfo.Validate if the designated constraints aren't met.
+type ReportedReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportedReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportedReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportedReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportedReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportedReviewInfoValidationError) ErrorName() string {
+	return "ReportedReviewInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportedReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportedReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportedReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportedReviewInfoValidationError{}
+
+// Validate checks the field values on ListReportedReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportedReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportedReviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportedReviewsReplyMultiError, or nil if none found.
+func (m *ListReportedReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportedReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportedReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportedReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportedReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportedReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportedReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReportedReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportedReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportedReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportedReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListReportedReviewsReplyValidationError is the validation error returned by
+// ListReportedReviewsReply.Validate if the designated constraints aren't met.
+type ListReportedReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportedReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportedReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportedReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportedReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportedReviewsReplyValidationError) ErrorName() string {
+	return "ListReportedReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportedReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportedReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportedReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportedReviewsReplyValidationError{}
+
+// Validate checks the field values on ClaimInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimInfoMultiError, or nil
+// if none found.
+func (m *ClaimInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpUser
+
+	// no validation rules for ExpireAt
+
+	if len(errors) > 0 {
+		return ClaimInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimInfoMultiError is an error wrapping multiple validation errors returned
+// by ClaimInfo.ValidateAll() if the designated constraints aren't met.
+type ClaimInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimInfoMultiError) AllErrors() []error { return m }
+
+// ClaimInfoValidationError is the validation error returned by
+// ClaimInfo.Validate if the designated constraints aren't met.
+type ClaimInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimInfoValidationError) ErrorName() string { return "ClaimInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimInfoValidationError{}
+
+// Validate checks the field values on SlaInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SlaInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SlaInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SlaInfoMultiError, or nil if none found.
+func (m *SlaInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SlaInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SlaSeconds
+
+	// no validation rules for Total
+
+	// no validation rules for Breached
+
+	// no validation rules for OldestAgeSeconds
+
+	if len(errors) > 0 {
+		return SlaInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SlaInfoMultiError is an error wrapping multiple validation errors returned
+// by SlaInfo.ValidateAll() if the designated constraints aren't met.
+type SlaInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SlaInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SlaInfoMultiError) AllErrors() []error { return m }
+
+// SlaInfoValidationError is the validation error returned by SlaInfo.Validate
+// if the designated constraints aren't met.
+type SlaInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SlaInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SlaInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SlaInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SlaInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SlaInfoValidationError) ErrorName() string { return "SlaInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SlaInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSlaInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SlaInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SlaInfoValidationError{}
+
+// Validate checks the field values on ListPendingReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingReviewsRequestMultiError, or nil if none found.
+func (m *ListPendingReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListPendingReviewsRequest_HasMedia_InLookup[m.GetHasMedia()]; !ok {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "HasMedia",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinReportCount() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "MinReportCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinAgeMinutes() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "MinAgeMinutes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPendingReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPendingReviewsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPendingReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingReviewsRequestValidationError is the validation error returned by
+// ListPendingReviewsRequest.Validate if the designated constraints aren't met.
+type ListPendingReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingReviewsRequestValidationError) ErrorName() string {
+	return "ListPendingReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingReviewsRequestValidationError{}
+
+var _ListPendingReviewsRequest_HasMedia_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on PendingReviewInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingReviewInfoMultiError, or nil if none found.
+func (m *PendingReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for UserID
+
+	// no validation rules for OrderID
+
+	// no validation rules for Score
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for HasMedia
+
+	// no validation rules for ReportCount
+
+	// no validation rules for Anonymous
+
+	// no validation rules for CreateAt
+
+	// no validation rules for AgeSeconds
+
+	// no validation rules for SlaBreached
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PendingReviewInfoValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PendingReviewInfoValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PendingReviewInfoValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PendingReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by PendingReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type PendingReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingReviewInfoMultiError) AllErrors() []error { return m }
+
+// PendingReviewInfoValidationError is the validation error returned by
+// PendingReviewInfo.Validate if the designated constraints aren't met.
+type PendingReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingReviewInfoValidationError) ErrorName() string {
+	return "PendingReviewInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingReviewInfoValidationError{}
+
+// Validate checks the field values on ListPendingReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingReviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingReviewsReplyMultiError, or nil if none found.
+func (m *ListPendingReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSla()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPendingReviewsReplyValidationError{
+					field:  "Sla",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPendingReviewsReplyValidationError{
+					field:  "Sla",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSla()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPendingReviewsReplyValidationError{
+				field:  "Sla",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListPendingReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPendingReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListPendingReviewsReplyValidationError is the validation error returned by
+// ListPendingReviewsReply.Validate if the designated constraints aren't met.
+type ListPendingReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingReviewsReplyValidationError) ErrorName() string {
+	return "ListPendingReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingReviewsReplyValidationError{}
+
+// Validate checks the field values on ListPendingAppealsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingAppealsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingAppealsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingAppealsRequestMultiError, or nil if none found.
+func (m *ListPendingAppealsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingAppealsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() < 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinAgeMinutes() < 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "MinAgeMinutes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPendingAppealsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingAppealsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPendingAppealsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPendingAppealsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingAppealsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingAppealsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingAppealsRequestValidationError is the validation error returned by
+// ListPendingAppealsRequest.Validate if the designated constraints aren't met.
+type ListPendingAppealsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingAppealsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingAppealsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingAppealsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingAppealsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingAppealsRequestValidationError) ErrorName() string {
+	return "ListPendingAppealsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingAppealsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingAppealsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingAppealsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingAppealsRequestValidationError{}
+
+// Validate checks the field values on PendingAppealInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingAppealInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingAppealInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingAppealInfoMultiError, or nil if none found.
+func (m *PendingAppealInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingAppealInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for CreateAt
+
+	// no validation rules for AgeSeconds
+
+	// no validation rules for SlaBreached
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PendingAppealInfoValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PendingAppealInfoValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PendingAppealInfoValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PendingAppealInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingAppealInfoMultiError is an error wrapping multiple validation errors
+// returned by PendingAppealInfo.ValidateAll() if the designated constraints
+// aren't met.
+type PendingAppealInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingAppealInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingAppealInfoMultiError) AllErrors() []error { return m }
+
+// PendingAppealInfoValidationError is the validation error returned by
+// PendingAppealInfo.Validate if the designated constraints aren't met.
+type PendingAppealInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingAppealInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingAppealInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingAppealInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingAppealInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingAppealInfoValidationError) ErrorName() string {
+	return "PendingAppealInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingAppealInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingAppealInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingAppealInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingAppealInfoValidationError{}
+
+// Validate checks the field values on ListPendingAppealsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingAppealsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingAppealsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingAppealsReplyMultiError, or nil if none found.
+func (m *ListPendingAppealsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingAppealsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingAppealsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSla()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPendingAppealsReplyValidationError{
+					field:  "Sla",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPendingAppealsReplyValidationError{
+					field:  "Sla",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSla()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPendingAppealsReplyValidationError{
+				field:  "Sla",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListPendingAppealsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingAppealsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPendingAppealsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingAppealsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingAppealsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingAppealsReplyMultiError) AllErrors() []error { return m }
+
+// ListPendingAppealsReplyValidationError is the validation error returned by
+// ListPendingAppealsReply.Validate if the designated constraints aren't met.
+type ListPendingAppealsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingAppealsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingAppealsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingAppealsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingAppealsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingAppealsReplyValidationError) ErrorName() string {
+	return "ListPendingAppealsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingAppealsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingAppealsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingAppealsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingAppealsReplyValidationError{}
+
+// Validate checks the field values on ClaimReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimReviewRequestMultiError, or nil if none found.
+func (m *ClaimReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := ClaimReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := ClaimReviewRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by ClaimReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimReviewRequestMultiError) AllErrors() []error { return m }
+
+// ClaimReviewRequestValidationError is the validation error returned by
+// ClaimReviewRequest.Validate if the designated constraints aren't met.
+type ClaimReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimReviewRequestValidationError) ErrorName() string {
+	return "ClaimReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClaimReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimReviewRequestValidationError{}
+
+// Validate checks the field values on ReleaseReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseReviewRequestMultiError, or nil if none found.
+func (m *ReleaseReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := ReleaseReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := ReleaseReviewRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseReviewRequestValidationError is the validation error returned by
+// ReleaseReviewRequest.Validate if the designated constraints aren't met.
+type ReleaseReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReviewRequestValidationError) ErrorName() string {
+	return "ReleaseReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReviewRequestValidationError{}
+
+// Validate checks the field values on ClaimAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimAppealRequestMultiError, or nil if none found.
+func (m *ClaimAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppealID() <= 0 {
+		err := ClaimAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := ClaimAppealRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimAppealRequestMultiError is an error wrapping multiple validation errors
+// returned by ClaimAppealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimAppealRequestMultiError) AllErrors() []error { return m }
+
+// ClaimAppealRequestValidationError is the validation error returned by
+// ClaimAppealRequest.Validate if the designated constraints aren't met.
+type ClaimAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimAppealRequestValidationError) ErrorName() string {
+	return "ClaimAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClaimAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimAppealRequestValidationError{}
+
+// Validate checks the field values on ReleaseAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseAppealRequestMultiError, or nil if none found.
+func (m *ReleaseAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppealID() <= 0 {
+		err := ReleaseAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := ReleaseAppealRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseAppealRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseAppealRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseAppealRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseAppealRequestValidationError is the validation error returned by
+// ReleaseAppealRequest.Validate if the designated constraints aren't met.
+type ReleaseAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseAppealRequestValidationError) ErrorName() string {
+	return "ReleaseAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseAppealRequestValidationError{}
+
+// Validate checks the field values on ClaimReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimReplyMultiError, or
+// nil if none found.
+func (m *ClaimReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimReplyValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimReplyValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimReplyValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ClaimReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimReplyMultiError is an error wrapping multiple validation errors
+// returned by ClaimReply.ValidateAll() if the designated constraints aren't met.
+type ClaimReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimReplyMultiError) AllErrors() []error { return m }
+
+// ClaimReplyValidationError is the validation error returned by
+// ClaimReply.Validate if the designated constraints aren't met.
+type ClaimReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimReplyValidationError) ErrorName() string { return "ClaimReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimReplyValidationError{}
+
+// Validate checks the field values on ReleaseReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReleaseReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReleaseReplyMultiError, or
+// nil if none found.
+func (m *ReleaseReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseReplyMultiError is an error wrapping multiple validation errors
+// returned by ReleaseReply.ValidateAll() if the designated constraints aren't met.
+type ReleaseReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseReplyMultiError) AllErrors() []error { return m }
+
+// ReleaseReplyValidationError is the validation error returned by
+// ReleaseReply.Validate if the designated constraints aren't met.
+type ReleaseReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseReplyValidationError) ErrorName() string { return "ReleaseReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReleaseReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseReplyValidationError{}
+
+// Validate checks the field values on RequestAppealInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAppealInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAppealInfoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestAppealInfoRequestMultiError, or nil if none found.
+func (m *RequestAppealInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAppealInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppealID() <= 0 {
+		err := RequestAppealInfoRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := RequestAppealInfoRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
+		err := RequestAppealInfoRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 2 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestAppealInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestAppealInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestAppealInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestAppealInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAppealInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAppealInfoRequestMultiError) AllErrors() []error { return m }
+
+// RequestAppealInfoRequestValidationError is the validation error returned by
+// RequestAppealInfoRequest.Validate if the designated constraints aren't met.
+type RequestAppealInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAppealInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAppealInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAppealInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAppealInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAppealInfoRequestValidationError) ErrorName() string {
+	return "RequestAppealInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAppealInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAppealInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAppealInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAppealInfoRequestValidationError{}
+
+// Validate checks the field values on RequestAppealInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAppealInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAppealInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestAppealInfoReplyMultiError, or nil if none found.
+func (m *RequestAppealInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAppealInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestAppealInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestAppealInfoReplyMultiError is an error wrapping multiple validation
+// errors returned by RequestAppealInfoReply.ValidateAll() if the designated
+// constraints aren't met.
+type RequestAppealInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAppealInfoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAppealInfoReplyMultiError) AllErrors() []error { return m }
+
+// RequestAppealInfoReplyValidationError is the validation error returned by
+// RequestAppealInfoReply.Validate if the designated constraints aren't met.
+type RequestAppealInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAppealInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAppealInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAppealInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAppealInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAppealInfoReplyValidationError) ErrorName() string {
+	return "RequestAppealInfoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAppealInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAppealInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAppealInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAppealInfoReplyValidationError{}
+
+// Validate checks the field values on GetAppealHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealHistoryRequestMultiError, or nil if none found.
+func (m *GetAppealHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := GetAppealHistoryRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAppealHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAppealHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppealHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetAppealHistoryRequestValidationError is the validation error returned by
+// GetAppealHistoryRequest.Validate if the designated constraints aren't met.
+type GetAppealHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealHistoryRequestValidationError) ErrorName() string {
+	return "GetAppealHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealHistoryRequestValidationError{}
+
+// Validate checks the field values on AppealInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppealInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppealInfoMultiError, or
+// nil if none found.
+func (m *AppealInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Round
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for CreateAt
+
+	// no validation rules for UpdateAt
+
+	if len(errors) > 0 {
+		return AppealInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealInfoMultiError is an error wrapping multiple validation errors
+// returned by AppealInfo.ValidateAll() if the designated constraints aren't met.
+type AppealInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealInfoMultiError) AllErrors() []error { return m }
+
+// AppealInfoValidationError is the validation error returned by
+// AppealInfo.Validate if the designated constraints aren't met.
+type AppealInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealInfoValidationError) ErrorName() string { return "AppealInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppealInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealInfoValidationError{}
+
+// Validate checks the field values on AppealMessageInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppealMessageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealMessageInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealMessageInfoMultiError, or nil if none found.
+func (m *AppealMessageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealMessageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageID
+
+	// no validation rules for AppealID
+
+	// no validation rules for SenderType
+
+	// no validation rules for Sender
+
+	// no validation rules for Action
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return AppealMessageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealMessageInfoMultiError is an error wrapping multiple validation errors
+// returned by AppealMessageInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AppealMessageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealMessageInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealMessageInfoMultiError) AllErrors() []error { return m }
+
+// AppealMessageInfoValidationError is the validation error returned by
+// AppealMessageInfo.Validate if the designated constraints aren't met.
+type AppealMessageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealMessageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealMessageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealMessageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealMessageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealMessageInfoValidationError) ErrorName() string {
+	return "AppealMessageInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealMessageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealMessageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealMessageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealMessageInfoValidationError{}
+
+// Validate checks the field values on GetAppealHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealHistoryReplyMultiError, or nil if none found.
+func (m *GetAppealHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAppeals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Appeals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Appeals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppealHistoryReplyValidationError{
+					field:  fmt.Sprintf("Appeals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppealHistoryReplyValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAppealHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAppealHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppealHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealHistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealHistoryReplyMultiError) AllErrors() []error { return m }
+
+// GetAppealHistoryReplyValidationError is the validation error returned by
+// GetAppealHistoryReply.Validate if the designated constraints aren't met.
+type GetAppealHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealHistoryReplyValidationError) ErrorName() string {
+	return "GetAppealHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealHistoryReplyValidationError{}
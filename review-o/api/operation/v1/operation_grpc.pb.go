@@ -19,8 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Operation_AuditReview_FullMethodName = "/api.operation.v1.Operation/AuditReview"
-	Operation_AuditAppeal_FullMethodName = "/api.operation.v1.Operation/AuditAppeal"
+	Operation_AuditReview_FullMethodName         = "/api.operation.v1.Operation/AuditReview"
+	Operation_AuditAppeal_FullMethodName         = "/api.operation.v1.Operation/AuditAppeal"
+	Operation_BatchAuditReviews_FullMethodName   = "/api.operation.v1.Operation/BatchAuditReviews"
+	Operation_BatchAuditAppeals_FullMethodName   = "/api.operation.v1.Operation/BatchAuditAppeals"
+	Operation_ListReportedReviews_FullMethodName = "/api.operation.v1.Operation/ListReportedReviews"
+	Operation_ListPendingReviews_FullMethodName  = "/api.operation.v1.Operation/ListPendingReviews"
+	Operation_ListPendingAppeals_FullMethodName  = "/api.operation.v1.Operation/ListPendingAppeals"
+	Operation_ClaimReview_FullMethodName         = "/api.operation.v1.Operation/ClaimReview"
+	Operation_ReleaseReview_FullMethodName       = "/api.operation.v1.Operation/ReleaseReview"
+	Operation_ClaimAppeal_FullMethodName         = "/api.operation.v1.Operation/ClaimAppeal"
+	Operation_ReleaseAppeal_FullMethodName       = "/api.operation.v1.Operation/ReleaseAppeal"
+	Operation_RequestAppealInfo_FullMethodName   = "/api.operation.v1.Operation/RequestAppealInfo"
+	Operation_GetAppealHistory_FullMethodName    = "/api.operation.v1.Operation/GetAppealHistory"
 )
 
 // OperationClient is the client API for Operation service.
@@ -31,6 +42,28 @@ type OperationClient interface {
 	AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error)
 	// O端审核申诉
 	AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...grpc.CallOption) (*AuditAppealReply, error)
+	// O端批量审核评价,被其他运营认领的评价不处理,逐条返回结果
+	BatchAuditReviews(ctx context.Context, in *BatchAuditReviewsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error)
+	// O端批量审核申诉
+	BatchAuditAppeals(ctx context.Context, in *BatchAuditAppealsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error)
+	// O端查询被举报的评价
+	ListReportedReviews(ctx context.Context, in *ListReportedReviewsRequest, opts ...grpc.CallOption) (*ListReportedReviewsReply, error)
+	// O端查询待审核评价,按等待时长倒序
+	ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsReply, error)
+	// O端查询待审核申诉,按等待时长倒序
+	ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...grpc.CallOption) (*ListPendingAppealsReply, error)
+	// O端认领待审核评价,重复认领会续期
+	ClaimReview(ctx context.Context, in *ClaimReviewRequest, opts ...grpc.CallOption) (*ClaimReply, error)
+	// O端放弃认领的评价
+	ReleaseReview(ctx context.Context, in *ReleaseReviewRequest, opts ...grpc.CallOption) (*ReleaseReply, error)
+	// O端认领待审核申诉
+	ClaimAppeal(ctx context.Context, in *ClaimAppealRequest, opts ...grpc.CallOption) (*ClaimReply, error)
+	// O端放弃认领的申诉
+	ReleaseAppeal(ctx context.Context, in *ReleaseAppealRequest, opts ...grpc.CallOption) (*ReleaseReply, error)
+	// O端要求商家补充申诉材料,申诉转为待补充,商家补充后回到待审核
+	RequestAppealInfo(ctx context.Context, in *RequestAppealInfoRequest, opts ...grpc.CallOption) (*RequestAppealInfoReply, error)
+	// O端查询评价的历次申诉和沟通记录
+	GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...grpc.CallOption) (*GetAppealHistoryReply, error)
 }
 
 type operationClient struct {
@@ -59,6 +92,105 @@ func (c *operationClient) AuditAppeal(ctx context.Context, in *AuditAppealReques
 	return out, nil
 }
 
+func (c *operationClient) BatchAuditReviews(ctx context.Context, in *BatchAuditReviewsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error) {
+	out := new(BatchAuditReply)
+	err := c.cc.Invoke(ctx, Operation_BatchAuditReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) BatchAuditAppeals(ctx context.Context, in *BatchAuditAppealsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error) {
+	out := new(BatchAuditReply)
+	err := c.cc.Invoke(ctx, Operation_BatchAuditAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ListReportedReviews(ctx context.Context, in *ListReportedReviewsRequest, opts ...grpc.CallOption) (*ListReportedReviewsReply, error) {
+	out := new(ListReportedReviewsReply)
+	err := c.cc.Invoke(ctx, Operation_ListReportedReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsReply, error) {
+	out := new(ListPendingReviewsReply)
+	err := c.cc.Invoke(ctx, Operation_ListPendingReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...grpc.CallOption) (*ListPendingAppealsReply, error) {
+	out := new(ListPendingAppealsReply)
+	err := c.cc.Invoke(ctx, Operation_ListPendingAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ClaimReview(ctx context.Context, in *ClaimReviewRequest, opts ...grpc.CallOption) (*ClaimReply, error) {
+	out := new(ClaimReply)
+	err := c.cc.Invoke(ctx, Operation_ClaimReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ReleaseReview(ctx context.Context, in *ReleaseReviewRequest, opts ...grpc.CallOption) (*ReleaseReply, error) {
+	out := new(ReleaseReply)
+	err := c.cc.Invoke(ctx, Operation_ReleaseReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ClaimAppeal(ctx context.Context, in *ClaimAppealRequest, opts ...grpc.CallOption) (*ClaimReply, error) {
+	out := new(ClaimReply)
+	err := c.cc.Invoke(ctx, Operation_ClaimAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) ReleaseAppeal(ctx context.Context, in *ReleaseAppealRequest, opts ...grpc.CallOption) (*ReleaseReply, error) {
+	out := new(ReleaseReply)
+	err := c.cc.Invoke(ctx, Operation_ReleaseAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) RequestAppealInfo(ctx context.Context, in *RequestAppealInfoRequest, opts ...grpc.CallOption) (*RequestAppealInfoReply, error) {
+	out := new(RequestAppealInfoReply)
+	err := c.cc.Invoke(ctx, Operation_RequestAppealInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationClient) GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...grpc.CallOption) (*GetAppealHistoryReply, error) {
+	out := new(GetAppealHistoryReply)
+	err := c.cc.Invoke(ctx, Operation_GetAppealHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperationServer is the server API for Operation service.
 // All implementations must embed UnimplementedOperationServer
 // for forward compatibility
@@ -67,6 +199,28 @@ type OperationServer interface {
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	// O端审核申诉
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
+	// O端批量审核评价,被其他运营认领的评价不处理,逐条返回结果
+	BatchAuditReviews(context.Context, *BatchAuditReviewsRequest) (*BatchAuditReply, error)
+	// O端批量审核申诉
+	BatchAuditAppeals(context.Context, *BatchAuditAppealsRequest) (*BatchAuditReply, error)
+	// O端查询被举报的评价
+	ListReportedReviews(context.Context, *ListReportedReviewsRequest) (*ListReportedReviewsReply, error)
+	// O端查询待审核评价,按等待时长倒序
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsReply, error)
+	// O端查询待审核申诉,按等待时长倒序
+	ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsReply, error)
+	// O端认领待审核评价,重复认领会续期
+	ClaimReview(context.Context, *ClaimReviewRequest) (*ClaimReply, error)
+	// O端放弃认领的评价
+	ReleaseReview(context.Context, *ReleaseReviewRequest) (*ReleaseReply, error)
+	// O端认领待审核申诉
+	ClaimAppeal(context.Context, *ClaimAppealRequest) (*ClaimReply, error)
+	// O端放弃认领的申诉
+	ReleaseAppeal(context.Context, *ReleaseAppealRequest) (*ReleaseReply, error)
+	// O端要求商家补充申诉材料,申诉转为待补充,商家补充后回到待审核
+	RequestAppealInfo(context.Context, *RequestAppealInfoRequest) (*RequestAppealInfoReply, error)
+	// O端查询评价的历次申诉和沟通记录
+	GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error)
 	mustEmbedUnimplementedOperationServer()
 }
 
@@ -80,6 +234,39 @@ func (UnimplementedOperationServer) AuditReview(context.Context, *AuditReviewReq
 func (UnimplementedOperationServer) AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditAppeal not implemented")
 }
+func (UnimplementedOperationServer) BatchAuditReviews(context.Context, *BatchAuditReviewsRequest) (*BatchAuditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAuditReviews not implemented")
+}
+func (UnimplementedOperationServer) BatchAuditAppeals(context.Context, *BatchAuditAppealsRequest) (*BatchAuditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAuditAppeals not implemented")
+}
+func (UnimplementedOperationServer) ListReportedReviews(context.Context, *ListReportedReviewsRequest) (*ListReportedReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportedReviews not implemented")
+}
+func (UnimplementedOperationServer) ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedOperationServer) ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingAppeals not implemented")
+}
+func (UnimplementedOperationServer) ClaimReview(context.Context, *ClaimReviewRequest) (*ClaimReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReview not implemented")
+}
+func (UnimplementedOperationServer) ReleaseReview(context.Context, *ReleaseReviewRequest) (*ReleaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReview not implemented")
+}
+func (UnimplementedOperationServer) ClaimAppeal(context.Context, *ClaimAppealRequest) (*ClaimReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAppeal not implemented")
+}
+func (UnimplementedOperationServer) ReleaseAppeal(context.Context, *ReleaseAppealRequest) (*ReleaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAppeal not implemented")
+}
+func (UnimplementedOperationServer) RequestAppealInfo(context.Context, *RequestAppealInfoRequest) (*RequestAppealInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAppealInfo not implemented")
+}
+func (UnimplementedOperationServer) GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppealHistory not implemented")
+}
 func (UnimplementedOperationServer) mustEmbedUnimplementedOperationServer() {}
 
 // UnsafeOperationServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +316,204 @@ func _Operation_AuditAppeal_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Operation_BatchAuditReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAuditReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).BatchAuditReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_BatchAuditReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).BatchAuditReviews(ctx, req.(*BatchAuditReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_BatchAuditAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAuditAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).BatchAuditAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_BatchAuditAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).BatchAuditAppeals(ctx, req.(*BatchAuditAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ListReportedReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportedReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ListReportedReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ListReportedReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ListReportedReviews(ctx, req.(*ListReportedReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ListPendingReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ListPendingReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ListPendingAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ListPendingAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ListPendingAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ListPendingAppeals(ctx, req.(*ListPendingAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ClaimReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ClaimReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ClaimReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ClaimReview(ctx, req.(*ClaimReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ReleaseReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ReleaseReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ReleaseReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ReleaseReview(ctx, req.(*ReleaseReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ClaimAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ClaimAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ClaimAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ClaimAppeal(ctx, req.(*ClaimAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_ReleaseAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).ReleaseAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_ReleaseAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).ReleaseAppeal(ctx, req.(*ReleaseAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_RequestAppealInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAppealInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).RequestAppealInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_RequestAppealInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).RequestAppealInfo(ctx, req.(*RequestAppealInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operation_GetAppealHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServer).GetAppealHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Operation_GetAppealHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServer).GetAppealHistory(ctx, req.(*GetAppealHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Operation_ServiceDesc is the grpc.ServiceDesc for Operation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +529,50 @@ var Operation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuditAppeal",
 			Handler:    _Operation_AuditAppeal_Handler,
 		},
+		{
+			MethodName: "BatchAuditReviews",
+			Handler:    _Operation_BatchAuditReviews_Handler,
+		},
+		{
+			MethodName: "BatchAuditAppeals",
+			Handler:    _Operation_BatchAuditAppeals_Handler,
+		},
+		{
+			MethodName: "ListReportedReviews",
+			Handler:    _Operation_ListReportedReviews_Handler,
+		},
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _Operation_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "ListPendingAppeals",
+			Handler:    _Operation_ListPendingAppeals_Handler,
+		},
+		{
+			MethodName: "ClaimReview",
+			Handler:    _Operation_ClaimReview_Handler,
+		},
+		{
+			MethodName: "ReleaseReview",
+			Handler:    _Operation_ReleaseReview_Handler,
+		},
+		{
+			MethodName: "ClaimAppeal",
+			Handler:    _Operation_ClaimAppeal_Handler,
+		},
+		{
+			MethodName: "ReleaseAppeal",
+			Handler:    _Operation_ReleaseAppeal_Handler,
+		},
+		{
+			MethodName: "RequestAppealInfo",
+			Handler:    _Operation_RequestAppealInfo_Handler,
+		},
+		{
+			MethodName: "GetAppealHistory",
+			Handler:    _Operation_GetAppealHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "operation/v1/operation.proto",
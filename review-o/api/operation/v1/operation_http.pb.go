@@ -21,18 +21,62 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationOperationAuditAppeal = "/api.operation.v1.Operation/AuditAppeal"
 const OperationOperationAuditReview = "/api.operation.v1.Operation/AuditReview"
+const OperationOperationBatchAuditAppeals = "/api.operation.v1.Operation/BatchAuditAppeals"
+const OperationOperationBatchAuditReviews = "/api.operation.v1.Operation/BatchAuditReviews"
+const OperationOperationClaimAppeal = "/api.operation.v1.Operation/ClaimAppeal"
+const OperationOperationClaimReview = "/api.operation.v1.Operation/ClaimReview"
+const OperationOperationGetAppealHistory = "/api.operation.v1.Operation/GetAppealHistory"
+const OperationOperationListPendingAppeals = "/api.operation.v1.Operation/ListPendingAppeals"
+const OperationOperationListPendingReviews = "/api.operation.v1.Operation/ListPendingReviews"
+const OperationOperationListReportedReviews = "/api.operation.v1.Operation/ListReportedReviews"
+const OperationOperationReleaseAppeal = "/api.operation.v1.Operation/ReleaseAppeal"
+const OperationOperationReleaseReview = "/api.operation.v1.Operation/ReleaseReview"
+const OperationOperationRequestAppealInfo = "/api.operation.v1.Operation/RequestAppealInfo"
 
 type OperationHTTPServer interface {
 	// AuditAppealO端审核申诉
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
 	// AuditReviewO端审核评价
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
+	// BatchAuditAppealsO端批量审核申诉
+	BatchAuditAppeals(context.Context, *BatchAuditAppealsRequest) (*BatchAuditReply, error)
+	// BatchAuditReviewsO端批量审核评价,被其他运营认领的评价不处理,逐条返回结果
+	BatchAuditReviews(context.Context, *BatchAuditReviewsRequest) (*BatchAuditReply, error)
+	// ClaimAppealO端认领待审核申诉
+	ClaimAppeal(context.Context, *ClaimAppealRequest) (*ClaimReply, error)
+	// ClaimReviewO端认领待审核评价,重复认领会续期
+	ClaimReview(context.Context, *ClaimReviewRequest) (*ClaimReply, error)
+	// GetAppealHistoryO端查询评价的历次申诉和沟通记录
+	GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error)
+	// ListPendingAppealsO端查询待审核申诉,按等待时长倒序
+	ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsReply, error)
+	// ListPendingReviewsO端查询待审核评价,按等待时长倒序
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsReply, error)
+	// ListReportedReviewsO端查询被举报的评价
+	ListReportedReviews(context.Context, *ListReportedReviewsRequest) (*ListReportedReviewsReply, error)
+	// ReleaseAppealO端放弃认领的申诉
+	ReleaseAppeal(context.Context, *ReleaseAppealRequest) (*ReleaseReply, error)
+	// ReleaseReviewO端放弃认领的评价
+	ReleaseReview(context.Context, *ReleaseReviewRequest) (*ReleaseReply, error)
+	// RequestAppealInfoO端要求商家补充申诉材料,申诉转为待补充,商家补充后回到待审核
+	RequestAppealInfo(context.Context, *RequestAppealInfoRequest) (*RequestAppealInfoReply, error)
 }
 
 func RegisterOperationHTTPServer(s *http.Server, srv OperationHTTPServer) {
 	r := s.Route("/")
 	r.POST("operation/v1/review/audit", _Operation_AuditReview0_HTTP_Handler(srv))
 	r.POST("operation/v1/appeal/audit", _Operation_AuditAppeal0_HTTP_Handler(srv))
+	r.POST("operation/v1/reviews/audit", _Operation_BatchAuditReviews0_HTTP_Handler(srv))
+	r.POST("operation/v1/appeals/audit", _Operation_BatchAuditAppeals0_HTTP_Handler(srv))
+	r.GET("operation/v1/reviews/reported", _Operation_ListReportedReviews0_HTTP_Handler(srv))
+	r.GET("operation/v1/reviews/pending", _Operation_ListPendingReviews0_HTTP_Handler(srv))
+	r.GET("operation/v1/appeals/pending", _Operation_ListPendingAppeals0_HTTP_Handler(srv))
+	r.POST("operation/v1/review/claim", _Operation_ClaimReview0_HTTP_Handler(srv))
+	r.POST("operation/v1/review/release", _Operation_ReleaseReview0_HTTP_Handler(srv))
+	r.POST("operation/v1/appeal/claim", _Operation_ClaimAppeal0_HTTP_Handler(srv))
+	r.POST("operation/v1/appeal/release", _Operation_ReleaseAppeal0_HTTP_Handler(srv))
+	r.POST("operation/v1/appeal/request-info", _Operation_RequestAppealInfo0_HTTP_Handler(srv))
+	r.GET("operation/v1/review/{reviewID}/appeals", _Operation_GetAppealHistory0_HTTP_Handler(srv))
 }
 
 func _Operation_AuditReview0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
@@ -79,9 +123,253 @@ func _Operation_AuditAppeal0_HTTP_Handler(srv OperationHTTPServer) func(ctx http
 	}
 }
 
+func _Operation_BatchAuditReviews0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchAuditReviewsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationBatchAuditReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchAuditReviews(ctx, req.(*BatchAuditReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchAuditReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_BatchAuditAppeals0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchAuditAppealsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationBatchAuditAppeals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchAuditAppeals(ctx, req.(*BatchAuditAppealsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchAuditReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ListReportedReviews0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReportedReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationListReportedReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReportedReviews(ctx, req.(*ListReportedReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReportedReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ListPendingReviews0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationListPendingReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPendingReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ListPendingAppeals0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingAppealsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationListPendingAppeals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingAppeals(ctx, req.(*ListPendingAppealsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPendingAppealsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ClaimReview0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClaimReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationClaimReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ClaimReview(ctx, req.(*ClaimReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClaimReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ReleaseReview0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReleaseReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationReleaseReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReleaseReview(ctx, req.(*ReleaseReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReleaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ClaimAppeal0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ClaimAppealRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationClaimAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ClaimAppeal(ctx, req.(*ClaimAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ClaimReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_ReleaseAppeal0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReleaseAppealRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationReleaseAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReleaseAppeal(ctx, req.(*ReleaseAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReleaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_RequestAppealInfo0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestAppealInfoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationRequestAppealInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestAppealInfo(ctx, req.(*RequestAppealInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestAppealInfoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Operation_GetAppealHistory0_HTTP_Handler(srv OperationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppealHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOperationGetAppealHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppealHistory(ctx, req.(*GetAppealHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type OperationHTTPClient interface {
 	AuditAppeal(ctx context.Context, req *AuditAppealRequest, opts ...http.CallOption) (rsp *AuditAppealReply, err error)
 	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
+	BatchAuditAppeals(ctx context.Context, req *BatchAuditAppealsRequest, opts ...http.CallOption) (rsp *BatchAuditReply, err error)
+	BatchAuditReviews(ctx context.Context, req *BatchAuditReviewsRequest, opts ...http.CallOption) (rsp *BatchAuditReply, err error)
+	ClaimAppeal(ctx context.Context, req *ClaimAppealRequest, opts ...http.CallOption) (rsp *ClaimReply, err error)
+	ClaimReview(ctx context.Context, req *ClaimReviewRequest, opts ...http.CallOption) (rsp *ClaimReply, err error)
+	GetAppealHistory(ctx context.Context, req *GetAppealHistoryRequest, opts ...http.CallOption) (rsp *GetAppealHistoryReply, err error)
+	ListPendingAppeals(ctx context.Context, req *ListPendingAppealsRequest, opts ...http.CallOption) (rsp *ListPendingAppealsReply, err error)
+	ListPendingReviews(ctx context.Context, req *ListPendingReviewsRequest, opts ...http.CallOption) (rsp *ListPendingReviewsReply, err error)
+	ListReportedReviews(ctx context.Context, req *ListReportedReviewsRequest, opts ...http.CallOption) (rsp *ListReportedReviewsReply, err error)
+	ReleaseAppeal(ctx context.Context, req *ReleaseAppealRequest, opts ...http.CallOption) (rsp *ReleaseReply, err error)
+	ReleaseReview(ctx context.Context, req *ReleaseReviewRequest, opts ...http.CallOption) (rsp *ReleaseReply, err error)
+	RequestAppealInfo(ctx context.Context, req *RequestAppealInfoRequest, opts ...http.CallOption) (rsp *RequestAppealInfoReply, err error)
 }
 
 type OperationHTTPClientImpl struct {
@@ -117,3 +405,146 @@ func (c *OperationHTTPClientImpl) AuditReview(ctx context.Context, in *AuditRevi
 	}
 	return &out, nil
 }
+
+func (c *OperationHTTPClientImpl) BatchAuditAppeals(ctx context.Context, in *BatchAuditAppealsRequest, opts ...http.CallOption) (*BatchAuditReply, error) {
+	var out BatchAuditReply
+	pattern := "operation/v1/appeals/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationBatchAuditAppeals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) BatchAuditReviews(ctx context.Context, in *BatchAuditReviewsRequest, opts ...http.CallOption) (*BatchAuditReply, error) {
+	var out BatchAuditReply
+	pattern := "operation/v1/reviews/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationBatchAuditReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ClaimAppeal(ctx context.Context, in *ClaimAppealRequest, opts ...http.CallOption) (*ClaimReply, error) {
+	var out ClaimReply
+	pattern := "operation/v1/appeal/claim"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationClaimAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ClaimReview(ctx context.Context, in *ClaimReviewRequest, opts ...http.CallOption) (*ClaimReply, error) {
+	var out ClaimReply
+	pattern := "operation/v1/review/claim"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationClaimReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...http.CallOption) (*GetAppealHistoryReply, error) {
+	var out GetAppealHistoryReply
+	pattern := "operation/v1/review/{reviewID}/appeals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOperationGetAppealHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...http.CallOption) (*ListPendingAppealsReply, error) {
+	var out ListPendingAppealsReply
+	pattern := "operation/v1/appeals/pending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOperationListPendingAppeals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...http.CallOption) (*ListPendingReviewsReply, error) {
+	var out ListPendingReviewsReply
+	pattern := "operation/v1/reviews/pending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOperationListPendingReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ListReportedReviews(ctx context.Context, in *ListReportedReviewsRequest, opts ...http.CallOption) (*ListReportedReviewsReply, error) {
+	var out ListReportedReviewsReply
+	pattern := "operation/v1/reviews/reported"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOperationListReportedReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ReleaseAppeal(ctx context.Context, in *ReleaseAppealRequest, opts ...http.CallOption) (*ReleaseReply, error) {
+	var out ReleaseReply
+	pattern := "operation/v1/appeal/release"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationReleaseAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) ReleaseReview(ctx context.Context, in *ReleaseReviewRequest, opts ...http.CallOption) (*ReleaseReply, error) {
+	var out ReleaseReply
+	pattern := "operation/v1/review/release"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationReleaseReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OperationHTTPClientImpl) RequestAppealInfo(ctx context.Context, in *RequestAppealInfoRequest, opts ...http.CallOption) (*RequestAppealInfoReply, error) {
+	var out RequestAppealInfoReply
+	pattern := "operation/v1/appeal/request-info"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOperationRequestAppealInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -35,31 +35,31 @@ var (
 	_ = sort.Sort
 )
 
-// Validate checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListReviewByStoreIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewRequest) Validate() error {
+func (m *ListReviewByStoreIDRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReviewByStoreIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReviewRequestMultiError, or nil if none found.
-func (m *CreateReviewRequest) ValidateAll() error {
+// ListReviewByStoreIDRequestMultiError, or nil if none found.
+func (m *ListReviewByStoreIDRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewRequest) validate(all bool) error {
+func (m *ListReviewByStoreIDRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserID() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "UserID",
+	if m.GetStoreID() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "StoreID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -68,9 +68,9 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOrderID() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "OrderID",
+	if m.GetPage() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Page",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -79,9 +79,9 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStoreID() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "StoreID",
+	if m.GetSize() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Size",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -90,8 +90,152 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
-		err := CreateReviewRequestValidationError{
+	if _, ok := _ListReviewByStoreIDRequest_Sort_InLookup[m.GetSort()]; !ok {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Sort",
+			reason: "value must be in list [ helpful newest score]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListReviewByStoreIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByStoreIDRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReviewByStoreIDRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReviewByStoreIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByStoreIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByStoreIDRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewByStoreIDRequestValidationError is the validation error returned
+// by ListReviewByStoreIDRequest.Validate if the designated constraints aren't met.
+type ListReviewByStoreIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByStoreIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByStoreIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByStoreIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByStoreIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByStoreIDRequestValidationError) ErrorName() string {
+	return "ListReviewByStoreIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByStoreIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByStoreIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByStoreIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByStoreIDRequestValidationError{}
+
+var _ListReviewByStoreIDRequest_Sort_InLookup = map[string]struct{}{
+	"":        {},
+	"helpful": {},
+	"newest":  {},
+	"score":   {},
+}
+
+// Validate checks the field values on ReviewInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewInfoMultiError, or
+// nil if none found.
+func (m *ReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := ReviewInfoValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderID() <= 0 {
+		err := ReviewInfoValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReviewInfo_Score_InLookup[m.GetScore()]; !ok {
+		err := ReviewInfoValidationError{
 			field:  "Score",
 			reason: "value must be in list [1 2 3 4 5]",
 		}
@@ -101,8 +245,8 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
-		err := CreateReviewRequestValidationError{
+	if _, ok := _ReviewInfo_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := ReviewInfoValidationError{
 			field:  "ServiceScore",
 			reason: "value must be in list [1 2 3 4 5]",
 		}
@@ -112,8 +256,8 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
-		err := CreateReviewRequestValidationError{
+	if _, ok := _ReviewInfo_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := ReviewInfoValidationError{
 			field:  "ExpressScore",
 			reason: "value must be in list [1 2 3 4 5]",
 		}
@@ -124,7 +268,7 @@ func (m *CreateReviewRequest) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
-		err := CreateReviewRequestValidationError{
+		err := ReviewInfoValidationError{
 			field:  "Content",
 			reason: "value length must be between 5 and 140 runes, inclusive",
 		}
@@ -138,22 +282,33 @@ func (m *CreateReviewRequest) validate(all bool) error {
 
 	// no validation rules for VideoInfo
 
+	// no validation rules for Status
+
 	// no validation rules for Anonymous
 
+	// no validation rules for Nickname
+
+	// no validation rules for Avatar
+
+	// no validation rules for Level
+
+	// no validation rules for ReviewID
+
+	// no validation rules for HelpfulCount
+
 	if len(errors) > 0 {
-		return CreateReviewRequestMultiError(errors)
+		return ReviewInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateReviewRequestMultiError []error
+// ReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewInfo.ValidateAll() if the designated constraints aren't met.
+type ReviewInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewRequestMultiError) Error() string {
+func (m ReviewInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -162,11 +317,11 @@ func (m CreateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+func (m ReviewInfoMultiError) AllErrors() []error { return m }
 
-// CreateReviewRequestValidationError is the validation error returned by
-// CreateReviewRequest.Validate if the designated constraints aren't met.
-type CreateReviewRequestValidationError struct {
+// ReviewInfoValidationError is the validation error returned by
+// ReviewInfo.Validate if the designated constraints aren't met.
+type ReviewInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -174,24 +329,22 @@ type CreateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewRequestValidationError) Field() string { return e.field }
+func (e ReviewInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+func (e ReviewInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+func (e ReviewInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+func (e ReviewInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewRequestValidationError) ErrorName() string {
-	return "CreateReviewRequestValidationError"
-}
+func (e ReviewInfoValidationError) ErrorName() string { return "ReviewInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewRequestValidationError) Error() string {
+func (e ReviewInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -203,14 +356,14 @@ func (e CreateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewRequest.%s: %s%s",
+		"invalid %sReviewInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewRequestValidationError{}
+var _ error = ReviewInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -218,9 +371,9 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewRequestValidationError{}
+} = ReviewInfoValidationError{}
 
-var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
+var _ReviewInfo_Score_InLookup = map[int32]struct{}{
 	1: {},
 	2: {},
 	3: {},
@@ -228,7 +381,7 @@ var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
 	5: {},
 }
 
-var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+var _ReviewInfo_ServiceScore_InLookup = map[int32]struct{}{
 	1: {},
 	2: {},
 	3: {},
@@ -236,7 +389,7 @@ var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
 	5: {},
 }
 
-var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+var _ReviewInfo_ExpressScore_InLookup = map[int32]struct{}{
 	1: {},
 	2: {},
 	3: {},
@@ -244,44 +397,80 @@ var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
 	5: {},
 }
 
-// Validate checks the field values on CreateReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewReply) Validate() error {
+// Validate checks the field values on ListReviewByStoreIDReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByStoreIDReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReviewByStoreIDReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReviewReplyMultiError, or nil if none found.
-func (m *CreateReviewReply) ValidateAll() error {
+// ListReviewByStoreIDReplyMultiError, or nil if none found.
+func (m *ListReviewByStoreIDReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewReply) validate(all bool) error {
+func (m *ListReviewByStoreIDReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReviewID
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByStoreIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByStoreIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByStoreIDReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Degraded
+
+	// no validation rules for NextCursor
 
 	if len(errors) > 0 {
-		return CreateReviewReplyMultiError(errors)
+		return ListReviewByStoreIDReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type CreateReviewReplyMultiError []error
+// ListReviewByStoreIDReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByStoreIDReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByStoreIDReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewReplyMultiError) Error() string {
+func (m ListReviewByStoreIDReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -290,11 +479,11 @@ func (m CreateReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewReplyMultiError) AllErrors() []error { return m }
+func (m ListReviewByStoreIDReplyMultiError) AllErrors() []error { return m }
 
-// CreateReviewReplyValidationError is the validation error returned by
-// CreateReviewReply.Validate if the designated constraints aren't met.
-type CreateReviewReplyValidationError struct {
+// ListReviewByStoreIDReplyValidationError is the validation error returned by
+// ListReviewByStoreIDReply.Validate if the designated constraints aren't met.
+type ListReviewByStoreIDReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -302,24 +491,24 @@ type CreateReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewReplyValidationError) Field() string { return e.field }
+func (e ListReviewByStoreIDReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReviewReplyValidationError) Reason() string { return e.reason }
+func (e ListReviewByStoreIDReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReviewReplyValidationError) Cause() error { return e.cause }
+func (e ListReviewByStoreIDReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewReplyValidationError) Key() bool { return e.key }
+func (e ListReviewByStoreIDReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewReplyValidationError) ErrorName() string {
-	return "CreateReviewReplyValidationError"
+func (e ListReviewByStoreIDReplyValidationError) ErrorName() string {
+	return "ListReviewByStoreIDReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewReplyValidationError) Error() string {
+func (e ListReviewByStoreIDReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -331,14 +520,14 @@ func (e CreateReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewReply.%s: %s%s",
+		"invalid %sListReviewByStoreIDReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewReplyValidationError{}
+var _ error = ListReviewByStoreIDReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -346,33 +535,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewReplyValidationError{}
+} = ListReviewByStoreIDReplyValidationError{}
 
-// Validate checks the field values on UpdateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListReviewByContentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReviewRequest) Validate() error {
+func (m *ListReviewByContentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReviewByContentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReviewRequestMultiError, or nil if none found.
-func (m *UpdateReviewRequest) ValidateAll() error {
+// ListReviewByContentRequestMultiError, or nil if none found.
+func (m *ListReviewByContentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReviewRequest) validate(all bool) error {
+func (m *ListReviewByContentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := UpdateReviewRequestValidationError{
-			field:  "ReviewID",
+	if m.GetPage() <= 0 {
+		err := ListReviewByContentRequestValidationError{
+			field:  "Page",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -381,9 +570,9 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetUserID() <= 0 {
-		err := UpdateReviewRequestValidationError{
-			field:  "UserID",
+	if m.GetSize() <= 0 {
+		err := ListReviewByContentRequestValidationError{
+			field:  "Size",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -392,21 +581,11753 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _UpdateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
-		err := UpdateReviewRequestValidationError{
-			field:  "Score",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListReviewByContentRequestMultiError(errors)
 	}
 
-	if _, ok := _UpdateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
-		err := UpdateReviewRequestValidationError{
-			field:  "ServiceScore",
-			reason: "value must be in list [1 2 3 4 5]",
+	return nil
+}
+
+// ListReviewByContentRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReviewByContentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReviewByContentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByContentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByContentRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewByContentRequestValidationError is the validation error returned
+// by ListReviewByContentRequest.Validate if the designated constraints aren't met.
+type ListReviewByContentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByContentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByContentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByContentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByContentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByContentRequestValidationError) ErrorName() string {
+	return "ListReviewByContentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByContentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByContentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByContentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByContentRequestValidationError{}
+
+// Validate checks the field values on ListReviewByContentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByContentReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewByContentReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewByContentReplyMultiError, or nil if none found.
+func (m *ListReviewByContentReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewByContentReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByContentReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByContentReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByContentReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Degraded
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListReviewByContentReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByContentReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByContentReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByContentReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByContentReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByContentReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewByContentReplyValidationError is the validation error returned by
+// ListReviewByContentReply.Validate if the designated constraints aren't met.
+type ListReviewByContentReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByContentReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByContentReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByContentReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByContentReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByContentReplyValidationError) ErrorName() string {
+	return "ListReviewByContentReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByContentReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByContentReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByContentReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByContentReplyValidationError{}
+
+// Validate checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewRequestMultiError, or nil if none found.
+func (m *CreateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
+		err := CreateReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 140 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Anonymous
+
+	if len(errors) > 0 {
+		return CreateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewRequestValidationError is the validation error returned by
+// CreateReviewRequest.Validate if the designated constraints aren't met.
+type CreateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewRequestValidationError) ErrorName() string {
+	return "CreateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewRequestValidationError{}
+
+var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on CreateReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewReplyMultiError, or nil if none found.
+func (m *CreateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	if len(errors) > 0 {
+		return CreateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewReplyMultiError) AllErrors() []error { return m }
+
+// CreateReviewReplyValidationError is the validation error returned by
+// CreateReviewReply.Validate if the designated constraints aren't met.
+type CreateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewReplyValidationError) ErrorName() string {
+	return "CreateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewReplyValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := UpdateReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := UpdateReviewRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 140 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+var _UpdateReviewRequest_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _UpdateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _UpdateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on UpdateReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewReplyMultiError, or nil if none found.
+func (m *UpdateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	if m.GetRowaffected() < 0 {
+		err := UpdateReviewReplyValidationError{
+			field:  "Rowaffected",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewReplyMultiError) AllErrors() []error { return m }
+
+// UpdateReviewReplyValidationError is the validation error returned by
+// UpdateReviewReply.Validate if the designated constraints aren't met.
+type UpdateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewReplyValidationError) ErrorName() string {
+	return "UpdateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewReplyValidationError{}
+
+// Validate checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewRequestMultiError, or nil if none found.
+func (m *DeleteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := DeleteReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := DeleteReviewRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReviewRequestValidationError is the validation error returned by
+// DeleteReviewRequest.Validate if the designated constraints aren't met.
+type DeleteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewRequestValidationError) ErrorName() string {
+	return "DeleteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewRequestValidationError{}
+
+// Validate checks the field values on DeleteReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReviewReplyMultiError, or nil if none found.
+func (m *DeleteReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRowaffected() < 0 {
+		err := DeleteReviewReplyValidationError{
+			field:  "Rowaffected",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReviewReplyMultiError) AllErrors() []error { return m }
+
+// DeleteReviewReplyValidationError is the validation error returned by
+// DeleteReviewReply.Validate if the designated constraints aren't met.
+type DeleteReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReviewReplyValidationError) ErrorName() string {
+	return "DeleteReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReviewReplyValidationError{}
+
+// Validate checks the field values on GetReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewRequestMultiError, or nil if none found.
+func (m *GetReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := GetReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewRequestValidationError is the validation error returned by
+// GetReviewRequest.Validate if the designated constraints aren't met.
+type GetReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewRequestValidationError{}
+
+// Validate checks the field values on GetReviewReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetReviewReplyMultiError,
+// or nil if none found.
+func (m *GetReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := GetReviewReplyValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderID() <= 0 {
+		err := GetReviewReplyValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetReviewReply_Score_InLookup[m.GetScore()]; !ok {
+		err := GetReviewReplyValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetReviewReply_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := GetReviewReplyValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetReviewReply_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := GetReviewReplyValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
+		err := GetReviewReplyValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 140 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for Anonymous
+
+	// no validation rules for Nickname
+
+	if len(errors) > 0 {
+		return GetReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by GetReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewReplyValidationError is the validation error returned by
+// GetReviewReply.Validate if the designated constraints aren't met.
+type GetReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewReplyValidationError) ErrorName() string { return "GetReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewReplyValidationError{}
+
+var _GetReviewReply_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _GetReviewReply_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _GetReviewReply_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on GetReviewDetailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewDetailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewDetailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewDetailRequestMultiError, or nil if none found.
+func (m *GetReviewDetailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewDetailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := GetReviewDetailRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() < 0 {
+		err := GetReviewDetailRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReviewDetailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewDetailRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReviewDetailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReviewDetailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewDetailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewDetailRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewDetailRequestValidationError is the validation error returned by
+// GetReviewDetailRequest.Validate if the designated constraints aren't met.
+type GetReviewDetailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewDetailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewDetailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewDetailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewDetailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewDetailRequestValidationError) ErrorName() string {
+	return "GetReviewDetailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewDetailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewDetailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewDetailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewDetailRequestValidationError{}
+
+// Validate checks the field values on ReviewDetail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewDetail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewDetailMultiError, or
+// nil if none found.
+func (m *ReviewDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for UserID
+
+	// no validation rules for Nickname
+
+	// no validation rules for Anonymous
+
+	// no validation rules for OrderID
+
+	// no validation rules for StoreID
+
+	// no validation rules for SkuID
+
+	// no validation rules for SpuID
+
+	// no validation rules for Score
+
+	// no validation rules for ServiceScore
+
+	// no validation rules for ExpressScore
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Tags
+
+	// no validation rules for Status
+
+	// no validation rules for HasReply
+
+	// no validation rules for HelpfulCount
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewDetailValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewDetailValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewDetailValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewDetailValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewDetailValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewDetailValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OpReason
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for OpUser
+
+	if len(errors) > 0 {
+		return ReviewDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewDetailMultiError is an error wrapping multiple validation errors
+// returned by ReviewDetail.ValidateAll() if the designated constraints aren't met.
+type ReviewDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewDetailMultiError) AllErrors() []error { return m }
+
+// ReviewDetailValidationError is the validation error returned by
+// ReviewDetail.Validate if the designated constraints aren't met.
+type ReviewDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewDetailValidationError) ErrorName() string { return "ReviewDetailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewDetailValidationError{}
+
+// Validate checks the field values on ReviewReplyDetail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewReplyDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewReplyDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewReplyDetailMultiError, or nil if none found.
+func (m *ReviewReplyDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewReplyDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReplyID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewReplyDetailValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewReplyDetailValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewReplyDetailValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewReplyDetailValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewReplyDetailValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewReplyDetailValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewReplyDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewReplyDetailMultiError is an error wrapping multiple validation errors
+// returned by ReviewReplyDetail.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewReplyDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewReplyDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewReplyDetailMultiError) AllErrors() []error { return m }
+
+// ReviewReplyDetailValidationError is the validation error returned by
+// ReviewReplyDetail.Validate if the designated constraints aren't met.
+type ReviewReplyDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewReplyDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewReplyDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewReplyDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewReplyDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewReplyDetailValidationError) ErrorName() string {
+	return "ReviewReplyDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewReplyDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewReplyDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewReplyDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewReplyDetailValidationError{}
+
+// Validate checks the field values on ReviewAppealDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewAppealDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewAppealDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewAppealDetailMultiError, or nil if none found.
+func (m *ReviewAppealDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewAppealDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	// no validation rules for Round
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for OpUser
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewAppealDetailValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewAppealDetailValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewAppealDetailValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewAppealDetailValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewAppealDetailValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewAppealDetailValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewAppealDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewAppealDetailMultiError is an error wrapping multiple validation errors
+// returned by ReviewAppealDetail.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewAppealDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewAppealDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewAppealDetailMultiError) AllErrors() []error { return m }
+
+// ReviewAppealDetailValidationError is the validation error returned by
+// ReviewAppealDetail.Validate if the designated constraints aren't met.
+type ReviewAppealDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewAppealDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewAppealDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewAppealDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewAppealDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewAppealDetailValidationError) ErrorName() string {
+	return "ReviewAppealDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewAppealDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewAppealDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewAppealDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewAppealDetailValidationError{}
+
+// Validate checks the field values on GetReviewDetailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewDetailReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewDetailReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewDetailReplyMultiError, or nil if none found.
+func (m *GetReviewDetailReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewDetailReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewDetailReplyValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewDetailReplyValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewDetailReplyValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReply()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewDetailReplyValidationError{
+					field:  "Reply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewDetailReplyValidationError{
+					field:  "Reply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReply()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewDetailReplyValidationError{
+				field:  "Reply",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAppeal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewDetailReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewDetailReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppeal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewDetailReplyValidationError{
+				field:  "Appeal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewDetailReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewDetailReplyMultiError is an error wrapping multiple validation
+// errors returned by GetReviewDetailReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetReviewDetailReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewDetailReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewDetailReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewDetailReplyValidationError is the validation error returned by
+// GetReviewDetailReply.Validate if the designated constraints aren't met.
+type GetReviewDetailReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewDetailReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewDetailReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewDetailReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewDetailReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewDetailReplyValidationError) ErrorName() string {
+	return "GetReviewDetailReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewDetailReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewDetailReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewDetailReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewDetailReplyValidationError{}
+
+// Validate checks the field values on GetAllReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllReviewsRequestMultiError, or nil if none found.
+func (m *GetAllReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := GetAllReviewsRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAllReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllReviewsRequestMultiError) AllErrors() []error { return m }
+
+// GetAllReviewsRequestValidationError is the validation error returned by
+// GetAllReviewsRequest.Validate if the designated constraints aren't met.
+type GetAllReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllReviewsRequestValidationError) ErrorName() string {
+	return "GetAllReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllReviewsRequestValidationError{}
+
+// Validate checks the field values on AllReviewsInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AllReviewsInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllReviewsInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AllReviewsInfoMultiError,
+// or nil if none found.
+func (m *AllReviewsInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllReviewsInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := AllReviewsInfoValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderID() <= 0 {
+		err := AllReviewsInfoValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReviewID() <= 0 {
+		err := AllReviewsInfoValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AllReviewsInfo_Score_InLookup[m.GetScore()]; !ok {
+		err := AllReviewsInfoValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AllReviewsInfo_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := AllReviewsInfoValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AllReviewsInfo_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := AllReviewsInfoValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
+		err := AllReviewsInfoValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 140 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AllReviewsInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllReviewsInfoMultiError is an error wrapping multiple validation errors
+// returned by AllReviewsInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AllReviewsInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllReviewsInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllReviewsInfoMultiError) AllErrors() []error { return m }
+
+// AllReviewsInfoValidationError is the validation error returned by
+// AllReviewsInfo.Validate if the designated constraints aren't met.
+type AllReviewsInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllReviewsInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllReviewsInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllReviewsInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllReviewsInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllReviewsInfoValidationError) ErrorName() string { return "AllReviewsInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AllReviewsInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllReviewsInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllReviewsInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllReviewsInfoValidationError{}
+
+var _AllReviewsInfo_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _AllReviewsInfo_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _AllReviewsInfo_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on GetAllReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllReviewsReplyMultiError, or nil if none found.
+func (m *GetAllReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllReviewsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllReviewsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllReviewsReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllReviewsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAllReviewsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAllReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllReviewsReplyMultiError) AllErrors() []error { return m }
+
+// GetAllReviewsReplyValidationError is the validation error returned by
+// GetAllReviewsReply.Validate if the designated constraints aren't met.
+type GetAllReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllReviewsReplyValidationError) ErrorName() string {
+	return "GetAllReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllReviewsReplyValidationError{}
+
+// Validate checks the field values on ListUserReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserReviewsRequestMultiError, or nil if none found.
+func (m *ListUserReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := ListUserReviewsRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListUserReviewsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListUserReviewsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [0 10 20 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListUserReviewsRequest_HasReply_InLookup[m.GetHasReply()]; !ok {
+		err := ListUserReviewsRequestValidationError{
+			field:  "HasReply",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := ListUserReviewsRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := ListUserReviewsRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListUserReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListUserReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListUserReviewsRequestValidationError is the validation error returned by
+// ListUserReviewsRequest.Validate if the designated constraints aren't met.
+type ListUserReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserReviewsRequestValidationError) ErrorName() string {
+	return "ListUserReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserReviewsRequestValidationError{}
+
+var _ListUserReviewsRequest_Status_InLookup = map[int32]struct{}{
+	0:  {},
+	10: {},
+	20: {},
+	30: {},
+}
+
+var _ListUserReviewsRequest_HasReply_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on UserReviewInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserReviewInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserReviewInfoMultiError,
+// or nil if none found.
+func (m *UserReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for OrderID
+
+	// no validation rules for StoreID
+
+	// no validation rules for SkuID
+
+	// no validation rules for SpuID
+
+	// no validation rules for Score
+
+	// no validation rules for ServiceScore
+
+	// no validation rules for ExpressScore
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for Anonymous
+
+	// no validation rules for HasReply
+
+	// no validation rules for HelpfulCount
+
+	// no validation rules for OpReason
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserReviewInfoValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserReviewInfoValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserReviewInfoValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by UserReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type UserReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserReviewInfoMultiError) AllErrors() []error { return m }
+
+// UserReviewInfoValidationError is the validation error returned by
+// UserReviewInfo.Validate if the designated constraints aren't met.
+type UserReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserReviewInfoValidationError) ErrorName() string { return "UserReviewInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserReviewInfoValidationError{}
+
+// Validate checks the field values on ListUserReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserReviewsReplyMultiError, or nil if none found.
+func (m *ListUserReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListUserReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListUserReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListUserReviewsReplyValidationError is the validation error returned by
+// ListUserReviewsReply.Validate if the designated constraints aren't met.
+type ListUserReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserReviewsReplyValidationError) ErrorName() string {
+	return "ListUserReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserReviewsReplyValidationError{}
+
+// Validate checks the field values on BatchGetReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetReviewsRequestMultiError, or nil if none found.
+func (m *BatchGetReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetReviewIDs()); l < 1 || l > 50 {
+		err := BatchGetReviewsRequestValidationError{
+			field:  "ReviewIDs",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetReviewIDs() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := BatchGetReviewsRequestValidationError{
+				field:  fmt.Sprintf("ReviewIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetReviewsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetReviewsRequestValidationError is the validation error returned by
+// BatchGetReviewsRequest.Validate if the designated constraints aren't met.
+type BatchGetReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetReviewsRequestValidationError) ErrorName() string {
+	return "BatchGetReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetReviewsRequestValidationError{}
+
+// Validate checks the field values on BatchReviewInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchReviewInfoMultiError, or nil if none found.
+func (m *BatchReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for OrderID
+
+	// no validation rules for StoreID
+
+	// no validation rules for UserID
+
+	// no validation rules for Nickname
+
+	// no validation rules for Score
+
+	// no validation rules for ServiceScore
+
+	// no validation rules for ExpressScore
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for Anonymous
+
+	// no validation rules for HasReply
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchReviewInfoValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchReviewInfoValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchReviewInfoValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by BatchReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type BatchReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchReviewInfoMultiError) AllErrors() []error { return m }
+
+// BatchReviewInfoValidationError is the validation error returned by
+// BatchReviewInfo.Validate if the designated constraints aren't met.
+type BatchReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchReviewInfoValidationError) ErrorName() string { return "BatchReviewInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchReviewInfoValidationError{}
+
+// Validate checks the field values on BatchGetReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetReviewsReplyMultiError, or nil if none found.
+func (m *BatchGetReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchGetReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetReviewsReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetReviewsReplyValidationError is the validation error returned by
+// BatchGetReviewsReply.Validate if the designated constraints aren't met.
+type BatchGetReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetReviewsReplyValidationError) ErrorName() string {
+	return "BatchGetReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetReviewsReplyValidationError{}
+
+// Validate checks the field values on GetReviewsByOrderIDsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewsByOrderIDsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewsByOrderIDsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewsByOrderIDsRequestMultiError, or nil if none found.
+func (m *GetReviewsByOrderIDsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewsByOrderIDsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetOrderIDs()); l < 1 || l > 50 {
+		err := GetReviewsByOrderIDsRequestValidationError{
+			field:  "OrderIDs",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrderIDs() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := GetReviewsByOrderIDsRequestValidationError{
+				field:  fmt.Sprintf("OrderIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReviewsByOrderIDsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewsByOrderIDsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetReviewsByOrderIDsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetReviewsByOrderIDsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewsByOrderIDsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewsByOrderIDsRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewsByOrderIDsRequestValidationError is the validation error returned
+// by GetReviewsByOrderIDsRequest.Validate if the designated constraints
+// aren't met.
+type GetReviewsByOrderIDsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewsByOrderIDsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewsByOrderIDsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewsByOrderIDsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewsByOrderIDsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewsByOrderIDsRequestValidationError) ErrorName() string {
+	return "GetReviewsByOrderIDsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewsByOrderIDsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewsByOrderIDsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewsByOrderIDsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewsByOrderIDsRequestValidationError{}
+
+// Validate checks the field values on GetReviewsByOrderIDsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewsByOrderIDsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewsByOrderIDsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewsByOrderIDsReplyMultiError, or nil if none found.
+func (m *GetReviewsByOrderIDsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewsByOrderIDsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReviewsByOrderIDsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReviewsByOrderIDsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReviewsByOrderIDsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReviewsByOrderIDsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewsByOrderIDsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetReviewsByOrderIDsReply.ValidateAll() if the
+// designated constraints aren't met.
+type GetReviewsByOrderIDsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewsByOrderIDsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewsByOrderIDsReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewsByOrderIDsReplyValidationError is the validation error returned by
+// GetReviewsByOrderIDsReply.Validate if the designated constraints aren't met.
+type GetReviewsByOrderIDsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewsByOrderIDsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewsByOrderIDsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewsByOrderIDsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewsByOrderIDsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewsByOrderIDsReplyValidationError) ErrorName() string {
+	return "GetReviewsByOrderIDsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewsByOrderIDsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewsByOrderIDsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewsByOrderIDsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewsByOrderIDsReplyValidationError{}
+
+// Validate checks the field values on ReplyReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewRequestMultiError, or nil if none found.
+func (m *ReplyReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := ReplyReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() <= 0 {
+		err := ReplyReviewRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := ReplyReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(errors) > 0 {
+		return ReplyReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by ReplyReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReplyReviewRequestValidationError is the validation error returned by
+// ReplyReviewRequest.Validate if the designated constraints aren't met.
+type ReplyReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyReviewRequestValidationError) ErrorName() string {
+	return "ReplyReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyReviewRequestValidationError{}
+
+// Validate checks the field values on ReplyReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewReplyMultiError, or nil if none found.
+func (m *ReplyReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReplyID
+
+	if len(errors) > 0 {
+		return ReplyReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by ReplyReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyReviewReplyMultiError) AllErrors() []error { return m }
+
+// ReplyReviewReplyValidationError is the validation error returned by
+// ReplyReviewReply.Validate if the designated constraints aren't met.
+type ReplyReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyReviewReplyValidationError) ErrorName() string { return "ReplyReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplyReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyReviewReplyValidationError{}
+
+// Validate checks the field values on ReplyReviewUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyReviewUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewUpdateRequestMultiError, or nil if none found.
+func (m *ReplyReviewUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyReviewUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := ReplyReviewUpdateRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() <= 0 {
+		err := ReplyReviewUpdateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReplyID() <= 0 {
+		err := ReplyReviewUpdateRequestValidationError{
+			field:  "ReplyID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := ReplyReviewUpdateRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(errors) > 0 {
+		return ReplyReviewUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyReviewUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplyReviewUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplyReviewUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyReviewUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyReviewUpdateRequestMultiError) AllErrors() []error { return m }
+
+// ReplyReviewUpdateRequestValidationError is the validation error returned by
+// ReplyReviewUpdateRequest.Validate if the designated constraints aren't met.
+type ReplyReviewUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyReviewUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyReviewUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyReviewUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyReviewUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyReviewUpdateRequestValidationError) ErrorName() string {
+	return "ReplyReviewUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyReviewUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyReviewUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyReviewUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyReviewUpdateRequestValidationError{}
+
+// Validate checks the field values on ReplyReviewUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyReviewUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewUpdateReplyMultiError, or nil if none found.
+func (m *ReplyReviewUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyReviewUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReplyID
+
+	// no validation rules for Rowsaffected
+
+	if len(errors) > 0 {
+		return ReplyReviewUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyReviewUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by ReplyReviewUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type ReplyReviewUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyReviewUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyReviewUpdateReplyMultiError) AllErrors() []error { return m }
+
+// ReplyReviewUpdateReplyValidationError is the validation error returned by
+// ReplyReviewUpdateReply.Validate if the designated constraints aren't met.
+type ReplyReviewUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyReviewUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyReviewUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyReviewUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyReviewUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyReviewUpdateReplyValidationError) ErrorName() string {
+	return "ReplyReviewUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyReviewUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyReviewUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyReviewUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyReviewUpdateReplyValidationError{}
+
+// Validate checks the field values on AppealReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AppealReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealReviewRequestMultiError, or nil if none found.
+func (m *AppealReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := AppealReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() <= 0 {
+		err := AppealReviewRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 5 || l > 200 {
+		err := AppealReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := AppealReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(errors) > 0 {
+		return AppealReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AppealReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AppealReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealReviewRequestMultiError) AllErrors() []error { return m }
+
+// AppealReviewRequestValidationError is the validation error returned by
+// AppealReviewRequest.Validate if the designated constraints aren't met.
+type AppealReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealReviewRequestValidationError) ErrorName() string {
+	return "AppealReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealReviewRequestValidationError{}
+
+// Validate checks the field values on AppealReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppealReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealReviewReplyMultiError, or nil if none found.
+func (m *AppealReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	if len(errors) > 0 {
+		return AppealReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AppealReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type AppealReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealReviewReplyMultiError) AllErrors() []error { return m }
+
+// AppealReviewReplyValidationError is the validation error returned by
+// AppealReviewReply.Validate if the designated constraints aren't met.
+type AppealReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealReviewReplyValidationError) ErrorName() string {
+	return "AppealReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealReviewReplyValidationError{}
+
+// Validate checks the field values on AuditReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditReviewRequestMultiError, or nil if none found.
+func (m *AuditReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := AuditReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AuditReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [10 20 30 40]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := AuditReviewRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := AuditReviewRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpRemarks
+
+	if len(errors) > 0 {
+		return AuditReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuditReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditReviewRequestMultiError) AllErrors() []error { return m }
+
+// AuditReviewRequestValidationError is the validation error returned by
+// AuditReviewRequest.Validate if the designated constraints aren't met.
+type AuditReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditReviewRequestValidationError) ErrorName() string {
+	return "AuditReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditReviewRequestValidationError{}
+
+var _AuditReviewRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on AuditReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditReviewReplyMultiError, or nil if none found.
+func (m *AuditReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuditReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuditReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditReviewReplyMultiError) AllErrors() []error { return m }
+
+// AuditReviewReplyValidationError is the validation error returned by
+// AuditReviewReply.Validate if the designated constraints aren't met.
+type AuditReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditReviewReplyValidationError) ErrorName() string { return "AuditReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditReviewReplyValidationError{}
+
+// Validate checks the field values on AuditAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditAppealRequestMultiError, or nil if none found.
+func (m *AuditAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppealID() <= 0 {
+		err := AuditAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AuditAppealRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditAppealRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [10 20 30 40]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := AuditAppealRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := AuditAppealRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpRemarks
+
+	if len(errors) > 0 {
+		return AuditAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditAppealRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditAppealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuditAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditAppealRequestMultiError) AllErrors() []error { return m }
+
+// AuditAppealRequestValidationError is the validation error returned by
+// AuditAppealRequest.Validate if the designated constraints aren't met.
+type AuditAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditAppealRequestValidationError) ErrorName() string {
+	return "AuditAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditAppealRequestValidationError{}
+
+var _AuditAppealRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on AuditAppealReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditAppealReplyMultiError, or nil if none found.
+func (m *AuditAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuditAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditAppealReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditAppealReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuditAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditAppealReplyMultiError) AllErrors() []error { return m }
+
+// AuditAppealReplyValidationError is the validation error returned by
+// AuditAppealReply.Validate if the designated constraints aren't met.
+type AuditAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditAppealReplyValidationError) ErrorName() string { return "AuditAppealReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditAppealReplyValidationError{}
+
+// Validate checks the field values on BatchAuditReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchAuditReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAuditReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAuditReviewsRequestMultiError, or nil if none found.
+func (m *BatchAuditReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAuditReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetReviewIDs()); l < 1 || l > 100 {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "ReviewIDs",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchAuditReviewsRequest_ReviewIDs_Unique := make(map[int64]struct{}, len(m.GetReviewIDs()))
+
+	for idx, item := range m.GetReviewIDs() {
+		_, _ = idx, item
+
+		if _, exists := _BatchAuditReviewsRequest_ReviewIDs_Unique[item]; exists {
+			err := BatchAuditReviewsRequestValidationError{
+				field:  fmt.Sprintf("ReviewIDs[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchAuditReviewsRequest_ReviewIDs_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchAuditReviewsRequestValidationError{
+				field:  fmt.Sprintf("ReviewIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _BatchAuditReviewsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [10 20 30 40]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := BatchAuditReviewsRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpRemarks
+
+	if len(errors) > 0 {
+		return BatchAuditReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAuditReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchAuditReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAuditReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAuditReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAuditReviewsRequestMultiError) AllErrors() []error { return m }
+
+// BatchAuditReviewsRequestValidationError is the validation error returned by
+// BatchAuditReviewsRequest.Validate if the designated constraints aren't met.
+type BatchAuditReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAuditReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAuditReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAuditReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAuditReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAuditReviewsRequestValidationError) ErrorName() string {
+	return "BatchAuditReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchAuditReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAuditReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAuditReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAuditReviewsRequestValidationError{}
+
+var _BatchAuditReviewsRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on BatchAuditAppealsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchAuditAppealsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAuditAppealsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAuditAppealsRequestMultiError, or nil if none found.
+func (m *BatchAuditAppealsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAuditAppealsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetAppealIDs()); l < 1 || l > 100 {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "AppealIDs",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchAuditAppealsRequest_AppealIDs_Unique := make(map[int64]struct{}, len(m.GetAppealIDs()))
+
+	for idx, item := range m.GetAppealIDs() {
+		_, _ = idx, item
+
+		if _, exists := _BatchAuditAppealsRequest_AppealIDs_Unique[item]; exists {
+			err := BatchAuditAppealsRequestValidationError{
+				field:  fmt.Sprintf("AppealIDs[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchAuditAppealsRequest_AppealIDs_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchAuditAppealsRequestValidationError{
+				field:  fmt.Sprintf("AppealIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _BatchAuditAppealsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [10 20 30 40]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := BatchAuditAppealsRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpRemarks
+
+	if len(errors) > 0 {
+		return BatchAuditAppealsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAuditAppealsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchAuditAppealsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAuditAppealsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAuditAppealsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAuditAppealsRequestMultiError) AllErrors() []error { return m }
+
+// BatchAuditAppealsRequestValidationError is the validation error returned by
+// BatchAuditAppealsRequest.Validate if the designated constraints aren't met.
+type BatchAuditAppealsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAuditAppealsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAuditAppealsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAuditAppealsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAuditAppealsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAuditAppealsRequestValidationError) ErrorName() string {
+	return "BatchAuditAppealsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchAuditAppealsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAuditAppealsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAuditAppealsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAuditAppealsRequestValidationError{}
+
+var _BatchAuditAppealsRequest_Status_InLookup = map[int32]struct{}{
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+}
+
+// Validate checks the field values on AuditResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditResultMultiError, or
+// nil if none found.
+func (m *AuditResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Success
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return AuditResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditResultMultiError is an error wrapping multiple validation errors
+// returned by AuditResult.ValidateAll() if the designated constraints aren't met.
+type AuditResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditResultMultiError) AllErrors() []error { return m }
+
+// AuditResultValidationError is the validation error returned by
+// AuditResult.Validate if the designated constraints aren't met.
+type AuditResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditResultValidationError) ErrorName() string { return "AuditResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditResultValidationError{}
+
+// Validate checks the field values on BatchAuditReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchAuditReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchAuditReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchAuditReplyMultiError, or nil if none found.
+func (m *BatchAuditReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchAuditReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchAuditReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchAuditReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchAuditReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return BatchAuditReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchAuditReplyMultiError is an error wrapping multiple validation errors
+// returned by BatchAuditReply.ValidateAll() if the designated constraints
+// aren't met.
+type BatchAuditReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchAuditReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchAuditReplyMultiError) AllErrors() []error { return m }
+
+// BatchAuditReplyValidationError is the validation error returned by
+// BatchAuditReply.Validate if the designated constraints aren't met.
+type BatchAuditReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchAuditReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchAuditReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchAuditReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchAuditReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchAuditReplyValidationError) ErrorName() string { return "BatchAuditReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchAuditReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchAuditReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchAuditReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchAuditReplyValidationError{}
+
+// Validate checks the field values on VoteReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VoteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoteReviewRequestMultiError, or nil if none found.
+func (m *VoteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := VoteReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := VoteReviewRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VoteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoteReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by VoteReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VoteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoteReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoteReviewRequestMultiError) AllErrors() []error { return m }
+
+// VoteReviewRequestValidationError is the validation error returned by
+// VoteReviewRequest.Validate if the designated constraints aren't met.
+type VoteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoteReviewRequestValidationError) ErrorName() string {
+	return "VoteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VoteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoteReviewRequestValidationError{}
+
+// Validate checks the field values on VoteReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VoteReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoteReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoteReviewReplyMultiError, or nil if none found.
+func (m *VoteReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoteReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VoteReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoteReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by VoteReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type VoteReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoteReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoteReviewReplyMultiError) AllErrors() []error { return m }
+
+// VoteReviewReplyValidationError is the validation error returned by
+// VoteReviewReply.Validate if the designated constraints aren't met.
+type VoteReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoteReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoteReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoteReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoteReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoteReviewReplyValidationError) ErrorName() string { return "VoteReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VoteReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoteReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoteReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoteReviewReplyValidationError{}
+
+// Validate checks the field values on UnvoteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnvoteReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnvoteReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnvoteReviewRequestMultiError, or nil if none found.
+func (m *UnvoteReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnvoteReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := UnvoteReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := UnvoteReviewRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnvoteReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnvoteReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UnvoteReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnvoteReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnvoteReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnvoteReviewRequestMultiError) AllErrors() []error { return m }
+
+// UnvoteReviewRequestValidationError is the validation error returned by
+// UnvoteReviewRequest.Validate if the designated constraints aren't met.
+type UnvoteReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnvoteReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnvoteReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnvoteReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnvoteReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnvoteReviewRequestValidationError) ErrorName() string {
+	return "UnvoteReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnvoteReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnvoteReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnvoteReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnvoteReviewRequestValidationError{}
+
+// Validate checks the field values on UnvoteReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnvoteReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnvoteReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnvoteReviewReplyMultiError, or nil if none found.
+func (m *UnvoteReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnvoteReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnvoteReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnvoteReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by UnvoteReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnvoteReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnvoteReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnvoteReviewReplyMultiError) AllErrors() []error { return m }
+
+// UnvoteReviewReplyValidationError is the validation error returned by
+// UnvoteReviewReply.Validate if the designated constraints aren't met.
+type UnvoteReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnvoteReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnvoteReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnvoteReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnvoteReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnvoteReviewReplyValidationError) ErrorName() string {
+	return "UnvoteReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnvoteReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnvoteReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnvoteReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnvoteReviewReplyValidationError{}
+
+// Validate checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReviewRequestMultiError, or nil if none found.
+func (m *ReportReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := ReportReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserID() <= 0 {
+		err := ReportReviewRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReportReviewRequest_Reason_InLookup[m.GetReason()]; !ok {
+		err := ReportReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) > 200 {
+		err := ReportReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReportReviewRequestValidationError is the validation error returned by
+// ReportReviewRequest.Validate if the designated constraints aren't met.
+type ReportReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewRequestValidationError) ErrorName() string {
+	return "ReportReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewRequestValidationError{}
+
+var _ReportReviewRequest_Reason_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on ReportReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReviewReplyMultiError, or nil if none found.
+func (m *ReportReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReportID
+
+	if len(errors) > 0 {
+		return ReportReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by ReportReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReportReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewReplyMultiError) AllErrors() []error { return m }
+
+// ReportReviewReplyValidationError is the validation error returned by
+// ReportReviewReply.Validate if the designated constraints aren't met.
+type ReportReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewReplyValidationError) ErrorName() string {
+	return "ReportReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewReplyValidationError{}
+
+// Validate checks the field values on ListReportedReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportedReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportedReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportedReviewsRequestMultiError, or nil if none found.
+func (m *ListReportedReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportedReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() <= 0 {
+		err := ListReportedReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListReportedReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReportedReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportedReviewsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReportedReviewsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReportedReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportedReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportedReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportedReviewsRequestValidationError is the validation error returned
+// by ListReportedReviewsRequest.Validate if the designated constraints aren't met.
+type ListReportedReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportedReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportedReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportedReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportedReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportedReviewsRequestValidationError) ErrorName() string {
+	return "ListReportedReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportedReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportedReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportedReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportedReviewsRequestValidationError{}
+
+// Validate checks the field values on ReportReasonCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportReasonCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReasonCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReasonCountMultiError, or nil if none found.
+func (m *ReportReasonCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReasonCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReportReasonCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReasonCountMultiError is an error wrapping multiple validation errors
+// returned by ReportReasonCount.ValidateAll() if the designated constraints
+// aren't met.
+type ReportReasonCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReasonCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReasonCountMultiError) AllErrors() []error { return m }
+
+// ReportReasonCountValidationError is the validation error returned by
+// ReportReasonCount.Validate if the designated constraints aren't met.
+type ReportReasonCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReasonCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReasonCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReasonCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReasonCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReasonCountValidationError) ErrorName() string {
+	return "ReportReasonCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReasonCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReasonCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReasonCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReasonCountValidationError{}
+
+// Validate checks the field values on ReportedReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportedReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportedReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportedReviewInfoMultiError, or nil if none found.
+func (m *ReportedReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportedReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for UserID
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for ReportCount
+
+	for idx, item := range m.GetReasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportedReviewInfoValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportedReviewInfoValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportedReviewInfoValidationError{
+					field:  fmt.Sprintf("Reasons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReportedReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportedReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by ReportedReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReportedReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportedReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportedReviewInfoMultiError) AllErrors() []error { return m }
+
+// ReportedReviewInfoValidationError is the validation error returned by
+// ReportedReviewInfo.Validate if the designated constraints aren't met.
+type ReportedReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportedReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportedReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportedReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportedReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportedReviewInfoValidationError) ErrorName() string {
+	return "ReportedReviewInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportedReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportedReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportedReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportedReviewInfoValidationError{}
+
+// Validate checks the field values on ListReportedReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportedReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportedReviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportedReviewsReplyMultiError, or nil if none found.
+func (m *ListReportedReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportedReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportedReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportedReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportedReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportedReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportedReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReportedReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportedReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportedReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportedReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListReportedReviewsReplyValidationError is the validation error returned by
+// ListReportedReviewsReply.Validate if the designated constraints aren't met.
+type ListReportedReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportedReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportedReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportedReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportedReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportedReviewsReplyValidationError) ErrorName() string {
+	return "ListReportedReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportedReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportedReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportedReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportedReviewsReplyValidationError{}
+
+// Validate checks the field values on ListPendingReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingReviewsRequestMultiError, or nil if none found.
+func (m *ListPendingReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListPendingReviewsRequest_HasMedia_InLookup[m.GetHasMedia()]; !ok {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "HasMedia",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinReportCount() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "MinReportCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedBefore() < 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "CreatedBefore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListPendingReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPendingReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPendingReviewsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPendingReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingReviewsRequestValidationError is the validation error returned by
+// ListPendingReviewsRequest.Validate if the designated constraints aren't met.
+type ListPendingReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingReviewsRequestValidationError) ErrorName() string {
+	return "ListPendingReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingReviewsRequestValidationError{}
+
+var _ListPendingReviewsRequest_HasMedia_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on PendingReviewInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingReviewInfoMultiError, or nil if none found.
+func (m *PendingReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for UserID
+
+	// no validation rules for OrderID
+
+	// no validation rules for Score
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for HasMedia
+
+	// no validation rules for ReportCount
+
+	// no validation rules for Anonymous
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return PendingReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by PendingReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type PendingReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingReviewInfoMultiError) AllErrors() []error { return m }
+
+// PendingReviewInfoValidationError is the validation error returned by
+// PendingReviewInfo.Validate if the designated constraints aren't met.
+type PendingReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingReviewInfoValidationError) ErrorName() string {
+	return "PendingReviewInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingReviewInfoValidationError{}
+
+// Validate checks the field values on ListPendingReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingReviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingReviewsReplyMultiError, or nil if none found.
+func (m *ListPendingReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for OldestCreateAt
+
+	if len(errors) > 0 {
+		return ListPendingReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPendingReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListPendingReviewsReplyValidationError is the validation error returned by
+// ListPendingReviewsReply.Validate if the designated constraints aren't met.
+type ListPendingReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingReviewsReplyValidationError) ErrorName() string {
+	return "ListPendingReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingReviewsReplyValidationError{}
+
+// Validate checks the field values on ListPendingAppealsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingAppealsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingAppealsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingAppealsRequestMultiError, or nil if none found.
+func (m *ListPendingAppealsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingAppealsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() < 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedBefore() < 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "CreatedBefore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListPendingAppealsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPendingAppealsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingAppealsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPendingAppealsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPendingAppealsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingAppealsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingAppealsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingAppealsRequestValidationError is the validation error returned by
+// ListPendingAppealsRequest.Validate if the designated constraints aren't met.
+type ListPendingAppealsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingAppealsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingAppealsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingAppealsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingAppealsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingAppealsRequestValidationError) ErrorName() string {
+	return "ListPendingAppealsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingAppealsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingAppealsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingAppealsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingAppealsRequestValidationError{}
+
+// Validate checks the field values on PendingAppealInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingAppealInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingAppealInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingAppealInfoMultiError, or nil if none found.
+func (m *PendingAppealInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingAppealInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return PendingAppealInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingAppealInfoMultiError is an error wrapping multiple validation errors
+// returned by PendingAppealInfo.ValidateAll() if the designated constraints
+// aren't met.
+type PendingAppealInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingAppealInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingAppealInfoMultiError) AllErrors() []error { return m }
+
+// PendingAppealInfoValidationError is the validation error returned by
+// PendingAppealInfo.Validate if the designated constraints aren't met.
+type PendingAppealInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingAppealInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingAppealInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingAppealInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingAppealInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingAppealInfoValidationError) ErrorName() string {
+	return "PendingAppealInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingAppealInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingAppealInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingAppealInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingAppealInfoValidationError{}
+
+// Validate checks the field values on ListPendingAppealsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingAppealsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingAppealsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingAppealsReplyMultiError, or nil if none found.
+func (m *ListPendingAppealsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingAppealsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingAppealsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for OldestCreateAt
+
+	if len(errors) > 0 {
+		return ListPendingAppealsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingAppealsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPendingAppealsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPendingAppealsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingAppealsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingAppealsReplyMultiError) AllErrors() []error { return m }
+
+// ListPendingAppealsReplyValidationError is the validation error returned by
+// ListPendingAppealsReply.Validate if the designated constraints aren't met.
+type ListPendingAppealsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingAppealsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingAppealsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingAppealsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingAppealsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingAppealsReplyValidationError) ErrorName() string {
+	return "ListPendingAppealsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingAppealsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingAppealsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingAppealsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingAppealsReplyValidationError{}
+
+// Validate checks the field values on ListStoreReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreReviewsRequestMultiError, or nil if none found.
+func (m *ListStoreReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Unreplied
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListStoreReviewsRequest_HasMedia_InLookup[m.GetHasMedia()]; !ok {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "HasMedia",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListStoreReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStoreReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStoreReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListStoreReviewsRequestValidationError is the validation error returned by
+// ListStoreReviewsRequest.Validate if the designated constraints aren't met.
+type ListStoreReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreReviewsRequestValidationError) ErrorName() string {
+	return "ListStoreReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreReviewsRequestValidationError{}
+
+var _ListStoreReviewsRequest_HasMedia_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on StoreReviewInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StoreReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreReviewInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoreReviewInfoMultiError, or nil if none found.
+func (m *StoreReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for UserID
+
+	// no validation rules for Nickname
+
+	// no validation rules for OrderID
+
+	// no validation rules for Score
+
+	// no validation rules for ServiceScore
+
+	// no validation rules for ExpressScore
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for Anonymous
+
+	// no validation rules for HasReply
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return StoreReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by StoreReviewInfo.ValidateAll() if the designated constraints
+// aren't met.
+type StoreReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreReviewInfoMultiError) AllErrors() []error { return m }
+
+// StoreReviewInfoValidationError is the validation error returned by
+// StoreReviewInfo.Validate if the designated constraints aren't met.
+type StoreReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreReviewInfoValidationError) ErrorName() string { return "StoreReviewInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StoreReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreReviewInfoValidationError{}
+
+// Validate checks the field values on ListStoreReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreReviewsReplyMultiError, or nil if none found.
+func (m *ListStoreReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStoreReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStoreReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoreReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListStoreReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListStoreReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreReviewsReplyMultiError) AllErrors() []error { return m }
+
+// ListStoreReviewsReplyValidationError is the validation error returned by
+// ListStoreReviewsReply.Validate if the designated constraints aren't met.
+type ListStoreReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreReviewsReplyValidationError) ErrorName() string {
+	return "ListStoreReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreReviewsReplyValidationError{}
+
+// Validate checks the field values on CreateReviewExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewExportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewExportRequestMultiError, or nil if none found.
+func (m *CreateReviewExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewExportRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := CreateReviewExportRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv xlsx]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Unreplied
+
+	if val := m.GetMaxScore(); val < 0 || val > 5 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [0, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewExportRequest_HasMedia_InLookup[m.GetHasMedia()]; !ok {
+		err := CreateReviewExportRequestValidationError{
+			field:  "HasMedia",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := CreateReviewExportRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateReviewExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewExportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewExportRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateReviewExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewExportRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewExportRequestValidationError is the validation error returned by
+// CreateReviewExportRequest.Validate if the designated constraints aren't met.
+type CreateReviewExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewExportRequestValidationError) ErrorName() string {
+	return "CreateReviewExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewExportRequestValidationError{}
+
+var _CreateReviewExportRequest_Format_InLookup = map[string]struct{}{
+	"csv":  {},
+	"xlsx": {},
+}
+
+var _CreateReviewExportRequest_HasMedia_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on CreateReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewExportReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewExportReplyMultiError, or nil if none found.
+func (m *CreateReviewExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExportID
+
+	if len(errors) > 0 {
+		return CreateReviewExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewExportReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewExportReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewExportReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewExportReplyMultiError) AllErrors() []error { return m }
+
+// CreateReviewExportReplyValidationError is the validation error returned by
+// CreateReviewExportReply.Validate if the designated constraints aren't met.
+type CreateReviewExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewExportReplyValidationError) ErrorName() string {
+	return "CreateReviewExportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewExportReplyValidationError{}
+
+// Validate checks the field values on ReviewExportInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewExportInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewExportInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewExportInfoMultiError, or nil if none found.
+func (m *ReviewExportInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewExportInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExportID
+
+	// no validation rules for Format
+
+	// no validation rules for Status
+
+	// no validation rules for RowCount
+
+	// no validation rules for FileSize
+
+	// no validation rules for FileName
+
+	// no validation rules for Error
+
+	// no validation rules for DownloadURL
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewExportInfoValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewExportInfoValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewExportInfoValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewExportInfoValidationError{
+					field:  "FinishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewExportInfoValidationError{
+					field:  "FinishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewExportInfoValidationError{
+				field:  "FinishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpireAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewExportInfoValidationError{
+					field:  "ExpireAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewExportInfoValidationError{
+					field:  "ExpireAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpireAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewExportInfoValidationError{
+				field:  "ExpireAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewExportInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewExportInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewExportInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReviewExportInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewExportInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewExportInfoMultiError) AllErrors() []error { return m }
+
+// ReviewExportInfoValidationError is the validation error returned by
+// ReviewExportInfo.Validate if the designated constraints aren't met.
+type ReviewExportInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewExportInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewExportInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewExportInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewExportInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewExportInfoValidationError) ErrorName() string { return "ReviewExportInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewExportInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewExportInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewExportInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewExportInfoValidationError{}
+
+// Validate checks the field values on GetReviewExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewExportRequestMultiError, or nil if none found.
+func (m *GetReviewExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := GetReviewExportRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExportID() <= 0 {
+		err := GetReviewExportRequestValidationError{
+			field:  "ExportID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReviewExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewExportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReviewExportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReviewExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewExportRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewExportRequestValidationError is the validation error returned by
+// GetReviewExportRequest.Validate if the designated constraints aren't met.
+type GetReviewExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewExportRequestValidationError) ErrorName() string {
+	return "GetReviewExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewExportRequestValidationError{}
+
+// Validate checks the field values on GetReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewExportReplyMultiError, or nil if none found.
+func (m *GetReviewExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewExportReplyValidationError{
+				field:  "Export",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewExportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetReviewExportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetReviewExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewExportReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewExportReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewExportReplyValidationError is the validation error returned by
+// GetReviewExportReply.Validate if the designated constraints aren't met.
+type GetReviewExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewExportReplyValidationError) ErrorName() string {
+	return "GetReviewExportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReviewExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewExportReplyValidationError{}
+
+// Validate checks the field values on ListReviewExportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewExportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewExportsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewExportsRequestMultiError, or nil if none found.
+func (m *ListReviewExportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewExportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListReviewExportsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListReviewExportsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListReviewExportsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReviewExportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewExportsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReviewExportsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewExportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewExportsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewExportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewExportsRequestValidationError is the validation error returned by
+// ListReviewExportsRequest.Validate if the designated constraints aren't met.
+type ListReviewExportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewExportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewExportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewExportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewExportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewExportsRequestValidationError) ErrorName() string {
+	return "ListReviewExportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewExportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewExportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewExportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewExportsRequestValidationError{}
+
+// Validate checks the field values on ListReviewExportsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewExportsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewExportsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewExportsReplyMultiError, or nil if none found.
+func (m *ListReviewExportsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewExportsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewExportsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewExportsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewExportsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListReviewExportsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewExportsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewExportsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewExportsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewExportsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewExportsReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewExportsReplyValidationError is the validation error returned by
+// ListReviewExportsReply.Validate if the designated constraints aren't met.
+type ListReviewExportsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewExportsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewExportsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewExportsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewExportsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewExportsReplyValidationError) ErrorName() string {
+	return "ListReviewExportsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewExportsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewExportsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewExportsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewExportsReplyValidationError{}
+
+// Validate checks the field values on DownloadReviewExportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadReviewExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadReviewExportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadReviewExportRequestMultiError, or nil if none found.
+func (m *DownloadReviewExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadReviewExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DownloadReviewExportRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExportID() <= 0 {
+		err := DownloadReviewExportRequestValidationError{
+			field:  "ExportID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadReviewExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadReviewExportRequestMultiError is an error wrapping multiple
+// validation errors returned by DownloadReviewExportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadReviewExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadReviewExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadReviewExportRequestMultiError) AllErrors() []error { return m }
+
+// DownloadReviewExportRequestValidationError is the validation error returned
+// by DownloadReviewExportRequest.Validate if the designated constraints
+// aren't met.
+type DownloadReviewExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadReviewExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadReviewExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadReviewExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadReviewExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadReviewExportRequestValidationError) ErrorName() string {
+	return "DownloadReviewExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadReviewExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadReviewExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadReviewExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadReviewExportRequestValidationError{}
+
+// Validate checks the field values on DownloadReviewExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadReviewExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadReviewExportReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadReviewExportReplyMultiError, or nil if none found.
+func (m *DownloadReviewExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadReviewExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for FileSize
+
+	// no validation rules for Chunk
+
+	if len(errors) > 0 {
+		return DownloadReviewExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadReviewExportReplyMultiError is an error wrapping multiple validation
+// errors returned by DownloadReviewExportReply.ValidateAll() if the
+// designated constraints aren't met.
+type DownloadReviewExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadReviewExportReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadReviewExportReplyMultiError) AllErrors() []error { return m }
+
+// DownloadReviewExportReplyValidationError is the validation error returned by
+// DownloadReviewExportReply.Validate if the designated constraints aren't met.
+type DownloadReviewExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadReviewExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadReviewExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadReviewExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadReviewExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadReviewExportReplyValidationError) ErrorName() string {
+	return "DownloadReviewExportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadReviewExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadReviewExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadReviewExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadReviewExportReplyValidationError{}
+
+// Validate checks the field values on ListStoreAppealsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreAppealsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreAppealsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreAppealsRequestMultiError, or nil if none found.
+func (m *ListStoreAppealsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreAppealsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListStoreAppealsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [0 10 20 30 40 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListStoreAppealsRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStoreAppealsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreAppealsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStoreAppealsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreAppealsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreAppealsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreAppealsRequestMultiError) AllErrors() []error { return m }
+
+// ListStoreAppealsRequestValidationError is the validation error returned by
+// ListStoreAppealsRequest.Validate if the designated constraints aren't met.
+type ListStoreAppealsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreAppealsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreAppealsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreAppealsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreAppealsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreAppealsRequestValidationError) ErrorName() string {
+	return "ListStoreAppealsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreAppealsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreAppealsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreAppealsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreAppealsRequestValidationError{}
+
+var _ListStoreAppealsRequest_Status_InLookup = map[int32]struct{}{
+	0:  {},
+	10: {},
+	20: {},
+	30: {},
+	40: {},
+	50: {},
+}
+
+// Validate checks the field values on AppealInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppealInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppealInfoMultiError, or
+// nil if none found.
+func (m *AppealInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppealID
+
+	// no validation rules for ReviewID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for OpRemarks
+
+	// no validation rules for CreateAt
+
+	// no validation rules for UpdateAt
+
+	// no validation rules for Round
+
+	if len(errors) > 0 {
+		return AppealInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealInfoMultiError is an error wrapping multiple validation errors
+// returned by AppealInfo.ValidateAll() if the designated constraints aren't met.
+type AppealInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealInfoMultiError) AllErrors() []error { return m }
+
+// AppealInfoValidationError is the validation error returned by
+// AppealInfo.Validate if the designated constraints aren't met.
+type AppealInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealInfoValidationError) ErrorName() string { return "AppealInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppealInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealInfoValidationError{}
+
+// Validate checks the field values on ListStoreAppealsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStoreAppealsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStoreAppealsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStoreAppealsReplyMultiError, or nil if none found.
+func (m *ListStoreAppealsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStoreAppealsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStoreAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStoreAppealsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStoreAppealsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListStoreAppealsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStoreAppealsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListStoreAppealsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListStoreAppealsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStoreAppealsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStoreAppealsReplyMultiError) AllErrors() []error { return m }
+
+// ListStoreAppealsReplyValidationError is the validation error returned by
+// ListStoreAppealsReply.Validate if the designated constraints aren't met.
+type ListStoreAppealsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStoreAppealsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStoreAppealsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStoreAppealsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStoreAppealsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStoreAppealsReplyValidationError) ErrorName() string {
+	return "ListStoreAppealsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStoreAppealsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStoreAppealsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStoreAppealsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStoreAppealsReplyValidationError{}
+
+// Validate checks the field values on GetAppealRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealRequestMultiError, or nil if none found.
+func (m *GetAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := GetAppealRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAppealID() <= 0 {
+		err := GetAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAppealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealRequestMultiError) AllErrors() []error { return m }
+
+// GetAppealRequestValidationError is the validation error returned by
+// GetAppealRequest.Validate if the designated constraints aren't met.
+type GetAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealRequestValidationError) ErrorName() string { return "GetAppealRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealRequestValidationError{}
+
+// Validate checks the field values on GetAppealReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetAppealReplyMultiError,
+// or nil if none found.
+func (m *GetAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAppeal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAppealReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAppealReplyValidationError{
+					field:  "Appeal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppeal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAppealReplyValidationError{
+				field:  "Appeal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAppealReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealReplyMultiError) AllErrors() []error { return m }
+
+// GetAppealReplyValidationError is the validation error returned by
+// GetAppealReply.Validate if the designated constraints aren't met.
+type GetAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealReplyValidationError) ErrorName() string { return "GetAppealReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealReplyValidationError{}
+
+// Validate checks the field values on SupplementAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SupplementAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SupplementAppealRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SupplementAppealRequestMultiError, or nil if none found.
+func (m *SupplementAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupplementAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := SupplementAppealRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAppealID() <= 0 {
+		err := SupplementAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := SupplementAppealRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(errors) > 0 {
+		return SupplementAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SupplementAppealRequestMultiError is an error wrapping multiple validation
+// errors returned by SupplementAppealRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SupplementAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SupplementAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SupplementAppealRequestMultiError) AllErrors() []error { return m }
+
+// SupplementAppealRequestValidationError is the validation error returned by
+// SupplementAppealRequest.Validate if the designated constraints aren't met.
+type SupplementAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SupplementAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SupplementAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SupplementAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SupplementAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SupplementAppealRequestValidationError) ErrorName() string {
+	return "SupplementAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SupplementAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupplementAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupplementAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupplementAppealRequestValidationError{}
+
+// Validate checks the field values on SupplementAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SupplementAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SupplementAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SupplementAppealReplyMultiError, or nil if none found.
+func (m *SupplementAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SupplementAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SupplementAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SupplementAppealReplyMultiError is an error wrapping multiple validation
+// errors returned by SupplementAppealReply.ValidateAll() if the designated
+// constraints aren't met.
+type SupplementAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SupplementAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SupplementAppealReplyMultiError) AllErrors() []error { return m }
+
+// SupplementAppealReplyValidationError is the validation error returned by
+// SupplementAppealReply.Validate if the designated constraints aren't met.
+type SupplementAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SupplementAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SupplementAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SupplementAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SupplementAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SupplementAppealReplyValidationError) ErrorName() string {
+	return "SupplementAppealReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SupplementAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSupplementAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SupplementAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SupplementAppealReplyValidationError{}
+
+// Validate checks the field values on WithdrawAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAppealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAppealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAppealRequestMultiError, or nil if none found.
+func (m *WithdrawAppealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAppealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := WithdrawAppealRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAppealID() <= 0 {
+		err := WithdrawAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := WithdrawAppealRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WithdrawAppealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAppealRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawAppealRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawAppealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAppealRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAppealRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawAppealRequestValidationError is the validation error returned by
+// WithdrawAppealRequest.Validate if the designated constraints aren't met.
+type WithdrawAppealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAppealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAppealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAppealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAppealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAppealRequestValidationError) ErrorName() string {
+	return "WithdrawAppealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAppealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAppealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAppealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAppealRequestValidationError{}
+
+// Validate checks the field values on WithdrawAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAppealReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAppealReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAppealReplyMultiError, or nil if none found.
+func (m *WithdrawAppealReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAppealReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WithdrawAppealReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAppealReplyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawAppealReply.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawAppealReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAppealReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAppealReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawAppealReplyValidationError is the validation error returned by
+// WithdrawAppealReply.Validate if the designated constraints aren't met.
+type WithdrawAppealReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAppealReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAppealReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAppealReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAppealReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAppealReplyValidationError) ErrorName() string {
+	return "WithdrawAppealReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAppealReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAppealReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAppealReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAppealReplyValidationError{}
+
+// Validate checks the field values on RequestAppealInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAppealInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAppealInfoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestAppealInfoRequestMultiError, or nil if none found.
+func (m *RequestAppealInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAppealInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppealID() <= 0 {
+		err := RequestAppealInfoRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
+		err := RequestAppealInfoRequestValidationError{
+			field:  "OpUser",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
+		err := RequestAppealInfoRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 2 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestAppealInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestAppealInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestAppealInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestAppealInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAppealInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAppealInfoRequestMultiError) AllErrors() []error { return m }
+
+// RequestAppealInfoRequestValidationError is the validation error returned by
+// RequestAppealInfoRequest.Validate if the designated constraints aren't met.
+type RequestAppealInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAppealInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAppealInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAppealInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAppealInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAppealInfoRequestValidationError) ErrorName() string {
+	return "RequestAppealInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAppealInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAppealInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAppealInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAppealInfoRequestValidationError{}
+
+// Validate checks the field values on RequestAppealInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAppealInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAppealInfoReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestAppealInfoReplyMultiError, or nil if none found.
+func (m *RequestAppealInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAppealInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestAppealInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestAppealInfoReplyMultiError is an error wrapping multiple validation
+// errors returned by RequestAppealInfoReply.ValidateAll() if the designated
+// constraints aren't met.
+type RequestAppealInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAppealInfoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAppealInfoReplyMultiError) AllErrors() []error { return m }
+
+// RequestAppealInfoReplyValidationError is the validation error returned by
+// RequestAppealInfoReply.Validate if the designated constraints aren't met.
+type RequestAppealInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAppealInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAppealInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAppealInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAppealInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAppealInfoReplyValidationError) ErrorName() string {
+	return "RequestAppealInfoReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAppealInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAppealInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAppealInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAppealInfoReplyValidationError{}
+
+// Validate checks the field values on GetAppealHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealHistoryRequestMultiError, or nil if none found.
+func (m *GetAppealHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := GetAppealHistoryRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() < 0 {
+		err := GetAppealHistoryRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAppealHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAppealHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppealHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetAppealHistoryRequestValidationError is the validation error returned by
+// GetAppealHistoryRequest.Validate if the designated constraints aren't met.
+type GetAppealHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealHistoryRequestValidationError) ErrorName() string {
+	return "GetAppealHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealHistoryRequestValidationError{}
+
+// Validate checks the field values on AppealMessageInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppealMessageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppealMessageInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppealMessageInfoMultiError, or nil if none found.
+func (m *AppealMessageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppealMessageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageID
+
+	// no validation rules for AppealID
+
+	// no validation rules for SenderType
+
+	// no validation rules for Sender
+
+	// no validation rules for Action
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for CreateAt
+
+	if len(errors) > 0 {
+		return AppealMessageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppealMessageInfoMultiError is an error wrapping multiple validation errors
+// returned by AppealMessageInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AppealMessageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppealMessageInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppealMessageInfoMultiError) AllErrors() []error { return m }
+
+// AppealMessageInfoValidationError is the validation error returned by
+// AppealMessageInfo.Validate if the designated constraints aren't met.
+type AppealMessageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppealMessageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppealMessageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppealMessageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppealMessageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppealMessageInfoValidationError) ErrorName() string {
+	return "AppealMessageInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppealMessageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppealMessageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppealMessageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppealMessageInfoValidationError{}
+
+// Validate checks the field values on GetAppealHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAppealHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAppealHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAppealHistoryReplyMultiError, or nil if none found.
+func (m *GetAppealHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAppealHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAppeals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Appeals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Appeals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppealHistoryReplyValidationError{
+					field:  fmt.Sprintf("Appeals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAppealHistoryReplyValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAppealHistoryReplyValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAppealHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAppealHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetAppealHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetAppealHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAppealHistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAppealHistoryReplyMultiError) AllErrors() []error { return m }
+
+// GetAppealHistoryReplyValidationError is the validation error returned by
+// GetAppealHistoryReply.Validate if the designated constraints aren't met.
+type GetAppealHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAppealHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAppealHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAppealHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAppealHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAppealHistoryReplyValidationError) ErrorName() string {
+	return "GetAppealHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAppealHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAppealHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAppealHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAppealHistoryReplyValidationError{}
+
+// Validate checks the field values on ReplyTemplateInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplyTemplateInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyTemplateInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyTemplateInfoMultiError, or nil if none found.
+func (m *ReplyTemplateInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyTemplateInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateID
+
+	// no validation rules for StoreID
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	// no validation rules for UpdateAt
+
+	if len(errors) > 0 {
+		return ReplyTemplateInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyTemplateInfoMultiError is an error wrapping multiple validation errors
+// returned by ReplyTemplateInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyTemplateInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyTemplateInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyTemplateInfoMultiError) AllErrors() []error { return m }
+
+// ReplyTemplateInfoValidationError is the validation error returned by
+// ReplyTemplateInfo.Validate if the designated constraints aren't met.
+type ReplyTemplateInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyTemplateInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyTemplateInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyTemplateInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyTemplateInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyTemplateInfoValidationError) ErrorName() string {
+	return "ReplyTemplateInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplyTemplateInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyTemplateInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyTemplateInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyTemplateInfoValidationError{}
+
+// Validate checks the field values on CreateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReplyTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReplyTemplateRequestMultiError, or nil if none found.
+func (m *CreateReplyTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReplyTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := CreateReplyTemplateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := CreateReplyTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := CreateReplyTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateReplyTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReplyTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateReplyTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateReplyTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReplyTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReplyTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateReplyTemplateRequestValidationError is the validation error returned
+// by CreateReplyTemplateRequest.Validate if the designated constraints aren't met.
+type CreateReplyTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReplyTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReplyTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReplyTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReplyTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReplyTemplateRequestValidationError) ErrorName() string {
+	return "CreateReplyTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReplyTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReplyTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReplyTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReplyTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateReplyTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReplyTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReplyTemplateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReplyTemplateReplyMultiError, or nil if none found.
+func (m *CreateReplyTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReplyTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateID
+
+	if len(errors) > 0 {
+		return CreateReplyTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReplyTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateReplyTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReplyTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReplyTemplateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReplyTemplateReplyMultiError) AllErrors() []error { return m }
+
+// CreateReplyTemplateReplyValidationError is the validation error returned by
+// CreateReplyTemplateReply.Validate if the designated constraints aren't met.
+type CreateReplyTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReplyTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReplyTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReplyTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReplyTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReplyTemplateReplyValidationError) ErrorName() string {
+	return "CreateReplyTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReplyTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReplyTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReplyTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReplyTemplateReplyValidationError{}
+
+// Validate checks the field values on UpdateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReplyTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReplyTemplateRequestMultiError, or nil if none found.
+func (m *UpdateReplyTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReplyTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTemplateID() <= 0 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
+		err := UpdateReplyTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 5 and 200 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -414,10 +12335,214 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _UpdateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
-		err := UpdateReviewRequestValidationError{
-			field:  "ExpressScore",
-			reason: "value must be in list [1 2 3 4 5]",
+	if len(errors) > 0 {
+		return UpdateReplyTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReplyTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateReplyTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateReplyTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReplyTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReplyTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReplyTemplateRequestValidationError is the validation error returned
+// by UpdateReplyTemplateRequest.Validate if the designated constraints aren't met.
+type UpdateReplyTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReplyTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReplyTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReplyTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReplyTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReplyTemplateRequestValidationError) ErrorName() string {
+	return "UpdateReplyTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReplyTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReplyTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReplyTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReplyTemplateRequestValidationError{}
+
+// Validate checks the field values on UpdateReplyTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReplyTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReplyTemplateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReplyTemplateReplyMultiError, or nil if none found.
+func (m *UpdateReplyTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReplyTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateReplyTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReplyTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateReplyTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReplyTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReplyTemplateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReplyTemplateReplyMultiError) AllErrors() []error { return m }
+
+// UpdateReplyTemplateReplyValidationError is the validation error returned by
+// UpdateReplyTemplateReply.Validate if the designated constraints aren't met.
+type UpdateReplyTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReplyTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReplyTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReplyTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReplyTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReplyTemplateReplyValidationError) ErrorName() string {
+	return "UpdateReplyTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReplyTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReplyTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReplyTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReplyTemplateReplyValidationError{}
+
+// Validate checks the field values on DeleteReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReplyTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReplyTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReplyTemplateRequestMultiError, or nil if none found.
+func (m *DeleteReplyTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReplyTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := DeleteReplyTemplateRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -425,10 +12550,10 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
-		err := UpdateReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 140 runes, inclusive",
+	if m.GetTemplateID() <= 0 {
+		err := DeleteReplyTemplateRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -436,24 +12561,122 @@ func (m *UpdateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
+	if len(errors) > 0 {
+		return DeleteReplyTemplateRequestMultiError(errors)
+	}
 
-	// no validation rules for VideoInfo
+	return nil
+}
+
+// DeleteReplyTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteReplyTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteReplyTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReplyTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReplyTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReplyTemplateRequestValidationError is the validation error returned
+// by DeleteReplyTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteReplyTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReplyTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReplyTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReplyTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReplyTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReplyTemplateRequestValidationError) ErrorName() string {
+	return "DeleteReplyTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReplyTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReplyTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReplyTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReplyTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteReplyTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReplyTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReplyTemplateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReplyTemplateReplyMultiError, or nil if none found.
+func (m *DeleteReplyTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReplyTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if len(errors) > 0 {
-		return UpdateReviewRequestMultiError(errors)
+		return DeleteReplyTemplateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// DeleteReplyTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteReplyTemplateReply.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateReviewRequestMultiError []error
+type DeleteReplyTemplateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReviewRequestMultiError) Error() string {
+func (m DeleteReplyTemplateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -462,11 +12685,11 @@ func (m UpdateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+func (m DeleteReplyTemplateReplyMultiError) AllErrors() []error { return m }
 
-// UpdateReviewRequestValidationError is the validation error returned by
-// UpdateReviewRequest.Validate if the designated constraints aren't met.
-type UpdateReviewRequestValidationError struct {
+// DeleteReplyTemplateReplyValidationError is the validation error returned by
+// DeleteReplyTemplateReply.Validate if the designated constraints aren't met.
+type DeleteReplyTemplateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -474,24 +12697,24 @@ type UpdateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+func (e DeleteReplyTemplateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+func (e DeleteReplyTemplateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+func (e DeleteReplyTemplateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+func (e DeleteReplyTemplateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReviewRequestValidationError) ErrorName() string {
-	return "UpdateReviewRequestValidationError"
+func (e DeleteReplyTemplateReplyValidationError) ErrorName() string {
+	return "DeleteReplyTemplateReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReviewRequestValidationError) Error() string {
+func (e DeleteReplyTemplateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -503,14 +12726,14 @@ func (e UpdateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReviewRequest.%s: %s%s",
+		"invalid %sDeleteReplyTemplateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReviewRequestValidationError{}
+var _ error = DeleteReplyTemplateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -518,81 +12741,191 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReviewRequestValidationError{}
+} = DeleteReplyTemplateReplyValidationError{}
 
-var _UpdateReviewRequest_Score_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+// Validate checks the field values on ListReplyTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReplyTemplatesRequest) Validate() error {
+	return m.validate(false)
 }
 
-var _UpdateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+// ValidateAll checks the field values on ListReplyTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReplyTemplatesRequestMultiError, or nil if none found.
+func (m *ListReplyTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
 }
 
-var _UpdateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+func (m *ListReplyTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListReplyTemplatesRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReplyTemplatesRequestMultiError(errors)
+	}
+
+	return nil
 }
 
-// Validate checks the field values on UpdateReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateReviewReply) Validate() error {
-	return m.validate(false)
+// ListReplyTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReplyTemplatesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListReplyTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReplyTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
 }
 
-// ValidateAll checks the field values on UpdateReviewReply with the rules
+// AllErrors returns a list of validation violation errors.
+func (m ListReplyTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListReplyTemplatesRequestValidationError is the validation error returned by
+// ListReplyTemplatesRequest.Validate if the designated constraints aren't met.
+type ListReplyTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReplyTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReplyTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReplyTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReplyTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReplyTemplatesRequestValidationError) ErrorName() string {
+	return "ListReplyTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReplyTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReplyTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReplyTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReplyTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListReplyTemplatesReply with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReplyTemplatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReplyTemplatesReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateReviewReplyMultiError, or nil if none found.
-func (m *UpdateReviewReply) ValidateAll() error {
+// ListReplyTemplatesReplyMultiError, or nil if none found.
+func (m *ListReplyTemplatesReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateReviewReply) validate(all bool) error {
+func (m *ListReplyTemplatesReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReviewID
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
 
-	if m.GetRowaffected() < 0 {
-		err := UpdateReviewReplyValidationError{
-			field:  "Rowaffected",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReplyTemplatesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReplyTemplatesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReplyTemplatesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateReviewReplyMultiError(errors)
+		return ListReplyTemplatesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by UpdateReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateReviewReplyMultiError []error
+// ListReplyTemplatesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReplyTemplatesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReplyTemplatesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateReviewReplyMultiError) Error() string {
+func (m ListReplyTemplatesReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -601,11 +12934,11 @@ func (m UpdateReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateReviewReplyMultiError) AllErrors() []error { return m }
+func (m ListReplyTemplatesReplyMultiError) AllErrors() []error { return m }
 
-// UpdateReviewReplyValidationError is the validation error returned by
-// UpdateReviewReply.Validate if the designated constraints aren't met.
-type UpdateReviewReplyValidationError struct {
+// ListReplyTemplatesReplyValidationError is the validation error returned by
+// ListReplyTemplatesReply.Validate if the designated constraints aren't met.
+type ListReplyTemplatesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -613,24 +12946,24 @@ type UpdateReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReviewReplyValidationError) Field() string { return e.field }
+func (e ListReplyTemplatesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReviewReplyValidationError) Reason() string { return e.reason }
+func (e ListReplyTemplatesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReviewReplyValidationError) Cause() error { return e.cause }
+func (e ListReplyTemplatesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReviewReplyValidationError) Key() bool { return e.key }
+func (e ListReplyTemplatesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReviewReplyValidationError) ErrorName() string {
-	return "UpdateReviewReplyValidationError"
+func (e ListReplyTemplatesReplyValidationError) ErrorName() string {
+	return "ListReplyTemplatesReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReviewReplyValidationError) Error() string {
+func (e ListReplyTemplatesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -642,14 +12975,14 @@ func (e UpdateReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReviewReply.%s: %s%s",
+		"invalid %sListReplyTemplatesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReviewReplyValidationError{}
+var _ error = ListReplyTemplatesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -657,66 +12990,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReviewReplyValidationError{}
+} = ListReplyTemplatesReplyValidationError{}
 
-// Validate checks the field values on DeleteReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReviewRequest) Validate() error {
+// Validate checks the field values on AutoReplyRuleInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AutoReplyRuleInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReviewRequest with the rules
+// ValidateAll checks the field values on AutoReplyRuleInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReviewRequestMultiError, or nil if none found.
-func (m *DeleteReviewRequest) ValidateAll() error {
+// AutoReplyRuleInfoMultiError, or nil if none found.
+func (m *AutoReplyRuleInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReviewRequest) validate(all bool) error {
+func (m *AutoReplyRuleInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := DeleteReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RuleID
 
-	if m.GetUserID() <= 0 {
-		err := DeleteReviewRequestValidationError{
-			field:  "UserID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for StoreID
+
+	// no validation rules for TemplateID
+
+	// no validation rules for MinScore
+
+	// no validation rules for MaxScore
+
+	// no validation rules for OnlyEmpty
+
+	// no validation rules for DelaySeconds
+
+	// no validation rules for Enabled
 
 	if len(errors) > 0 {
-		return DeleteReviewRequestMultiError(errors)
+		return AutoReplyRuleInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReviewRequestMultiError []error
+// AutoReplyRuleInfoMultiError is an error wrapping multiple validation errors
+// returned by AutoReplyRuleInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AutoReplyRuleInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReviewRequestMultiError) Error() string {
+func (m AutoReplyRuleInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -725,11 +13052,11 @@ func (m DeleteReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReviewRequestMultiError) AllErrors() []error { return m }
+func (m AutoReplyRuleInfoMultiError) AllErrors() []error { return m }
 
-// DeleteReviewRequestValidationError is the validation error returned by
-// DeleteReviewRequest.Validate if the designated constraints aren't met.
-type DeleteReviewRequestValidationError struct {
+// AutoReplyRuleInfoValidationError is the validation error returned by
+// AutoReplyRuleInfo.Validate if the designated constraints aren't met.
+type AutoReplyRuleInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -737,24 +13064,24 @@ type DeleteReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReviewRequestValidationError) Field() string { return e.field }
+func (e AutoReplyRuleInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReviewRequestValidationError) Reason() string { return e.reason }
+func (e AutoReplyRuleInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReviewRequestValidationError) Cause() error { return e.cause }
+func (e AutoReplyRuleInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReviewRequestValidationError) Key() bool { return e.key }
+func (e AutoReplyRuleInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReviewRequestValidationError) ErrorName() string {
-	return "DeleteReviewRequestValidationError"
+func (e AutoReplyRuleInfoValidationError) ErrorName() string {
+	return "AutoReplyRuleInfoValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReviewRequestValidationError) Error() string {
+func (e AutoReplyRuleInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -766,14 +13093,14 @@ func (e DeleteReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReviewRequest.%s: %s%s",
+		"invalid %sAutoReplyRuleInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReviewRequestValidationError{}
+var _ error = AutoReplyRuleInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -781,33 +13108,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReviewRequestValidationError{}
+} = AutoReplyRuleInfoValidationError{}
 
-// Validate checks the field values on DeleteReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReviewReply) Validate() error {
+// Validate checks the field values on CreateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAutoReplyRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteReviewReplyMultiError, or nil if none found.
-func (m *DeleteReviewReply) ValidateAll() error {
+// CreateAutoReplyRuleRequestMultiError, or nil if none found.
+func (m *CreateAutoReplyRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReviewReply) validate(all bool) error {
+func (m *CreateAutoReplyRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetRowaffected() < 0 {
-		err := DeleteReviewReplyValidationError{
-			field:  "Rowaffected",
+	if m.GetStoreID() <= 0 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTemplateID() <= 0 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinScore(); val < 1 || val > 5 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxScore(); val < 1 || val > 5 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OnlyEmpty
+
+	if m.GetDelaySeconds() < 0 {
+		err := CreateAutoReplyRuleRequestValidationError{
+			field:  "DelaySeconds",
 			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
@@ -816,20 +13189,22 @@ func (m *DeleteReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Enabled
+
 	if len(errors) > 0 {
-		return DeleteReviewReplyMultiError(errors)
+		return CreateAutoReplyRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by DeleteReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteReviewReplyMultiError []error
+// CreateAutoReplyRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateAutoReplyRuleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAutoReplyRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReviewReplyMultiError) Error() string {
+func (m CreateAutoReplyRuleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -838,11 +13213,11 @@ func (m DeleteReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReviewReplyMultiError) AllErrors() []error { return m }
+func (m CreateAutoReplyRuleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReviewReplyValidationError is the validation error returned by
-// DeleteReviewReply.Validate if the designated constraints aren't met.
-type DeleteReviewReplyValidationError struct {
+// CreateAutoReplyRuleRequestValidationError is the validation error returned
+// by CreateAutoReplyRuleRequest.Validate if the designated constraints aren't met.
+type CreateAutoReplyRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -850,24 +13225,24 @@ type DeleteReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReviewReplyValidationError) Field() string { return e.field }
+func (e CreateAutoReplyRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReviewReplyValidationError) Reason() string { return e.reason }
+func (e CreateAutoReplyRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReviewReplyValidationError) Cause() error { return e.cause }
+func (e CreateAutoReplyRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReviewReplyValidationError) Key() bool { return e.key }
+func (e CreateAutoReplyRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReviewReplyValidationError) ErrorName() string {
-	return "DeleteReviewReplyValidationError"
+func (e CreateAutoReplyRuleRequestValidationError) ErrorName() string {
+	return "CreateAutoReplyRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReviewReplyValidationError) Error() string {
+func (e CreateAutoReplyRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -879,14 +13254,14 @@ func (e DeleteReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReviewReply.%s: %s%s",
+		"invalid %sCreateAutoReplyRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReviewReplyValidationError{}
+var _ error = CreateAutoReplyRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -894,55 +13269,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReviewReplyValidationError{}
+} = CreateAutoReplyRuleRequestValidationError{}
 
-// Validate checks the field values on GetReviewRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetReviewRequest) Validate() error {
+// Validate checks the field values on CreateAutoReplyRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAutoReplyRuleReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateAutoReplyRuleReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReviewRequestMultiError, or nil if none found.
-func (m *GetReviewRequest) ValidateAll() error {
+// CreateAutoReplyRuleReplyMultiError, or nil if none found.
+func (m *CreateAutoReplyRuleReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewRequest) validate(all bool) error {
+func (m *CreateAutoReplyRuleReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := GetReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RuleID
 
 	if len(errors) > 0 {
-		return GetReviewRequestMultiError(errors)
+		return CreateAutoReplyRuleReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by GetReviewRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetReviewRequestMultiError []error
+// CreateAutoReplyRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateAutoReplyRuleReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAutoReplyRuleReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewRequestMultiError) Error() string {
+func (m CreateAutoReplyRuleReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -951,11 +13317,11 @@ func (m GetReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+func (m CreateAutoReplyRuleReplyMultiError) AllErrors() []error { return m }
 
-// GetReviewRequestValidationError is the validation error returned by
-// GetReviewRequest.Validate if the designated constraints aren't met.
-type GetReviewRequestValidationError struct {
+// CreateAutoReplyRuleReplyValidationError is the validation error returned by
+// CreateAutoReplyRuleReply.Validate if the designated constraints aren't met.
+type CreateAutoReplyRuleReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -963,22 +13329,24 @@ type GetReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewRequestValidationError) Field() string { return e.field }
+func (e CreateAutoReplyRuleReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+func (e CreateAutoReplyRuleReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+func (e CreateAutoReplyRuleReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewRequestValidationError) Key() bool { return e.key }
+func (e CreateAutoReplyRuleReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+func (e CreateAutoReplyRuleReplyValidationError) ErrorName() string {
+	return "CreateAutoReplyRuleReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetReviewRequestValidationError) Error() string {
+func (e CreateAutoReplyRuleReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -990,14 +13358,14 @@ func (e GetReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewRequest.%s: %s%s",
+		"invalid %sCreateAutoReplyRuleReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewRequestValidationError{}
+var _ error = CreateAutoReplyRuleReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1005,33 +13373,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReviewRequestValidationError{}
+} = CreateAutoReplyRuleReplyValidationError{}
 
-// Validate checks the field values on GetReviewReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetReviewReply) Validate() error {
+// Validate checks the field values on UpdateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAutoReplyRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetReviewReplyMultiError,
-// or nil if none found.
-func (m *GetReviewReply) ValidateAll() error {
+// ValidateAll checks the field values on UpdateAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAutoReplyRuleRequestMultiError, or nil if none found.
+func (m *UpdateAutoReplyRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewReply) validate(all bool) error {
+func (m *UpdateAutoReplyRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserID() <= 0 {
-		err := GetReviewReplyValidationError{
-			field:  "UserID",
+	if m.GetStoreID() <= 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "StoreID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1040,9 +13408,9 @@ func (m *GetReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOrderID() <= 0 {
-		err := GetReviewReplyValidationError{
-			field:  "OrderID",
+	if m.GetRuleID() <= 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "RuleID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1051,10 +13419,10 @@ func (m *GetReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _GetReviewReply_Score_InLookup[m.GetScore()]; !ok {
-		err := GetReviewReplyValidationError{
-			field:  "Score",
-			reason: "value must be in list [1 2 3 4 5]",
+	if m.GetTemplateID() <= 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "TemplateID",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1062,10 +13430,10 @@ func (m *GetReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _GetReviewReply_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
-		err := GetReviewReplyValidationError{
-			field:  "ServiceScore",
-			reason: "value must be in list [1 2 3 4 5]",
+	if val := m.GetMinScore(); val < 1 || val > 5 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "MinScore",
+			reason: "value must be inside range [1, 5]",
 		}
 		if !all {
 			return err
@@ -1073,10 +13441,10 @@ func (m *GetReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _GetReviewReply_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
-		err := GetReviewReplyValidationError{
-			field:  "ExpressScore",
-			reason: "value must be in list [1 2 3 4 5]",
+	if val := m.GetMaxScore(); val < 1 || val > 5 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "MaxScore",
+			reason: "value must be inside range [1, 5]",
 		}
 		if !all {
 			return err
@@ -1084,10 +13452,12 @@ func (m *GetReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
-		err := GetReviewReplyValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 140 runes, inclusive",
+	// no validation rules for OnlyEmpty
+
+	if m.GetDelaySeconds() < 0 {
+		err := UpdateAutoReplyRuleRequestValidationError{
+			field:  "DelaySeconds",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1095,26 +13465,22 @@ func (m *GetReviewReply) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
-	// no validation rules for Status
+	// no validation rules for Enabled
 
 	if len(errors) > 0 {
-		return GetReviewReplyMultiError(errors)
+		return UpdateAutoReplyRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by GetReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetReviewReplyMultiError []error
+// UpdateAutoReplyRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateAutoReplyRuleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateAutoReplyRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewReplyMultiError) Error() string {
+func (m UpdateAutoReplyRuleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1123,11 +13489,11 @@ func (m GetReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewReplyMultiError) AllErrors() []error { return m }
+func (m UpdateAutoReplyRuleRequestMultiError) AllErrors() []error { return m }
 
-// GetReviewReplyValidationError is the validation error returned by
-// GetReviewReply.Validate if the designated constraints aren't met.
-type GetReviewReplyValidationError struct {
+// UpdateAutoReplyRuleRequestValidationError is the validation error returned
+// by UpdateAutoReplyRuleRequest.Validate if the designated constraints aren't met.
+type UpdateAutoReplyRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1135,22 +13501,24 @@ type GetReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewReplyValidationError) Field() string { return e.field }
+func (e UpdateAutoReplyRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewReplyValidationError) Reason() string { return e.reason }
+func (e UpdateAutoReplyRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewReplyValidationError) Cause() error { return e.cause }
+func (e UpdateAutoReplyRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewReplyValidationError) Key() bool { return e.key }
+func (e UpdateAutoReplyRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewReplyValidationError) ErrorName() string { return "GetReviewReplyValidationError" }
+func (e UpdateAutoReplyRuleRequestValidationError) ErrorName() string {
+	return "UpdateAutoReplyRuleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetReviewReplyValidationError) Error() string {
+func (e UpdateAutoReplyRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1162,14 +13530,14 @@ func (e GetReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewReply.%s: %s%s",
+		"invalid %sUpdateAutoReplyRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewReplyValidationError{}
+var _ error = UpdateAutoReplyRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1177,79 +13545,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReviewReplyValidationError{}
-
-var _GetReviewReply_Score_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
-}
-
-var _GetReviewReply_ServiceScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
-}
-
-var _GetReviewReply_ExpressScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
-}
+} = UpdateAutoReplyRuleRequestValidationError{}
 
-// Validate checks the field values on GetAllReviewsRequest with the rules
+// Validate checks the field values on UpdateAutoReplyRuleReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllReviewsRequest) Validate() error {
+func (m *UpdateAutoReplyRuleReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllReviewsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateAutoReplyRuleReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllReviewsRequestMultiError, or nil if none found.
-func (m *GetAllReviewsRequest) ValidateAll() error {
+// UpdateAutoReplyRuleReplyMultiError, or nil if none found.
+func (m *UpdateAutoReplyRuleReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllReviewsRequest) validate(all bool) error {
+func (m *UpdateAutoReplyRuleReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserID() <= 0 {
-		err := GetAllReviewsRequestValidationError{
-			field:  "UserID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return GetAllReviewsRequestMultiError(errors)
+		return UpdateAutoReplyRuleReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllReviewsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAllReviewsRequest.ValidateAll() if the designated
+// UpdateAutoReplyRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateAutoReplyRuleReply.ValidateAll() if the designated
 // constraints aren't met.
-type GetAllReviewsRequestMultiError []error
+type UpdateAutoReplyRuleReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllReviewsRequestMultiError) Error() string {
+func (m UpdateAutoReplyRuleReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1258,11 +13591,11 @@ func (m GetAllReviewsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllReviewsRequestMultiError) AllErrors() []error { return m }
+func (m UpdateAutoReplyRuleReplyMultiError) AllErrors() []error { return m }
 
-// GetAllReviewsRequestValidationError is the validation error returned by
-// GetAllReviewsRequest.Validate if the designated constraints aren't met.
-type GetAllReviewsRequestValidationError struct {
+// UpdateAutoReplyRuleReplyValidationError is the validation error returned by
+// UpdateAutoReplyRuleReply.Validate if the designated constraints aren't met.
+type UpdateAutoReplyRuleReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1270,24 +13603,24 @@ type GetAllReviewsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllReviewsRequestValidationError) Field() string { return e.field }
+func (e UpdateAutoReplyRuleReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllReviewsRequestValidationError) Reason() string { return e.reason }
+func (e UpdateAutoReplyRuleReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllReviewsRequestValidationError) Cause() error { return e.cause }
+func (e UpdateAutoReplyRuleReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllReviewsRequestValidationError) Key() bool { return e.key }
+func (e UpdateAutoReplyRuleReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllReviewsRequestValidationError) ErrorName() string {
-	return "GetAllReviewsRequestValidationError"
+func (e UpdateAutoReplyRuleReplyValidationError) ErrorName() string {
+	return "UpdateAutoReplyRuleReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllReviewsRequestValidationError) Error() string {
+func (e UpdateAutoReplyRuleReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1299,14 +13632,14 @@ func (e GetAllReviewsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllReviewsRequest.%s: %s%s",
+		"invalid %sUpdateAutoReplyRuleReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllReviewsRequestValidationError{}
+var _ error = UpdateAutoReplyRuleReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1314,44 +13647,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllReviewsRequestValidationError{}
+} = UpdateAutoReplyRuleReplyValidationError{}
 
-// Validate checks the field values on AllReviewsInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AllReviewsInfo) Validate() error {
+// Validate checks the field values on DeleteAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAutoReplyRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AllReviewsInfo with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AllReviewsInfoMultiError,
-// or nil if none found.
-func (m *AllReviewsInfo) ValidateAll() error {
+// ValidateAll checks the field values on DeleteAutoReplyRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAutoReplyRuleRequestMultiError, or nil if none found.
+func (m *DeleteAutoReplyRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AllReviewsInfo) validate(all bool) error {
+func (m *DeleteAutoReplyRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserID() <= 0 {
-		err := AllReviewsInfoValidationError{
-			field:  "UserID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetOrderID() <= 0 {
-		err := AllReviewsInfoValidationError{
-			field:  "OrderID",
+	if m.GetStoreID() <= 0 {
+		err := DeleteAutoReplyRuleRequestValidationError{
+			field:  "StoreID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1360,9 +13682,9 @@ func (m *AllReviewsInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetReviewID() <= 0 {
-		err := AllReviewsInfoValidationError{
-			field:  "ReviewID",
+	if m.GetRuleID() <= 0 {
+		err := DeleteAutoReplyRuleRequestValidationError{
+			field:  "RuleID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1371,70 +13693,20 @@ func (m *AllReviewsInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _AllReviewsInfo_Score_InLookup[m.GetScore()]; !ok {
-		err := AllReviewsInfoValidationError{
-			field:  "Score",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _AllReviewsInfo_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
-		err := AllReviewsInfoValidationError{
-			field:  "ServiceScore",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _AllReviewsInfo_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
-		err := AllReviewsInfoValidationError{
-			field:  "ExpressScore",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 140 {
-		err := AllReviewsInfoValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 140 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
-	// no validation rules for Status
-
 	if len(errors) > 0 {
-		return AllReviewsInfoMultiError(errors)
+		return DeleteAutoReplyRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AllReviewsInfoMultiError is an error wrapping multiple validation errors
-// returned by AllReviewsInfo.ValidateAll() if the designated constraints
-// aren't met.
-type AllReviewsInfoMultiError []error
+// DeleteAutoReplyRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteAutoReplyRuleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteAutoReplyRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AllReviewsInfoMultiError) Error() string {
+func (m DeleteAutoReplyRuleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1443,11 +13715,11 @@ func (m AllReviewsInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AllReviewsInfoMultiError) AllErrors() []error { return m }
+func (m DeleteAutoReplyRuleRequestMultiError) AllErrors() []error { return m }
 
-// AllReviewsInfoValidationError is the validation error returned by
-// AllReviewsInfo.Validate if the designated constraints aren't met.
-type AllReviewsInfoValidationError struct {
+// DeleteAutoReplyRuleRequestValidationError is the validation error returned
+// by DeleteAutoReplyRuleRequest.Validate if the designated constraints aren't met.
+type DeleteAutoReplyRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1455,22 +13727,24 @@ type AllReviewsInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e AllReviewsInfoValidationError) Field() string { return e.field }
+func (e DeleteAutoReplyRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AllReviewsInfoValidationError) Reason() string { return e.reason }
+func (e DeleteAutoReplyRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AllReviewsInfoValidationError) Cause() error { return e.cause }
+func (e DeleteAutoReplyRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AllReviewsInfoValidationError) Key() bool { return e.key }
+func (e DeleteAutoReplyRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AllReviewsInfoValidationError) ErrorName() string { return "AllReviewsInfoValidationError" }
+func (e DeleteAutoReplyRuleRequestValidationError) ErrorName() string {
+	return "DeleteAutoReplyRuleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AllReviewsInfoValidationError) Error() string {
+func (e DeleteAutoReplyRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1482,14 +13756,14 @@ func (e AllReviewsInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAllReviewsInfo.%s: %s%s",
+		"invalid %sDeleteAutoReplyRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AllReviewsInfoValidationError{}
+var _ error = DeleteAutoReplyRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1497,102 +13771,157 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AllReviewsInfoValidationError{}
+} = DeleteAutoReplyRuleRequestValidationError{}
 
-var _AllReviewsInfo_Score_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+// Validate checks the field values on DeleteAutoReplyRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAutoReplyRuleReply) Validate() error {
+	return m.validate(false)
 }
 
-var _AllReviewsInfo_ServiceScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+// ValidateAll checks the field values on DeleteAutoReplyRuleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAutoReplyRuleReplyMultiError, or nil if none found.
+func (m *DeleteAutoReplyRuleReply) ValidateAll() error {
+	return m.validate(true)
 }
 
-var _AllReviewsInfo_ExpressScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+func (m *DeleteAutoReplyRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAutoReplyRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAutoReplyRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteAutoReplyRuleReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAutoReplyRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAutoReplyRuleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAutoReplyRuleReplyMultiError) AllErrors() []error { return m }
+
+// DeleteAutoReplyRuleReplyValidationError is the validation error returned by
+// DeleteAutoReplyRuleReply.Validate if the designated constraints aren't met.
+type DeleteAutoReplyRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAutoReplyRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAutoReplyRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAutoReplyRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAutoReplyRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAutoReplyRuleReplyValidationError) ErrorName() string {
+	return "DeleteAutoReplyRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAutoReplyRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAutoReplyRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
 }
 
-// Validate checks the field values on GetAllReviewsReply with the rules
+var _ error = DeleteAutoReplyRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAutoReplyRuleReplyValidationError{}
+
+// Validate checks the field values on ListAutoReplyRulesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllReviewsReply) Validate() error {
+func (m *ListAutoReplyRulesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllReviewsReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAutoReplyRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllReviewsReplyMultiError, or nil if none found.
-func (m *GetAllReviewsReply) ValidateAll() error {
+// ListAutoReplyRulesRequestMultiError, or nil if none found.
+func (m *ListAutoReplyRulesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllReviewsReply) validate(all bool) error {
+func (m *ListAutoReplyRulesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllReviewsReplyValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllReviewsReplyValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAllReviewsReplyValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetStoreID() <= 0 {
+		err := ListAutoReplyRulesRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetAllReviewsReplyMultiError(errors)
+		return ListAutoReplyRulesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllReviewsReplyMultiError is an error wrapping multiple validation errors
-// returned by GetAllReviewsReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetAllReviewsReplyMultiError []error
+// ListAutoReplyRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAutoReplyRulesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListAutoReplyRulesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllReviewsReplyMultiError) Error() string {
+func (m ListAutoReplyRulesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1601,11 +13930,11 @@ func (m GetAllReviewsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllReviewsReplyMultiError) AllErrors() []error { return m }
+func (m ListAutoReplyRulesRequestMultiError) AllErrors() []error { return m }
 
-// GetAllReviewsReplyValidationError is the validation error returned by
-// GetAllReviewsReply.Validate if the designated constraints aren't met.
-type GetAllReviewsReplyValidationError struct {
+// ListAutoReplyRulesRequestValidationError is the validation error returned by
+// ListAutoReplyRulesRequest.Validate if the designated constraints aren't met.
+type ListAutoReplyRulesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1613,24 +13942,24 @@ type GetAllReviewsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllReviewsReplyValidationError) Field() string { return e.field }
+func (e ListAutoReplyRulesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllReviewsReplyValidationError) Reason() string { return e.reason }
+func (e ListAutoReplyRulesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllReviewsReplyValidationError) Cause() error { return e.cause }
+func (e ListAutoReplyRulesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllReviewsReplyValidationError) Key() bool { return e.key }
+func (e ListAutoReplyRulesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllReviewsReplyValidationError) ErrorName() string {
-	return "GetAllReviewsReplyValidationError"
+func (e ListAutoReplyRulesRequestValidationError) ErrorName() string {
+	return "ListAutoReplyRulesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllReviewsReplyValidationError) Error() string {
+func (e ListAutoReplyRulesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1642,14 +13971,14 @@ func (e GetAllReviewsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllReviewsReply.%s: %s%s",
+		"invalid %sListAutoReplyRulesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllReviewsReplyValidationError{}
+var _ error = ListAutoReplyRulesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1657,81 +13986,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllReviewsReplyValidationError{}
+} = ListAutoReplyRulesRequestValidationError{}
 
-// Validate checks the field values on ReplyReviewRequest with the rules
+// Validate checks the field values on ListAutoReplyRulesReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewRequest) Validate() error {
+func (m *ListAutoReplyRulesReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAutoReplyRulesReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewRequestMultiError, or nil if none found.
-func (m *ReplyReviewRequest) ValidateAll() error {
+// ListAutoReplyRulesReplyMultiError, or nil if none found.
+func (m *ListAutoReplyRulesReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewRequest) validate(all bool) error {
+func (m *ListAutoReplyRulesReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := ReplyReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
 
-	if m.GetStoreID() <= 0 {
-		err := ReplyReviewRequestValidationError{
-			field:  "StoreID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAutoReplyRulesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAutoReplyRulesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAutoReplyRulesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
-		err := ReplyReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 200 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
 	if len(errors) > 0 {
-		return ReplyReviewRequestMultiError(errors)
+		return ListAutoReplyRulesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by ReplyReviewRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ReplyReviewRequestMultiError []error
+// ListAutoReplyRulesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListAutoReplyRulesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListAutoReplyRulesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewRequestMultiError) Error() string {
+func (m ListAutoReplyRulesReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1740,11 +14066,11 @@ func (m ReplyReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewRequestMultiError) AllErrors() []error { return m }
+func (m ListAutoReplyRulesReplyMultiError) AllErrors() []error { return m }
 
-// ReplyReviewRequestValidationError is the validation error returned by
-// ReplyReviewRequest.Validate if the designated constraints aren't met.
-type ReplyReviewRequestValidationError struct {
+// ListAutoReplyRulesReplyValidationError is the validation error returned by
+// ListAutoReplyRulesReply.Validate if the designated constraints aren't met.
+type ListAutoReplyRulesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1752,24 +14078,24 @@ type ReplyReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewRequestValidationError) Field() string { return e.field }
+func (e ListAutoReplyRulesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewRequestValidationError) Reason() string { return e.reason }
+func (e ListAutoReplyRulesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewRequestValidationError) Cause() error { return e.cause }
+func (e ListAutoReplyRulesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewRequestValidationError) Key() bool { return e.key }
+func (e ListAutoReplyRulesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewRequestValidationError) ErrorName() string {
-	return "ReplyReviewRequestValidationError"
+func (e ListAutoReplyRulesReplyValidationError) ErrorName() string {
+	return "ListAutoReplyRulesReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewRequestValidationError) Error() string {
+func (e ListAutoReplyRulesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1781,14 +14107,14 @@ func (e ReplyReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewRequest.%s: %s%s",
+		"invalid %sListAutoReplyRulesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewRequestValidationError{}
+var _ error = ListAutoReplyRulesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1796,46 +14122,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewRequestValidationError{}
+} = ListAutoReplyRulesReplyValidationError{}
 
-// Validate checks the field values on ReplyReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewReply) Validate() error {
+// Validate checks the field values on WebhookInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReplyReviewReplyMultiError, or nil if none found.
-func (m *ReplyReviewReply) ValidateAll() error {
+// ValidateAll checks the field values on WebhookInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookInfoMultiError, or
+// nil if none found.
+func (m *WebhookInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewReply) validate(all bool) error {
+func (m *WebhookInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReplyID
+	// no validation rules for SubscriptionID
+
+	// no validation rules for OwnerType
+
+	// no validation rules for OwnerID
+
+	// no validation rules for Url
+
+	// no validation rules for CreateAt
 
 	if len(errors) > 0 {
-		return ReplyReviewReplyMultiError(errors)
+		return WebhookInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by ReplyReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type ReplyReviewReplyMultiError []error
+// WebhookInfoMultiError is an error wrapping multiple validation errors
+// returned by WebhookInfo.ValidateAll() if the designated constraints aren't met.
+type WebhookInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewReplyMultiError) Error() string {
+func (m WebhookInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1844,11 +14177,11 @@ func (m ReplyReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewReplyMultiError) AllErrors() []error { return m }
+func (m WebhookInfoMultiError) AllErrors() []error { return m }
 
-// ReplyReviewReplyValidationError is the validation error returned by
-// ReplyReviewReply.Validate if the designated constraints aren't met.
-type ReplyReviewReplyValidationError struct {
+// WebhookInfoValidationError is the validation error returned by
+// WebhookInfo.Validate if the designated constraints aren't met.
+type WebhookInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1856,22 +14189,22 @@ type ReplyReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewReplyValidationError) Field() string { return e.field }
+func (e WebhookInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewReplyValidationError) Reason() string { return e.reason }
+func (e WebhookInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewReplyValidationError) Cause() error { return e.cause }
+func (e WebhookInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewReplyValidationError) Key() bool { return e.key }
+func (e WebhookInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewReplyValidationError) ErrorName() string { return "ReplyReviewReplyValidationError" }
+func (e WebhookInfoValidationError) ErrorName() string { return "WebhookInfoValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewReplyValidationError) Error() string {
+func (e WebhookInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1883,14 +14216,14 @@ func (e ReplyReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewReply.%s: %s%s",
+		"invalid %sWebhookInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewReplyValidationError{}
+var _ error = WebhookInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -1898,34 +14231,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewReplyValidationError{}
+} = WebhookInfoValidationError{}
 
-// Validate checks the field values on ReplyReviewUpdateRequest with the rules
+// Validate checks the field values on CreateWebhookRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewUpdateRequest) Validate() error {
+func (m *CreateWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewUpdateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewUpdateRequestMultiError, or nil if none found.
-func (m *ReplyReviewUpdateRequest) ValidateAll() error {
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewUpdateRequest) validate(all bool) error {
+func (m *CreateWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := ReplyReviewUpdateRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
+	if _, ok := _CreateWebhookRequest_OwnerType_InLookup[m.GetOwnerType()]; !ok {
+		err := CreateWebhookRequestValidationError{
+			field:  "OwnerType",
+			reason: "value must be in list [1 2]",
 		}
 		if !all {
 			return err
@@ -1933,9 +14266,9 @@ func (m *ReplyReviewUpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStoreID() <= 0 {
-		err := ReplyReviewUpdateRequestValidationError{
-			field:  "StoreID",
+	if m.GetOwnerID() <= 0 {
+		err := CreateWebhookRequestValidationError{
+			field:  "OwnerID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1944,10 +14277,10 @@ func (m *ReplyReviewUpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetReplyID() <= 0 {
-		err := ReplyReviewUpdateRequestValidationError{
-			field:  "ReplyID",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetUrl()) > 512 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 512 runes",
 		}
 		if !all {
 			return err
@@ -1955,10 +14288,20 @@ func (m *ReplyReviewUpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
-		err := ReplyReviewUpdateRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 200 runes, inclusive",
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
 		}
 		if !all {
 			return err
@@ -1966,24 +14309,52 @@ func (m *ReplyReviewUpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
+	if len(m.GetEvents()) < 1 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Events",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for VideoInfo
+	_CreateWebhookRequest_Events_Unique := make(map[string]struct{}, len(m.GetEvents()))
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if _, exists := _CreateWebhookRequest_Events_Unique[item]; exists {
+			err := CreateWebhookRequestValidationError{
+				field:  fmt.Sprintf("Events[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateWebhookRequest_Events_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Events[idx]
+	}
 
 	if len(errors) > 0 {
-		return ReplyReviewUpdateRequestMultiError(errors)
+		return CreateWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by ReplyReviewUpdateRequest.ValidateAll() if the designated
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ReplyReviewUpdateRequestMultiError []error
+type CreateWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewUpdateRequestMultiError) Error() string {
+func (m CreateWebhookRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1992,11 +14363,11 @@ func (m ReplyReviewUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewUpdateRequestMultiError) AllErrors() []error { return m }
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
 
-// ReplyReviewUpdateRequestValidationError is the validation error returned by
-// ReplyReviewUpdateRequest.Validate if the designated constraints aren't met.
-type ReplyReviewUpdateRequestValidationError struct {
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2004,24 +14375,24 @@ type ReplyReviewUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewUpdateRequestValidationError) Field() string { return e.field }
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewUpdateRequestValidationError) Reason() string { return e.reason }
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewUpdateRequestValidationError) Cause() error { return e.cause }
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewUpdateRequestValidationError) Key() bool { return e.key }
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewUpdateRequestValidationError) ErrorName() string {
-	return "ReplyReviewUpdateRequestValidationError"
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewUpdateRequestValidationError) Error() string {
+func (e CreateWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2033,14 +14404,14 @@ func (e ReplyReviewUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewUpdateRequest.%s: %s%s",
+		"invalid %sCreateWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewUpdateRequestValidationError{}
+var _ error = CreateWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2048,48 +14419,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewUpdateRequestValidationError{}
+} = CreateWebhookRequestValidationError{}
 
-// Validate checks the field values on ReplyReviewUpdateReply with the rules
+var _CreateWebhookRequest_OwnerType_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on CreateWebhookReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewUpdateReply) Validate() error {
+func (m *CreateWebhookReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewUpdateReply with the rules
+// ValidateAll checks the field values on CreateWebhookReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewUpdateReplyMultiError, or nil if none found.
-func (m *ReplyReviewUpdateReply) ValidateAll() error {
+// CreateWebhookReplyMultiError, or nil if none found.
+func (m *CreateWebhookReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewUpdateReply) validate(all bool) error {
+func (m *CreateWebhookReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReplyID
+	// no validation rules for SubscriptionID
 
-	// no validation rules for Rowsaffected
+	// no validation rules for Secret
 
 	if len(errors) > 0 {
-		return ReplyReviewUpdateReplyMultiError(errors)
+		return CreateWebhookReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by ReplyReviewUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type ReplyReviewUpdateReplyMultiError []error
+// CreateWebhookReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateWebhookReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateWebhookReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewUpdateReplyMultiError) Error() string {
+func (m CreateWebhookReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2098,11 +14474,11 @@ func (m ReplyReviewUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewUpdateReplyMultiError) AllErrors() []error { return m }
+func (m CreateWebhookReplyMultiError) AllErrors() []error { return m }
 
-// ReplyReviewUpdateReplyValidationError is the validation error returned by
-// ReplyReviewUpdateReply.Validate if the designated constraints aren't met.
-type ReplyReviewUpdateReplyValidationError struct {
+// CreateWebhookReplyValidationError is the validation error returned by
+// CreateWebhookReply.Validate if the designated constraints aren't met.
+type CreateWebhookReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2110,24 +14486,24 @@ type ReplyReviewUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewUpdateReplyValidationError) Field() string { return e.field }
+func (e CreateWebhookReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewUpdateReplyValidationError) Reason() string { return e.reason }
+func (e CreateWebhookReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewUpdateReplyValidationError) Cause() error { return e.cause }
+func (e CreateWebhookReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewUpdateReplyValidationError) Key() bool { return e.key }
+func (e CreateWebhookReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewUpdateReplyValidationError) ErrorName() string {
-	return "ReplyReviewUpdateReplyValidationError"
+func (e CreateWebhookReplyValidationError) ErrorName() string {
+	return "CreateWebhookReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewUpdateReplyValidationError) Error() string {
+func (e CreateWebhookReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2139,14 +14515,14 @@ func (e ReplyReviewUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewUpdateReply.%s: %s%s",
+		"invalid %sCreateWebhookReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewUpdateReplyValidationError{}
+var _ error = CreateWebhookReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2154,34 +14530,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewUpdateReplyValidationError{}
+} = CreateWebhookReplyValidationError{}
 
-// Validate checks the field values on AppealReviewRequest with the rules
+// Validate checks the field values on DeleteWebhookRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AppealReviewRequest) Validate() error {
+func (m *DeleteWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppealReviewRequest with the rules
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AppealReviewRequestMultiError, or nil if none found.
-func (m *AppealReviewRequest) ValidateAll() error {
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppealReviewRequest) validate(all bool) error {
+func (m *DeleteWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := AppealReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
+	if _, ok := _DeleteWebhookRequest_OwnerType_InLookup[m.GetOwnerType()]; !ok {
+		err := DeleteWebhookRequestValidationError{
+			field:  "OwnerType",
+			reason: "value must be in list [1 2]",
 		}
 		if !all {
 			return err
@@ -2189,9 +14565,9 @@ func (m *AppealReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStoreID() <= 0 {
-		err := AppealReviewRequestValidationError{
-			field:  "StoreID",
+	if m.GetOwnerID() <= 0 {
+		err := DeleteWebhookRequestValidationError{
+			field:  "OwnerID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -2200,21 +14576,10 @@ func (m *AppealReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetReason()); l < 5 || l > 200 {
-		err := AppealReviewRequestValidationError{
-			field:  "Reason",
-			reason: "value length must be between 5 and 200 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 200 {
-		err := AppealReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 200 runes, inclusive",
+	if m.GetSubscriptionID() <= 0 {
+		err := DeleteWebhookRequestValidationError{
+			field:  "SubscriptionID",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -2222,24 +14587,20 @@ func (m *AppealReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
 	if len(errors) > 0 {
-		return AppealReviewRequestMultiError(errors)
+		return DeleteWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppealReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by AppealReviewRequest.ValidateAll() if the designated
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AppealReviewRequestMultiError []error
+type DeleteWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppealReviewRequestMultiError) Error() string {
+func (m DeleteWebhookRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2248,11 +14609,11 @@ func (m AppealReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppealReviewRequestMultiError) AllErrors() []error { return m }
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
 
-// AppealReviewRequestValidationError is the validation error returned by
-// AppealReviewRequest.Validate if the designated constraints aren't met.
-type AppealReviewRequestValidationError struct {
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2260,24 +14621,24 @@ type AppealReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppealReviewRequestValidationError) Field() string { return e.field }
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppealReviewRequestValidationError) Reason() string { return e.reason }
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppealReviewRequestValidationError) Cause() error { return e.cause }
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppealReviewRequestValidationError) Key() bool { return e.key }
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppealReviewRequestValidationError) ErrorName() string {
-	return "AppealReviewRequestValidationError"
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AppealReviewRequestValidationError) Error() string {
+func (e DeleteWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2289,14 +14650,14 @@ func (e AppealReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppealReviewRequest.%s: %s%s",
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppealReviewRequestValidationError{}
+var _ error = DeleteWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2304,46 +14665,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppealReviewRequestValidationError{}
+} = DeleteWebhookRequestValidationError{}
 
-// Validate checks the field values on AppealReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AppealReviewReply) Validate() error {
+var _DeleteWebhookRequest_OwnerType_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on DeleteWebhookReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppealReviewReply with the rules
+// ValidateAll checks the field values on DeleteWebhookReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AppealReviewReplyMultiError, or nil if none found.
-func (m *AppealReviewReply) ValidateAll() error {
+// DeleteWebhookReplyMultiError, or nil if none found.
+func (m *DeleteWebhookReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppealReviewReply) validate(all bool) error {
+func (m *DeleteWebhookReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppealID
-
 	if len(errors) > 0 {
-		return AppealReviewReplyMultiError(errors)
+		return DeleteWebhookReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppealReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by AppealReviewReply.ValidateAll() if the designated constraints
+// DeleteWebhookReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteWebhookReply.ValidateAll() if the designated constraints
 // aren't met.
-type AppealReviewReplyMultiError []error
+type DeleteWebhookReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppealReviewReplyMultiError) Error() string {
+func (m DeleteWebhookReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2352,11 +14716,11 @@ func (m AppealReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppealReviewReplyMultiError) AllErrors() []error { return m }
+func (m DeleteWebhookReplyMultiError) AllErrors() []error { return m }
 
-// AppealReviewReplyValidationError is the validation error returned by
-// AppealReviewReply.Validate if the designated constraints aren't met.
-type AppealReviewReplyValidationError struct {
+// DeleteWebhookReplyValidationError is the validation error returned by
+// DeleteWebhookReply.Validate if the designated constraints aren't met.
+type DeleteWebhookReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2364,24 +14728,24 @@ type AppealReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppealReviewReplyValidationError) Field() string { return e.field }
+func (e DeleteWebhookReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppealReviewReplyValidationError) Reason() string { return e.reason }
+func (e DeleteWebhookReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppealReviewReplyValidationError) Cause() error { return e.cause }
+func (e DeleteWebhookReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppealReviewReplyValidationError) Key() bool { return e.key }
+func (e DeleteWebhookReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppealReviewReplyValidationError) ErrorName() string {
-	return "AppealReviewReplyValidationError"
+func (e DeleteWebhookReplyValidationError) ErrorName() string {
+	return "DeleteWebhookReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AppealReviewReplyValidationError) Error() string {
+func (e DeleteWebhookReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2393,14 +14757,14 @@ func (e AppealReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppealReviewReply.%s: %s%s",
+		"invalid %sDeleteWebhookReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppealReviewReplyValidationError{}
+var _ error = DeleteWebhookReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2408,34 +14772,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppealReviewReplyValidationError{}
+} = DeleteWebhookReplyValidationError{}
 
-// Validate checks the field values on AuditReviewRequest with the rules
+// Validate checks the field values on ListWebhooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuditReviewRequest) Validate() error {
+func (m *ListWebhooksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditReviewRequest with the rules
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditReviewRequestMultiError, or nil if none found.
-func (m *AuditReviewRequest) ValidateAll() error {
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditReviewRequest) validate(all bool) error {
+func (m *ListWebhooksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := AuditReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
+	if _, ok := _ListWebhooksRequest_OwnerType_InLookup[m.GetOwnerType()]; !ok {
+		err := ListWebhooksRequestValidationError{
+			field:  "OwnerType",
+			reason: "value must be in list [1 2]",
 		}
 		if !all {
 			return err
@@ -2443,10 +14807,10 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _AuditReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
-		err := AuditReviewRequestValidationError{
-			field:  "Status",
-			reason: "value must be in list [10 20 30 40]",
+	if m.GetOwnerID() <= 0 {
+		err := ListWebhooksRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -2454,44 +14818,161 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
-		err := AuditReviewRequestValidationError{
-			field:  "OpUser",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return ListWebhooksRequestMultiError(errors)
 	}
 
-	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
-		err := AuditReviewRequestValidationError{
-			field:  "OpReason",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for OpRemarks
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksRequestValidationError{}
+
+var _ListWebhooksRequest_OwnerType_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on ListWebhooksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksReplyMultiError, or nil if none found.
+func (m *ListWebhooksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhooksReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhooksReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return AuditReviewRequestMultiError(errors)
+		return ListWebhooksReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by AuditReviewRequest.ValidateAll() if the designated constraints
+// ListWebhooksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListWebhooksReply.ValidateAll() if the designated constraints
 // aren't met.
-type AuditReviewRequestMultiError []error
+type ListWebhooksReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditReviewRequestMultiError) Error() string {
+func (m ListWebhooksReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2500,11 +14981,11 @@ func (m AuditReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditReviewRequestMultiError) AllErrors() []error { return m }
+func (m ListWebhooksReplyMultiError) AllErrors() []error { return m }
 
-// AuditReviewRequestValidationError is the validation error returned by
-// AuditReviewRequest.Validate if the designated constraints aren't met.
-type AuditReviewRequestValidationError struct {
+// ListWebhooksReplyValidationError is the validation error returned by
+// ListWebhooksReply.Validate if the designated constraints aren't met.
+type ListWebhooksReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2512,24 +14993,24 @@ type AuditReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditReviewRequestValidationError) Field() string { return e.field }
+func (e ListWebhooksReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditReviewRequestValidationError) Reason() string { return e.reason }
+func (e ListWebhooksReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditReviewRequestValidationError) Cause() error { return e.cause }
+func (e ListWebhooksReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditReviewRequestValidationError) Key() bool { return e.key }
+func (e ListWebhooksReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditReviewRequestValidationError) ErrorName() string {
-	return "AuditReviewRequestValidationError"
+func (e ListWebhooksReplyValidationError) ErrorName() string {
+	return "ListWebhooksReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuditReviewRequestValidationError) Error() string {
+func (e ListWebhooksReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2541,14 +15022,14 @@ func (e AuditReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditReviewRequest.%s: %s%s",
+		"invalid %sListWebhooksReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditReviewRequestValidationError{}
+var _ error = ListWebhooksReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2556,51 +15037,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditReviewRequestValidationError{}
-
-var _AuditReviewRequest_Status_InLookup = map[int32]struct{}{
-	10: {},
-	20: {},
-	30: {},
-	40: {},
-}
+} = ListWebhooksReplyValidationError{}
 
-// Validate checks the field values on AuditReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuditReviewReply) Validate() error {
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditReviewReplyMultiError, or nil if none found.
-func (m *AuditReviewReply) ValidateAll() error {
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditReviewReply) validate(all bool) error {
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if _, ok := _ListWebhookDeliveriesRequest_OwnerType_InLookup[m.GetOwnerType()]; !ok {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "OwnerType",
+			reason: "value must be in list [1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOwnerID() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubscriptionID() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "SubscriptionID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListWebhookDeliveriesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [0 10 20 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return AuditReviewReplyMultiError(errors)
+		return ListWebhookDeliveriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by AuditReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type AuditReviewReplyMultiError []error
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditReviewReplyMultiError) Error() string {
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2609,11 +15149,12 @@ func (m AuditReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditReviewReplyMultiError) AllErrors() []error { return m }
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
 
-// AuditReviewReplyValidationError is the validation error returned by
-// AuditReviewReply.Validate if the designated constraints aren't met.
-type AuditReviewReplyValidationError struct {
+// ListWebhookDeliveriesRequestValidationError is the validation error returned
+// by ListWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2621,22 +15162,24 @@ type AuditReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditReviewReplyValidationError) Field() string { return e.field }
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditReviewReplyValidationError) Reason() string { return e.reason }
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditReviewReplyValidationError) Cause() error { return e.cause }
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditReviewReplyValidationError) Key() bool { return e.key }
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditReviewReplyValidationError) ErrorName() string { return "AuditReviewReplyValidationError" }
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuditReviewReplyValidationError) Error() string {
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2648,14 +15191,14 @@ func (e AuditReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditReviewReply.%s: %s%s",
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditReviewReplyValidationError{}
+var _ error = ListWebhookDeliveriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2663,90 +15206,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditReviewReplyValidationError{}
+} = ListWebhookDeliveriesRequestValidationError{}
 
-// Validate checks the field values on AuditAppealRequest with the rules
+var _ListWebhookDeliveriesRequest_OwnerType_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+}
+
+var _ListWebhookDeliveriesRequest_Status_InLookup = map[int32]struct{}{
+	0:  {},
+	10: {},
+	20: {},
+	30: {},
+}
+
+// Validate checks the field values on WebhookDeliveryInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuditAppealRequest) Validate() error {
+func (m *WebhookDeliveryInfo) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditAppealRequest with the rules
+// ValidateAll checks the field values on WebhookDeliveryInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditAppealRequestMultiError, or nil if none found.
-func (m *AuditAppealRequest) ValidateAll() error {
+// WebhookDeliveryInfoMultiError, or nil if none found.
+func (m *WebhookDeliveryInfo) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditAppealRequest) validate(all bool) error {
+func (m *WebhookDeliveryInfo) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetAppealID() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "AppealID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for DeliveryID
 
-	if _, ok := _AuditAppealRequest_Status_InLookup[m.GetStatus()]; !ok {
-		err := AuditAppealRequestValidationError{
-			field:  "Status",
-			reason: "value must be in list [10 20 30 40]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Event
 
-	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
-		err := AuditAppealRequestValidationError{
-			field:  "OpUser",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Payload
 
-	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
-		err := AuditAppealRequestValidationError{
-			field:  "OpReason",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Status
 
-	// no validation rules for OpRemarks
+	// no validation rules for Attempts
+
+	// no validation rules for ResponseCode
+
+	// no validation rules for LastError
+
+	// no validation rules for NextRetryAt
+
+	// no validation rules for CreateAt
 
 	if len(errors) > 0 {
-		return AuditAppealRequestMultiError(errors)
+		return WebhookDeliveryInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditAppealRequestMultiError is an error wrapping multiple validation errors
-// returned by AuditAppealRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuditAppealRequestMultiError []error
+// WebhookDeliveryInfoMultiError is an error wrapping multiple validation
+// errors returned by WebhookDeliveryInfo.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookDeliveryInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditAppealRequestMultiError) Error() string {
+func (m WebhookDeliveryInfoMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2755,11 +15282,11 @@ func (m AuditAppealRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditAppealRequestMultiError) AllErrors() []error { return m }
+func (m WebhookDeliveryInfoMultiError) AllErrors() []error { return m }
 
-// AuditAppealRequestValidationError is the validation error returned by
-// AuditAppealRequest.Validate if the designated constraints aren't met.
-type AuditAppealRequestValidationError struct {
+// WebhookDeliveryInfoValidationError is the validation error returned by
+// WebhookDeliveryInfo.Validate if the designated constraints aren't met.
+type WebhookDeliveryInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2767,24 +15294,24 @@ type AuditAppealRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditAppealRequestValidationError) Field() string { return e.field }
+func (e WebhookDeliveryInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditAppealRequestValidationError) Reason() string { return e.reason }
+func (e WebhookDeliveryInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditAppealRequestValidationError) Cause() error { return e.cause }
+func (e WebhookDeliveryInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditAppealRequestValidationError) Key() bool { return e.key }
+func (e WebhookDeliveryInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditAppealRequestValidationError) ErrorName() string {
-	return "AuditAppealRequestValidationError"
+func (e WebhookDeliveryInfoValidationError) ErrorName() string {
+	return "WebhookDeliveryInfoValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuditAppealRequestValidationError) Error() string {
+func (e WebhookDeliveryInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2796,14 +15323,14 @@ func (e AuditAppealRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditAppealRequest.%s: %s%s",
+		"invalid %sWebhookDeliveryInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditAppealRequestValidationError{}
+var _ error = WebhookDeliveryInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -2811,51 +15338,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditAppealRequestValidationError{}
-
-var _AuditAppealRequest_Status_InLookup = map[int32]struct{}{
-	10: {},
-	20: {},
-	30: {},
-	40: {},
-}
+} = WebhookDeliveryInfoValidationError{}
 
-// Validate checks the field values on AuditAppealReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuditAppealReply) Validate() error {
+// Validate checks the field values on ListWebhookDeliveriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditAppealReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListWebhookDeliveriesReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditAppealReplyMultiError, or nil if none found.
-func (m *AuditAppealReply) ValidateAll() error {
+// ListWebhookDeliveriesReplyMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditAppealReply) validate(all bool) error {
+func (m *ListWebhookDeliveriesReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return AuditAppealReplyMultiError(errors)
+		return ListWebhookDeliveriesReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditAppealReplyMultiError is an error wrapping multiple validation errors
-// returned by AuditAppealReply.ValidateAll() if the designated constraints
-// aren't met.
-type AuditAppealReplyMultiError []error
+// ListWebhookDeliveriesReplyMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesReply.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditAppealReplyMultiError) Error() string {
+func (m ListWebhookDeliveriesReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2864,11 +15420,11 @@ func (m AuditAppealReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditAppealReplyMultiError) AllErrors() []error { return m }
+func (m ListWebhookDeliveriesReplyMultiError) AllErrors() []error { return m }
 
-// AuditAppealReplyValidationError is the validation error returned by
-// AuditAppealReply.Validate if the designated constraints aren't met.
-type AuditAppealReplyValidationError struct {
+// ListWebhookDeliveriesReplyValidationError is the validation error returned
+// by ListWebhookDeliveriesReply.Validate if the designated constraints aren't met.
+type ListWebhookDeliveriesReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2876,22 +15432,24 @@ type AuditAppealReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditAppealReplyValidationError) Field() string { return e.field }
+func (e ListWebhookDeliveriesReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditAppealReplyValidationError) Reason() string { return e.reason }
+func (e ListWebhookDeliveriesReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditAppealReplyValidationError) Cause() error { return e.cause }
+func (e ListWebhookDeliveriesReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditAppealReplyValidationError) Key() bool { return e.key }
+func (e ListWebhookDeliveriesReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditAppealReplyValidationError) ErrorName() string { return "AuditAppealReplyValidationError" }
+func (e ListWebhookDeliveriesReplyValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuditAppealReplyValidationError) Error() string {
+func (e ListWebhookDeliveriesReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2903,14 +15461,14 @@ func (e AuditAppealReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditAppealReply.%s: %s%s",
+		"invalid %sListWebhookDeliveriesReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditAppealReplyValidationError{}
+var _ error = ListWebhookDeliveriesReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2918,4 +15476,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditAppealReplyValidationError{}
+} = ListWebhookDeliveriesReplyValidationError{}
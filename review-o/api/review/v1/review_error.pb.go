@@ -31,6 +31,14 @@ const (
 	ErrorReason_INVALID_PARAMS  ErrorReason = 99
 	ErrorReason_ORDER_REVIEWED  ErrorReason = 100
 	ErrorReason_REVIEW_REPLIED  ErrorReason = 101
+	ErrorReason_REVIEW_VOTED    ErrorReason = 102
+	ErrorReason_REVIEW_REPORTED ErrorReason = 103
+	ErrorReason_TASK_CLAIMED    ErrorReason = 104
+	ErrorReason_NOT_FOUND       ErrorReason = 105
+	//snowflake机器ID租约丢失或时钟回拨，暂时不能生成ID
+	ErrorReason_ID_UNAVAILABLE ErrorReason = 106
+	//同一店铺排队和执行中的导出任务过多
+	ErrorReason_TOO_MANY_EXPORTS ErrorReason = 107
 )
 
 // Enum value maps for ErrorReason.
@@ -43,15 +51,27 @@ var (
 		99:  "INVALID_PARAMS",
 		100: "ORDER_REVIEWED",
 		101: "REVIEW_REPLIED",
+		102: "REVIEW_VOTED",
+		103: "REVIEW_REPORTED",
+		104: "TASK_CLAIMED",
+		105: "NOT_FOUND",
+		106: "ID_UNAVAILABLE",
+		107: "TOO_MANY_EXPORTS",
 	}
 	ErrorReason_value = map[string]int32{
-		"NEED_LOGIN":      0,
-		"DB_FAILED":       1,
-		"APPEAL_AUDITED":  97,
-		"REVIEW_APPEALED": 98,
-		"INVALID_PARAMS":  99,
-		"ORDER_REVIEWED":  100,
-		"REVIEW_REPLIED":  101,
+		"NEED_LOGIN":       0,
+		"DB_FAILED":        1,
+		"APPEAL_AUDITED":   97,
+		"REVIEW_APPEALED":  98,
+		"INVALID_PARAMS":   99,
+		"ORDER_REVIEWED":   100,
+		"REVIEW_REPLIED":   101,
+		"REVIEW_VOTED":     102,
+		"REVIEW_REPORTED":  103,
+		"TASK_CLAIMED":     104,
+		"NOT_FOUND":        105,
+		"ID_UNAVAILABLE":   106,
+		"TOO_MANY_EXPORTS": 107,
 	}
 )
 
@@ -89,7 +109,7 @@ var file_review_v1_review_error_proto_rawDesc = []byte{
 	0x65, 0x77, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
 	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2a, 0xbb, 0x01, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x74, 0x6f, 0x2a, 0xd1, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
 	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x0a, 0x4e, 0x45, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e,
 	0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x13, 0x0a, 0x09, 0x44, 0x42, 0x5f, 0x46,
 	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x18, 0x0a,
@@ -100,11 +120,21 @@ var file_review_v1_review_error_proto_rawDesc = []byte{
 	0x52, 0x41, 0x4d, 0x53, 0x10, 0x63, 0x12, 0x18, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
 	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10, 0x64, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
 	0x12, 0x18, 0x0a, 0x0e, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49,
-	0x45, 0x44, 0x10, 0x65, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03,
-	0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
-	0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76,
-	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x44, 0x10, 0x65, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x66, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x67, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a,
+	0x0c, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x68, 0x1a,
+	0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x13, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x69, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x49, 0x44,
+	0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x6a, 0x1a, 0x04,
+	0xa8, 0x45, 0xf7, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59,
+	0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x10, 0x6b, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03,
+	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -94,3 +94,75 @@ func IsReviewReplied(err error) bool {
 func ErrorReviewReplied(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ErrorReason_REVIEW_REPLIED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsReviewVoted(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REVIEW_VOTED.String() && e.Code == 400
+}
+
+func ErrorReviewVoted(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_REVIEW_VOTED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsReviewReported(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REVIEW_REPORTED.String() && e.Code == 400
+}
+
+func ErrorReviewReported(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_REVIEW_REPORTED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTaskClaimed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TASK_CLAIMED.String() && e.Code == 409
+}
+
+func ErrorTaskClaimed(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TASK_CLAIMED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsIdUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ID_UNAVAILABLE.String() && e.Code == 503
+}
+
+func ErrorIdUnavailable(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ErrorReason_ID_UNAVAILABLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTooManyExports(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOO_MANY_EXPORTS.String() && e.Code == 429
+}
+
+func ErrorTooManyExports(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_TOO_MANY_EXPORTS.String(), fmt.Sprintf(format, args...))
+}
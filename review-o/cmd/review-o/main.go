@@ -4,6 +4,8 @@ import (
 	"flag"
 	"os"
 
+	"review-common/health"
+	"review-common/telemetry"
 	"review-o/internal/conf"
 
 	"github.com/go-kratos/kratos/v2"
@@ -20,7 +22,8 @@ import (
 // go build -ldflags "-X main.Version=x.y.z"
 var (
 	// Name is the name of the compiled software.
-	Name string
+	//链路追踪里的服务名称
+	Name string = "review-o"
 	// Version is the version of the compiled software.
 	Version string
 	// flagconf is the config flag.
@@ -33,7 +36,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, h *health.Health, gs *grpc.Server, hs *http.Server) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,6 +46,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			h,
 		),
 	)
 }
@@ -74,7 +78,14 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Registry, bc.Data, logger)
+	//在创建server和client之前设置，tracing中间件创建时取全局TracerProvider
+	shutdown, err := telemetry.SetTracerProvider(bc.Trace, telemetry.Service{Name: Name, Version: Version, InstanceID: id})
+	if err != nil {
+		panic(err)
+	}
+	defer shutdown()
+
+	app, cleanup, err := wireApp(bc.Server, bc.Registry, bc.Data, bc.Moderation, bc.Health, bc.Client, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -23,20 +23,37 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	discovery := data.NewDiscovery(registry)
-	reviewClient := data.NewReviewServiceClient(discovery)
-	dataData, cleanup, err := data.NewData(confData, reviewClient, logger)
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, moderation *conf.Moderation, health *conf.Health, client *conf.Client, logger log.Logger) (*kratos.App, func(), error) {
+	apiClient, err := data.NewConsulClient(registry)
 	if err != nil {
 		return nil, nil, err
 	}
+	discovery := data.NewDiscovery(apiClient, logger)
+	clientConn, cleanup, err := data.NewReviewServiceConn(discovery, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisClient, err := data.NewRedisClient(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	probes := data.NewHealthProbes(clientConn, apiClient, redisClient)
+	healthHealth := server.NewHealth(health, probes, logger)
+	reviewClient := data.NewReviewServiceClient(clientConn)
+	dataData, cleanup2, err := data.NewData(confData, reviewClient, redisClient, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	operationRepo := data.NewOperationRepo(dataData, logger)
-	operationUsecase := biz.NewOperationUsecase(operationRepo, logger)
+	operationUsecase := biz.NewOperationUsecase(operationRepo, moderation, logger)
 	operationService := service.NewOperationService(operationUsecase)
-	grpcServer := server.NewGRPCServer(confServer, operationService, logger)
-	httpServer := server.NewHTTPServer(confServer, operationService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	grpcServer := server.NewGRPCServer(confServer, operationService, healthHealth, logger)
+	httpServer := server.NewHTTPServer(confServer, operationService, healthHealth, logger)
+	app := newApp(logger, healthHealth, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// Appeal 一轮申诉
+type Appeal struct {
+	AppealID  int64
+	ReviewID  int64
+	StoreID   int64
+	Round     int32
+	Status    int32
+	Reason    string
+	Content   string
+	PicInfo   string
+	VideoInfo string
+	OpRemarks string
+	CreateAt  time.Time
+	UpdateAt  time.Time
+}
+
+// AppealMessage 申诉沟通记录
+type AppealMessage struct {
+	MessageID  int64
+	AppealID   int64
+	SenderType int32 //1商家;2运营
+	Sender     string
+	Action     int32 //1提交;2补充材料;3要求补充;4审核;5撤回
+	Content    string
+	PicInfo    string
+	VideoInfo  string
+	CreateAt   time.Time
+}
+
+// AppealHistory 一条评价的历次申诉和沟通记录
+type AppealHistory struct {
+	Appeals  []*Appeal
+	Messages []*AppealMessage
+}
+
+// RequestAppealInfo 要求商家补充材料，和审核一样需要先认领，完成后释放认领
+func (uc *OperationUsecase) RequestAppealInfo(ctx context.Context, appealID int64, opUser string, content string) error {
+	uc.log.WithContext(ctx).Debugf("[biz] RequestAppealInfo, appealID:%v opUser:%v\n", appealID, opUser)
+	if err := uc.checkClaim(ctx, ItemAppeal, appealID, opUser); err != nil {
+		return err
+	}
+	if err := uc.repo.RequestAppealInfo(ctx, appealID, opUser, content); err != nil {
+		return err
+	}
+	uc.release(ctx, ItemAppeal, appealID, opUser)
+	return nil
+}
+
+// GetAppealHistory 查询评价的历次申诉和沟通记录
+func (uc *OperationUsecase) GetAppealHistory(ctx context.Context, reviewID int64) (*AppealHistory, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetAppealHistory, reviewID:%v\n", reviewID)
+	return uc.repo.GetAppealHistory(ctx, reviewID)
+}
@@ -0,0 +1,57 @@
+package biz
+
+import "context"
+
+// AuditResult 批量审核中单条记录的处理结果
+type AuditResult struct {
+	ID  int64
+	Err error
+}
+
+// BatchAuditReviews 批量审核评价，被其他运营认领的评价直接返回TASK_CLAIMED，其余一次发给review-service
+func (uc *OperationUsecase) BatchAuditReviews(ctx context.Context, ids []int64, param *AuditReviewParam) ([]*AuditResult, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchAuditReviews, ids:%v\n", ids)
+	return uc.batchAudit(ctx, ItemReview, ids, param.OpUser, func(ids []int64) ([]*AuditResult, error) {
+		return uc.repo.BatchAuditReviews(ctx, ids, param)
+	})
+}
+
+// BatchAuditAppeals 批量审核申诉
+func (uc *OperationUsecase) BatchAuditAppeals(ctx context.Context, ids []int64, param *AuditAppealParam) ([]*AuditResult, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchAuditAppeals, ids:%v\n", ids)
+	return uc.batchAudit(ctx, ItemAppeal, ids, param.OpUser, func(ids []int64) ([]*AuditResult, error) {
+		return uc.repo.BatchAuditAppeals(ctx, ids, param)
+	})
+}
+
+// batchAudit 先按认领状态过滤，审核成功的记录释放认领，结果按请求顺序返回
+func (uc *OperationUsecase) batchAudit(ctx context.Context, item string, ids []int64, opUser string, audit func([]int64) ([]*AuditResult, error)) ([]*AuditResult, error) {
+	results := make(map[int64]*AuditResult, len(ids))
+	todo := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if err := uc.checkClaim(ctx, item, id, opUser); err != nil {
+			results[id] = &AuditResult{ID: id, Err: err}
+			continue
+		}
+		todo = append(todo, id)
+	}
+	if len(todo) > 0 {
+		ret, err := audit(todo)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range ret {
+			results[r.ID] = r
+			if r.Err == nil {
+				uc.release(ctx, item, r.ID, opUser)
+			}
+		}
+	}
+	list := make([]*AuditResult, 0, len(ids))
+	for _, id := range ids {
+		if r, ok := results[id]; ok {
+			list = append(list, r)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,77 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	v1 "review-o/api/review/v1"
+	"review-o/internal/conf"
+	"testing"
+	"time"
+)
+
+// BatchAuditReviews review-service只返回发过去的记录的结果，顺序不保证
+func (f *fakeRepo) BatchAuditReviews(_ context.Context, ids []int64, _ *AuditReviewParam) ([]*AuditResult, error) {
+	f.audited = append(f.audited, ids...)
+	ret := make([]*AuditResult, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		ret = append(ret, &AuditResult{ID: ids[i], Err: f.auditErr[ids[i]]})
+	}
+	return ret, nil
+}
+
+func TestBatchAuditReviews(t *testing.T) {
+	convey.Convey("claimed items are skipped and results keep the request order", t, func() {
+		repo := &fakeRepo{
+			claims: map[int64]*Claim{
+				2: {OpUser: "bob", ExpireAt: time.Now().Add(time.Minute)},
+				3: {OpUser: "alice", ExpireAt: time.Now().Add(time.Minute)},
+				4: {OpUser: "alice", ExpireAt: time.Now().Add(time.Minute)},
+			},
+			auditErr: map[int64]error{4: v1.ErrorDbFailed("没有这个评价ID！")},
+		}
+		uc := NewOperationUsecase(repo, &conf.Moderation{}, log.DefaultLogger)
+
+		ret, err := uc.BatchAuditReviews(context.Background(), []int64{1, 2, 3, 4}, &AuditReviewParam{OpUser: "alice", Status: 20})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(repo.audited, convey.ShouldResemble, []int64{1, 3, 4})
+		convey.So(len(ret), convey.ShouldEqual, 4)
+		for i, id := range []int64{1, 2, 3, 4} {
+			convey.So(ret[i].ID, convey.ShouldEqual, id)
+		}
+		convey.So(ret[0].Err, convey.ShouldBeNil)
+		convey.So(v1.IsTaskClaimed(ret[1].Err), convey.ShouldBeTrue)
+		convey.So(ret[2].Err, convey.ShouldBeNil)
+		convey.So(v1.IsDbFailed(ret[3].Err), convey.ShouldBeTrue)
+		//只释放审核成功的认领
+		convey.So(repo.released, convey.ShouldResemble, []int64{3, 1})
+		convey.So(repo.claims[3], convey.ShouldBeNil)
+		convey.So(repo.claims[4].OpUser, convey.ShouldEqual, "alice")
+		convey.So(repo.claims[2].OpUser, convey.ShouldEqual, "bob")
+
+		//全部被别人认领时不调用review-service
+		repo.audited = nil
+		ret, err = uc.BatchAuditReviews(context.Background(), []int64{2}, &AuditReviewParam{OpUser: "alice", Status: 20})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(repo.audited, convey.ShouldBeEmpty)
+		convey.So(v1.IsTaskClaimed(ret[0].Err), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("a failed batch call fails the whole request", t, func() {
+		repo := &failingRepo{fakeRepo: fakeRepo{claims: map[int64]*Claim{}}}
+		uc := NewOperationUsecase(repo, &conf.Moderation{}, log.DefaultLogger)
+		_, err := uc.BatchAuditReviews(context.Background(), []int64{1}, &AuditReviewParam{OpUser: "alice", Status: 20})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(repo.released, convey.ShouldBeEmpty)
+	})
+}
+
+// failingRepo review-service整体调用失败
+type failingRepo struct {
+	fakeRepo
+}
+
+func (f *failingRepo) BatchAuditReviews(context.Context, []int64, *AuditReviewParam) ([]*AuditResult, error) {
+	return nil, errors.New("unavailable")
+}
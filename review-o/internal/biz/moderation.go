@@ -0,0 +1,216 @@
+package biz
+
+import (
+	"context"
+	v1 "review-o/api/review/v1"
+	"time"
+)
+
+// 认领的记录类型
+const (
+	ItemReview = "review"
+	ItemAppeal = "appeal"
+)
+
+const (
+	defaultClaimTTL  = 10 * time.Minute
+	defaultReviewSLA = 24 * time.Hour
+	defaultAppealSLA = 48 * time.Hour
+)
+
+// PendingFilter 待审核队列的筛选条件，申诉只支持StoreID和MinAge
+type PendingFilter struct {
+	StoreID        int64
+	HasMedia       int32 //0不限;1有图或视频;2无
+	MinReportCount int32
+	MinAge         time.Duration //只看等待超过该时长的记录
+	Page           int32
+	Size           int32
+}
+
+// Claim 运营对一条待审核记录的认领
+type Claim struct {
+	OpUser   string
+	ExpireAt time.Time
+}
+
+type PendingReview struct {
+	ReviewID    int64
+	StoreID     int64
+	UserID      int64
+	OrderID     int64
+	Score       int32
+	Content     string
+	PicInfo     string
+	VideoInfo   string
+	HasMedia    int32
+	ReportCount int32
+	Anonymous   bool
+	CreateAt    time.Time
+
+	Age         time.Duration
+	SlaBreached bool
+	Claim       *Claim
+}
+
+type PendingAppeal struct {
+	AppealID  int64
+	ReviewID  int64
+	StoreID   int64
+	Reason    string
+	Content   string
+	PicInfo   string
+	VideoInfo string
+	CreateAt  time.Time
+
+	Age         time.Duration
+	SlaBreached bool
+	Claim       *Claim
+}
+
+// SlaStats 符合筛选条件的全部待审核记录的时效统计
+type SlaStats struct {
+	SLA       time.Duration
+	Total     int64
+	Breached  int64         //已超出处理时限的条数
+	OldestAge time.Duration //等待最久的记录已等待的时长
+}
+
+type PendingReviews struct {
+	List []*PendingReview
+	SlaStats
+	OldestCreateAt time.Time
+}
+
+type PendingAppeals struct {
+	List []*PendingAppeal
+	SlaStats
+	OldestCreateAt time.Time
+}
+
+// ListPendingReviews 查询待审核评价，附带认领状态和SLA时效
+func (uc *OperationUsecase) ListPendingReviews(ctx context.Context, filter *PendingFilter) (*PendingReviews, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListPendingReviews, filter:%v\n", filter)
+	ret, err := uc.repo.ListPendingReviews(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	//超时条数用同样的条件再查一次总数，等待时长下限取筛选值和SLA中较大的一个
+	breached, err := uc.repo.ListPendingReviews(ctx, breachedFilter(filter, uc.reviewSLA))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ret.SLA = uc.reviewSLA
+	ret.Breached = breached.Total
+	ret.OldestAge = age(now, ret.OldestCreateAt)
+	ids := make([]int64, 0, len(ret.List))
+	for _, r := range ret.List {
+		ids = append(ids, r.ReviewID)
+	}
+	claims := uc.getClaims(ctx, ItemReview, ids)
+	for _, r := range ret.List {
+		r.Age = age(now, r.CreateAt)
+		r.SlaBreached = r.Age > uc.reviewSLA
+		r.Claim = claims[r.ReviewID]
+	}
+	return ret, nil
+}
+
+// ListPendingAppeals 查询待审核申诉，附带认领状态和SLA时效
+func (uc *OperationUsecase) ListPendingAppeals(ctx context.Context, filter *PendingFilter) (*PendingAppeals, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListPendingAppeals, filter:%v\n", filter)
+	ret, err := uc.repo.ListPendingAppeals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	breached, err := uc.repo.ListPendingAppeals(ctx, breachedFilter(filter, uc.appealSLA))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ret.SLA = uc.appealSLA
+	ret.Breached = breached.Total
+	ret.OldestAge = age(now, ret.OldestCreateAt)
+	ids := make([]int64, 0, len(ret.List))
+	for _, a := range ret.List {
+		ids = append(ids, a.AppealID)
+	}
+	claims := uc.getClaims(ctx, ItemAppeal, ids)
+	for _, a := range ret.List {
+		a.Age = age(now, a.CreateAt)
+		a.SlaBreached = a.Age > uc.appealSLA
+		a.Claim = claims[a.AppealID]
+	}
+	return ret, nil
+}
+
+// ClaimItem 运营认领一条待审核记录，已被他人认领且未过期时返回TASK_CLAIMED
+// 自己重复认领会续期
+func (uc *OperationUsecase) ClaimItem(ctx context.Context, item string, id int64, opUser string) (*Claim, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ClaimItem, item:%v id:%v opUser:%v\n", item, id, opUser)
+	claim, err := uc.repo.Claim(ctx, item, id, opUser, uc.claimTTL)
+	if err != nil {
+		return nil, err
+	}
+	if claim.OpUser != opUser {
+		return nil, v1.ErrorTaskClaimed("已被%s认领，%s后释放", claim.OpUser, claim.ExpireAt.Format("2006-01-02 15:04:05"))
+	}
+	return claim, nil
+}
+
+// ReleaseItem 运营放弃认领，只能释放自己的认领
+func (uc *OperationUsecase) ReleaseItem(ctx context.Context, item string, id int64, opUser string) error {
+	uc.log.WithContext(ctx).Debugf("[biz] ReleaseItem, item:%v id:%v opUser:%v\n", item, id, opUser)
+	return uc.repo.Release(ctx, item, id, opUser)
+}
+
+// checkClaim 审核前校验记录没有被其他运营认领
+// redis不可用时不阻塞审核
+func (uc *OperationUsecase) checkClaim(ctx context.Context, item string, id int64, opUser string) error {
+	claims, err := uc.repo.GetClaims(ctx, item, []int64{id})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("get claim failed, item:%v id:%v err:%v", item, id, err)
+		return nil
+	}
+	if c, ok := claims[id]; ok && c.OpUser != opUser {
+		return v1.ErrorTaskClaimed("已被%s认领", c.OpUser)
+	}
+	return nil
+}
+
+// release 审核完成后释放认领，失败了等租约自然过期
+func (uc *OperationUsecase) release(ctx context.Context, item string, id int64, opUser string) {
+	if err := uc.repo.Release(ctx, item, id, opUser); err != nil {
+		uc.log.WithContext(ctx).Errorf("release claim failed, item:%v id:%v err:%v", item, id, err)
+	}
+}
+
+// getClaims 列表展示用，查询失败时不展示认领状态
+func (uc *OperationUsecase) getClaims(ctx context.Context, item string, ids []int64) map[int64]*Claim {
+	if len(ids) == 0 {
+		return nil
+	}
+	claims, err := uc.repo.GetClaims(ctx, item, ids)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("get claims failed, item:%v err:%v", item, err)
+		return nil
+	}
+	return claims
+}
+
+func breachedFilter(filter *PendingFilter, sla time.Duration) *PendingFilter {
+	f := *filter
+	if f.MinAge < sla {
+		f.MinAge = sla
+	}
+	f.Page, f.Size = 1, 1
+	return &f
+}
+
+func age(now time.Time, createAt time.Time) time.Duration {
+	if createAt.IsZero() {
+		return 0
+	}
+	return now.Sub(createAt)
+}
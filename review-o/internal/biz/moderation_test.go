@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/durationpb"
+	v1 "review-o/api/review/v1"
+	"review-o/internal/conf"
+	"testing"
+	"time"
+)
+
+// fakeRepo 只实现用到的方法，其余方法调用时panic
+type fakeRepo struct {
+	OperationRepo
+
+	pending   []*PendingReview
+	filters   []PendingFilter
+	claims    map[int64]*Claim
+	claimsErr error
+	released  []int64
+
+	audited  []int64
+	auditErr map[int64]error
+}
+
+// ListPendingReviews 按MinAge筛选，Total是筛选后的条数
+func (f *fakeRepo) ListPendingReviews(_ context.Context, filter *PendingFilter) (*PendingReviews, error) {
+	f.filters = append(f.filters, *filter)
+	ret := &PendingReviews{}
+	for _, r := range f.pending {
+		if filter.MinAge > 0 && time.Since(r.CreateAt) < filter.MinAge {
+			continue
+		}
+		if ret.OldestCreateAt.IsZero() || r.CreateAt.Before(ret.OldestCreateAt) {
+			ret.OldestCreateAt = r.CreateAt
+		}
+		ret.Total++
+		ret.List = append(ret.List, &PendingReview{ReviewID: r.ReviewID, CreateAt: r.CreateAt})
+	}
+	return ret, nil
+}
+
+func (f *fakeRepo) Claim(_ context.Context, _ string, id int64, opUser string, ttl time.Duration) (*Claim, error) {
+	if c, ok := f.claims[id]; ok && c.OpUser != opUser {
+		return c, nil
+	}
+	c := &Claim{OpUser: opUser, ExpireAt: time.Now().Add(ttl)}
+	f.claims[id] = c
+	return c, nil
+}
+
+func (f *fakeRepo) Release(_ context.Context, _ string, id int64, opUser string) error {
+	f.released = append(f.released, id)
+	if c, ok := f.claims[id]; ok && c.OpUser == opUser {
+		delete(f.claims, id)
+	}
+	return nil
+}
+
+func (f *fakeRepo) GetClaims(_ context.Context, _ string, ids []int64) (map[int64]*Claim, error) {
+	if f.claimsErr != nil {
+		return nil, f.claimsErr
+	}
+	ret := make(map[int64]*Claim)
+	for _, id := range ids {
+		if c, ok := f.claims[id]; ok {
+			ret[id] = c
+		}
+	}
+	return ret, nil
+}
+
+func TestListPendingReviews(t *testing.T) {
+	convey.Convey("pending reviews carry claims and SLA age", t, func() {
+		now := time.Now()
+		repo := &fakeRepo{
+			pending: []*PendingReview{
+				{ReviewID: 1, CreateAt: now.Add(-30 * time.Hour)},
+				{ReviewID: 2, CreateAt: now.Add(-2 * time.Hour)},
+				{ReviewID: 3, CreateAt: now.Add(-time.Hour)},
+			},
+			claims: map[int64]*Claim{2: {OpUser: "alice", ExpireAt: now.Add(time.Minute)}},
+		}
+		uc := NewOperationUsecase(repo, &conf.Moderation{ReviewSla: durationpb.New(24 * time.Hour)}, log.DefaultLogger)
+
+		ret, err := uc.ListPendingReviews(context.Background(), &PendingFilter{MinAge: 90 * time.Minute, Page: 2, Size: 20})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ret.Total, convey.ShouldEqual, 2)
+		convey.So(ret.SLA, convey.ShouldEqual, 24*time.Hour)
+		convey.So(ret.Breached, convey.ShouldEqual, 1)
+		convey.So(ret.OldestAge, convey.ShouldBeGreaterThanOrEqualTo, 30*time.Hour)
+		convey.So(ret.List[0].SlaBreached, convey.ShouldBeTrue)
+		convey.So(ret.List[0].Claim, convey.ShouldBeNil)
+		convey.So(ret.List[1].SlaBreached, convey.ShouldBeFalse)
+		convey.So(ret.List[1].Claim.OpUser, convey.ShouldEqual, "alice")
+		//超时条数沿用筛选条件，等待时长下限取SLA
+		convey.So(repo.filters[1], convey.ShouldResemble, PendingFilter{MinAge: 24 * time.Hour, Page: 1, Size: 1})
+
+		//查不到认领状态时照常返回列表
+		repo.claimsErr = errors.New("redis down")
+		ret, err = uc.ListPendingReviews(context.Background(), &PendingFilter{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(ret.List), convey.ShouldEqual, 3)
+		convey.So(ret.List[1].Claim, convey.ShouldBeNil)
+	})
+}
+
+func TestClaimItem(t *testing.T) {
+	convey.Convey("an item claimed by another operator can not be claimed or audited", t, func() {
+		repo := &fakeRepo{claims: map[int64]*Claim{}}
+		uc := NewOperationUsecase(repo, &conf.Moderation{}, log.DefaultLogger)
+		ctx := context.Background()
+
+		claim, err := uc.ClaimItem(ctx, ItemReview, 1, "alice")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(time.Until(claim.ExpireAt), convey.ShouldBeGreaterThan, defaultClaimTTL-time.Second)
+		_, err = uc.ClaimItem(ctx, ItemReview, 1, "bob")
+		convey.So(v1.IsTaskClaimed(err), convey.ShouldBeTrue)
+		convey.So(v1.IsTaskClaimed(uc.checkClaim(ctx, ItemReview, 1, "bob")), convey.ShouldBeTrue)
+		convey.So(uc.checkClaim(ctx, ItemReview, 1, "alice"), convey.ShouldBeNil)
+
+		//别人释放不掉，自己释放后别人可以认领
+		convey.So(uc.ReleaseItem(ctx, ItemReview, 1, "bob"), convey.ShouldBeNil)
+		_, err = uc.ClaimItem(ctx, ItemReview, 1, "bob")
+		convey.So(v1.IsTaskClaimed(err), convey.ShouldBeTrue)
+		convey.So(uc.ReleaseItem(ctx, ItemReview, 1, "alice"), convey.ShouldBeNil)
+		_, err = uc.ClaimItem(ctx, ItemReview, 1, "bob")
+		convey.So(err, convey.ShouldBeNil)
+
+		//redis不可用时不阻塞审核
+		repo.claimsErr = errors.New("redis down")
+		convey.So(uc.checkClaim(ctx, ItemReview, 1, "alice"), convey.ShouldBeNil)
+	})
+}
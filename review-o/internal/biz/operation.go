@@ -3,6 +3,8 @@ package biz
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"review-o/internal/conf"
+	"time"
 )
 
 type AuditAppealParam struct {
@@ -21,29 +23,101 @@ type AuditReviewParam struct {
 	Status    int32
 }
 
+type ReasonCount struct {
+	Reason int32
+	Count  int64
+}
+
+type ReportedReview struct {
+	ReviewID    int64
+	StoreID     int64
+	UserID      int64
+	Content     string
+	PicInfo     string
+	VideoInfo   string
+	Status      int32
+	ReportCount int32
+	Reasons     []*ReasonCount
+}
+
 // GreeterRepo is a Greater repo.
 type OperationRepo interface {
 	AuditAppeal(ctx context.Context, param *AuditAppealParam) (int64, error)
 	AuditReview(ctx context.Context, param *AuditReviewParam) (int64, error)
+	BatchAuditAppeals(ctx context.Context, ids []int64, param *AuditAppealParam) ([]*AuditResult, error)
+	BatchAuditReviews(ctx context.Context, ids []int64, param *AuditReviewParam) ([]*AuditResult, error)
+	ListReportedReviews(ctx context.Context, page int32, size int32) ([]*ReportedReview, error)
+
+	ListPendingReviews(ctx context.Context, filter *PendingFilter) (*PendingReviews, error)
+	ListPendingAppeals(ctx context.Context, filter *PendingFilter) (*PendingAppeals, error)
+
+	Claim(ctx context.Context, item string, id int64, opUser string, ttl time.Duration) (*Claim, error)
+	Release(ctx context.Context, item string, id int64, opUser string) error
+	GetClaims(ctx context.Context, item string, ids []int64) (map[int64]*Claim, error)
+
+	RequestAppealInfo(ctx context.Context, appealID int64, opUser string, content string) error
+	GetAppealHistory(ctx context.Context, reviewID int64) (*AppealHistory, error)
 }
 
 // OprationUsecase is a Greeter usecase.
 type OperationUsecase struct {
 	repo OperationRepo
 	log  *log.Helper
+
+	claimTTL  time.Duration
+	reviewSLA time.Duration
+	appealSLA time.Duration
 }
 
 // NewGreeterUsecase new a Greeter usecase.
-func NewOperationUsecase(repo OperationRepo, logger log.Logger) *OperationUsecase {
-	return &OperationUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewOperationUsecase(repo OperationRepo, c *conf.Moderation, logger log.Logger) *OperationUsecase {
+	uc := &OperationUsecase{
+		repo:      repo,
+		log:       log.NewHelper(logger),
+		claimTTL:  c.GetClaimTtl().AsDuration(),
+		reviewSLA: c.GetReviewSla().AsDuration(),
+		appealSLA: c.GetAppealSla().AsDuration(),
+	}
+	if uc.claimTTL <= 0 {
+		uc.claimTTL = defaultClaimTTL
+	}
+	if uc.reviewSLA <= 0 {
+		uc.reviewSLA = defaultReviewSLA
+	}
+	if uc.appealSLA <= 0 {
+		uc.appealSLA = defaultAppealSLA
+	}
+	return uc
 }
 
 func (uc *OperationUsecase) Audit(ctx context.Context, param *AuditAppealParam) (int64, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] Audit, replyID:%v\n", param.AppealID)
-	return uc.repo.AuditAppeal(ctx, param)
+	if err := uc.checkClaim(ctx, ItemAppeal, param.AppealID, param.OpUser); err != nil {
+		return 0, err
+	}
+	id, err := uc.repo.AuditAppeal(ctx, param)
+	if err != nil {
+		return 0, err
+	}
+	uc.release(ctx, ItemAppeal, param.AppealID, param.OpUser)
+	return id, nil
 }
 
 func (uc *OperationUsecase) AuditReview(ctx context.Context, param *AuditReviewParam) (int64, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] AuditReview, replyID:%v\n", param.ReviewID)
-	return uc.repo.AuditReview(ctx, param)
+	if err := uc.checkClaim(ctx, ItemReview, param.ReviewID, param.OpUser); err != nil {
+		return 0, err
+	}
+	id, err := uc.repo.AuditReview(ctx, param)
+	if err != nil {
+		return 0, err
+	}
+	uc.release(ctx, ItemReview, param.ReviewID, param.OpUser)
+	return id, nil
+}
+
+// ListReportedReviews 查询被举报的评价，service层调用这个方法
+func (uc *OperationUsecase) ListReportedReviews(ctx context.Context, page int32, size int32) ([]*ReportedReview, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReportedReviews, page:%v size:%v\n", page, size)
+	return uc.repo.ListReportedReviews(ctx, page, size)
 }
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	v1 "review-o/api/review/v1"
+	"review-o/internal/biz"
+	"time"
+)
+
+func (r *operationRepo) RequestAppealInfo(ctx context.Context, appealID int64, opUser string, content string) error {
+	r.log.WithContext(ctx).Infof("[data]RequestAppealInfo,appealID:%v opUser:%v\n", appealID, opUser)
+	_, err := r.data.rc.RequestAppealInfo(ctx, &v1.RequestAppealInfoRequest{
+		AppealID: appealID,
+		OpUser:   opUser,
+		Content:  content,
+	})
+	return err
+}
+
+func (r *operationRepo) GetAppealHistory(ctx context.Context, reviewID int64) (*biz.AppealHistory, error) {
+	r.log.WithContext(ctx).Infof("[data]GetAppealHistory,reviewID:%v\n", reviewID)
+	reply, err := r.data.rc.GetAppealHistory(ctx, &v1.GetAppealHistoryRequest{ReviewID: reviewID})
+	if err != nil {
+		return nil, err
+	}
+	history := &biz.AppealHistory{
+		Appeals:  make([]*biz.Appeal, 0, len(reply.Appeals)),
+		Messages: make([]*biz.AppealMessage, 0, len(reply.Messages)),
+	}
+	for _, a := range reply.Appeals {
+		history.Appeals = append(history.Appeals, &biz.Appeal{
+			AppealID:  a.GetAppealID(),
+			ReviewID:  a.GetReviewID(),
+			StoreID:   a.GetStoreID(),
+			Round:     a.GetRound(),
+			Status:    a.GetStatus(),
+			Reason:    a.GetReason(),
+			Content:   a.GetContent(),
+			PicInfo:   a.GetPicInfo(),
+			VideoInfo: a.GetVideoInfo(),
+			OpRemarks: a.GetOpRemarks(),
+			CreateAt:  time.Unix(a.GetCreateAt(), 0),
+			UpdateAt:  time.Unix(a.GetUpdateAt(), 0),
+		})
+	}
+	for _, m := range reply.Messages {
+		history.Messages = append(history.Messages, &biz.AppealMessage{
+			MessageID:  m.GetMessageID(),
+			AppealID:   m.GetAppealID(),
+			SenderType: m.GetSenderType(),
+			Sender:     m.GetSender(),
+			Action:     m.GetAction(),
+			Content:    m.GetContent(),
+			PicInfo:    m.GetPicInfo(),
+			VideoInfo:  m.GetVideoInfo(),
+			CreateAt:   time.Unix(m.GetCreateAt(), 0),
+		})
+	}
+	return history, nil
+}
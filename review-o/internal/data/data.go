@@ -2,65 +2,126 @@ package data
 
 import (
 	"context"
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/hashicorp/consul/api"
+	"github.com/redis/go-redis/v9"
+	ggrpc "google.golang.org/grpc"
+	"review-common/client"
 	v1 "review-o/api/review/v1"
 	"review-o/internal/conf"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewOperationRepo, NewReviewServiceClient, NewDiscovery)
+var ProviderSet = wire.NewSet(NewData, NewOperationRepo, NewReviewServiceConn, NewReviewServiceClient, NewConsulClient, NewDiscovery, NewHealthProbes, NewRedisClient)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
 	//嵌入一个gRPC client端，通过它调用review-service端
-	rc  v1.ReviewClient
+	rc v1.ReviewClient
+	//运营认领待审核记录的租约存在redis里
+	rdb *redis.Client
 	log *log.Helper
 }
 
 // NewData .
-func NewData(c *conf.Data, rc v1.ReviewClient, logger log.Logger) (*Data, func(), error) {
+func NewData(c *conf.Data, rc v1.ReviewClient, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Errorf("close redis failed, err:%v", err)
+		}
 	}
-	return &Data{rc: rc, log: log.NewHelper(logger)}, cleanup, nil
+	return &Data{rc: rc, rdb: rdb, log: log.NewHelper(logger)}, cleanup, nil
 }
 
-// 服务发现对象的构造函数
-func NewDiscovery(conf *conf.Registry) registry.Discovery {
+func NewRedisClient(cfg *conf.Data) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Network:      cfg.Redis.GetNetwork(),
+		Addr:         cfg.Redis.GetAddr(),
+		ReadTimeout:  cfg.Redis.GetReadTimeout().AsDuration(),
+		WriteTimeout: cfg.Redis.GetWriteTimeout().AsDuration(),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+	return rdb, nil
+}
+
+func NewConsulClient(conf *conf.Registry) (*api.Client, error) {
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
 	c.Scheme = conf.Consul.Scheme
-	client, err := api.NewClient(c)
-	if err != nil {
-		panic(err)
-	}
-	dis := consul.New(client, consul.WithHealthCheck(true))
-	return dis
+	return api.NewClient(c)
 }
 
 // 创建一个链接review-service的Grpc client端
-func NewReviewServiceClient(d registry.Discovery) v1.ReviewClient {
+// 连接不阻塞，consul或review-service暂时不可用时先启动，由健康检查报告未就绪
+func NewReviewServiceConn(d registry.Discovery, c *conf.Client) (*ggrpc.ClientConn, func(), error) {
+	if err := client.SetBalancer(c.GetBalancer()); err != nil {
+		return nil, nil, err
+	}
+	ms := []middleware.Middleware{
+		recovery.Recovery(),
+		//把链路上下文带给review-service
+		tracing.Client(),
+	}
+	if c.GetBreaker().GetEnable() {
+		ms = append(ms, client.Breaker(client.BreakerConfig{
+			Success: c.GetBreaker().GetSuccess(),
+			Request: c.GetBreaker().GetRequest(),
+			Window:  c.GetBreaker().GetWindow().AsDuration(),
+		}))
+	}
+	ms = append(ms,
+		validate.Validator(),
+		//告知review-service调用方是O端运营，用于匿名评价脱敏
+		client.SignRole("operator", c.GetRoleSecret()),
+	)
 	conn, err := grpc.DialInsecure(
 		context.Background(),
 		grpc.WithEndpoint("discovery:///review-service"),
 		grpc.WithDiscovery(d),
-		grpc.WithMiddleware(
-			recovery.Recovery(),
-			validate.Validator(),
-		),
+		grpc.WithMiddleware(ms...),
+		//超时由resilience按方法设置
+		grpc.WithTimeout(0),
+		grpc.WithUnaryInterceptor(client.UnaryInterceptor(clientConfig(c))),
 		//grpc.WithEndpoint("127.0.0.1:9092"),
 	)
 	if err != nil {
-		panic(err)
+		return nil, nil, err
+	}
+	return conn, func() { conn.Close() }, nil
+}
+
+// clientConfig 调用超时和重试配置
+func clientConfig(c *conf.Client) client.Config {
+	cfg := client.Config{
+		Timeout:        c.GetTimeout().AsDuration(),
+		MethodTimeouts: make(map[string]time.Duration, len(c.GetMethodTimeouts())),
+		MaxRetries:     int(c.GetRetry().GetMaxRetries()),
+		PerTryTimeout:  c.GetRetry().GetPerTryTimeout().AsDuration(),
+		Backoff:        c.GetRetry().GetBackoff().AsDuration(),
+		HedgeDelay:     c.GetRetry().GetHedgeDelay().AsDuration(),
+		BudgetRatio:    c.GetRetry().GetBudgetRatio(),
 	}
+	for name, d := range c.GetMethodTimeouts() {
+		cfg.MethodTimeouts[name] = d.AsDuration()
+	}
+	return cfg
+}
+
+func NewReviewServiceClient(conn *ggrpc.ClientConn) v1.ReviewClient {
 	return v1.NewReviewClient(conn)
 }
@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	v1 "review-o/api/review/v1"
+	"review-o/internal/biz"
+	"time"
+)
+
+// claimScript 没人认领或自己认领时写入/续期，否则返回当前认领人和剩余毫秒
+var claimScript = redis.NewScript(`
+local v = redis.call('GET', KEYS[1])
+if v == false or v == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return {ARGV[1], tonumber(ARGV[2])}
+end
+return {v, redis.call('PTTL', KEYS[1])}
+`)
+
+// releaseScript 只删除自己的认领
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+func claimKey(item string, id int64) string {
+	return fmt.Sprintf("operation:claim:%s:%d", item, id)
+}
+
+func (r *operationRepo) ListPendingReviews(ctx context.Context, filter *biz.PendingFilter) (*biz.PendingReviews, error) {
+	r.log.WithContext(ctx).Infof("[data]ListPendingReviews,filter:%v\n", filter)
+	reply, err := r.data.rc.ListPendingReviews(ctx, &v1.ListPendingReviewsRequest{
+		StoreID:        filter.StoreID,
+		HasMedia:       filter.HasMedia,
+		MinReportCount: filter.MinReportCount,
+		CreatedBefore:  createdBefore(filter.MinAge),
+		Page:           filter.Page,
+		Size:           filter.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.PendingReview, 0, len(reply.List))
+	for _, info := range reply.List {
+		list = append(list, &biz.PendingReview{
+			ReviewID:    info.ReviewID,
+			StoreID:     info.StoreID,
+			UserID:      info.UserID,
+			OrderID:     info.OrderID,
+			Score:       info.Score,
+			Content:     info.Content,
+			PicInfo:     info.PicInfo,
+			VideoInfo:   info.VideoInfo,
+			HasMedia:    info.HasMedia,
+			ReportCount: info.ReportCount,
+			Anonymous:   info.Anonymous,
+			CreateAt:    time.Unix(info.CreateAt, 0),
+		})
+	}
+	ret := &biz.PendingReviews{List: list}
+	ret.Total = reply.Total
+	if reply.OldestCreateAt > 0 {
+		ret.OldestCreateAt = time.Unix(reply.OldestCreateAt, 0)
+	}
+	return ret, nil
+}
+
+func (r *operationRepo) ListPendingAppeals(ctx context.Context, filter *biz.PendingFilter) (*biz.PendingAppeals, error) {
+	r.log.WithContext(ctx).Infof("[data]ListPendingAppeals,filter:%v\n", filter)
+	reply, err := r.data.rc.ListPendingAppeals(ctx, &v1.ListPendingAppealsRequest{
+		StoreID:       filter.StoreID,
+		CreatedBefore: createdBefore(filter.MinAge),
+		Page:          filter.Page,
+		Size:          filter.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.PendingAppeal, 0, len(reply.List))
+	for _, info := range reply.List {
+		list = append(list, &biz.PendingAppeal{
+			AppealID:  info.AppealID,
+			ReviewID:  info.ReviewID,
+			StoreID:   info.StoreID,
+			Reason:    info.Reason,
+			Content:   info.Content,
+			PicInfo:   info.PicInfo,
+			VideoInfo: info.VideoInfo,
+			CreateAt:  time.Unix(info.CreateAt, 0),
+		})
+	}
+	ret := &biz.PendingAppeals{List: list}
+	ret.Total = reply.Total
+	if reply.OldestCreateAt > 0 {
+		ret.OldestCreateAt = time.Unix(reply.OldestCreateAt, 0)
+	}
+	return ret, nil
+}
+
+// createdBefore 把等待时长下限换算成review-service的创建时间上限
+func createdBefore(minAge time.Duration) int64 {
+	if minAge <= 0 {
+		return 0
+	}
+	return time.Now().Add(-minAge).Unix()
+}
+
+func (r *operationRepo) Claim(ctx context.Context, item string, id int64, opUser string, ttl time.Duration) (*biz.Claim, error) {
+	ret, err := claimScript.Run(ctx, r.data.rdb, []string{claimKey(item, id)}, opUser, ttl.Milliseconds()).Slice()
+	if err != nil {
+		return nil, err
+	}
+	holder, _ := ret[0].(string)
+	pttl, _ := ret[1].(int64)
+	return &biz.Claim{OpUser: holder, ExpireAt: time.Now().Add(time.Duration(pttl) * time.Millisecond)}, nil
+}
+
+func (r *operationRepo) Release(ctx context.Context, item string, id int64, opUser string) error {
+	return releaseScript.Run(ctx, r.data.rdb, []string{claimKey(item, id)}, opUser).Err()
+}
+
+func (r *operationRepo) GetClaims(ctx context.Context, item string, ids []int64) (map[int64]*biz.Claim, error) {
+	pipe := r.data.rdb.Pipeline()
+	gets := make([]*redis.StringCmd, 0, len(ids))
+	ttls := make([]*redis.DurationCmd, 0, len(ids))
+	for _, id := range ids {
+		gets = append(gets, pipe.Get(ctx, claimKey(item, id)))
+		ttls = append(ttls, pipe.PTTL(ctx, claimKey(item, id)))
+	}
+	//没人认领的key返回redis.Nil，按未认领处理
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	now := time.Now()
+	claims := make(map[int64]*biz.Claim, len(ids))
+	for i, id := range ids {
+		opUser, err := gets[i].Result()
+		if err != nil {
+			continue
+		}
+		ttl := ttls[i].Val()
+		if ttl <= 0 {
+			continue
+		}
+		claims[id] = &biz.Claim{OpUser: opUser, ExpireAt: now.Add(ttl)}
+	}
+	return claims, nil
+}
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"review-o/internal/biz"
+	"testing"
+	"time"
+)
+
+func TestClaim(t *testing.T) {
+	convey.Convey("a claim is held by one operator until released or expired", t, func() {
+		mr := miniredis.RunT(t)
+		repo := NewOperationRepo(&Data{rdb: redis.NewClient(&redis.Options{Addr: mr.Addr()}), log: log.NewHelper(log.DefaultLogger)}, log.DefaultLogger)
+		ctx := context.Background()
+
+		claim, err := repo.Claim(ctx, biz.ItemReview, 1, "alice", time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(claim.OpUser, convey.ShouldEqual, "alice")
+		//别人认领时返回当前认领人和剩余时间
+		mr.FastForward(20 * time.Second)
+		claim, err = repo.Claim(ctx, biz.ItemReview, 1, "bob", time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(claim.OpUser, convey.ShouldEqual, "alice")
+		convey.So(time.Until(claim.ExpireAt), convey.ShouldBeBetween, 30*time.Second, 41*time.Second)
+		//自己再认领续期
+		claim, err = repo.Claim(ctx, biz.ItemReview, 1, "alice", time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(claim.OpUser, convey.ShouldEqual, "alice")
+		convey.So(mr.TTL(claimKey(biz.ItemReview, 1)), convey.ShouldEqual, time.Minute)
+
+		//同一ID的评价和申诉分开认领
+		claim, err = repo.Claim(ctx, biz.ItemAppeal, 1, "bob", time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(claim.OpUser, convey.ShouldEqual, "bob")
+
+		claims, err := repo.GetClaims(ctx, biz.ItemReview, []int64{1, 2})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(claims), convey.ShouldEqual, 1)
+		convey.So(claims[1].OpUser, convey.ShouldEqual, "alice")
+
+		//只能释放自己的认领
+		convey.So(repo.Release(ctx, biz.ItemReview, 1, "bob"), convey.ShouldBeNil)
+		convey.So(mr.Exists(claimKey(biz.ItemReview, 1)), convey.ShouldBeTrue)
+		convey.So(repo.Release(ctx, biz.ItemReview, 1, "alice"), convey.ShouldBeNil)
+		claim, err = repo.Claim(ctx, biz.ItemReview, 1, "bob", time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(claim.OpUser, convey.ShouldEqual, "bob")
+
+		//租约过期后别人可以认领
+		mr.FastForward(time.Minute)
+		claims, err = repo.GetClaims(ctx, biz.ItemReview, []int64{1})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(claims), convey.ShouldEqual, 0)
+		claim, err = repo.Claim(ctx, biz.ItemReview, 1, "alice", time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(claim.OpUser, convey.ShouldEqual, "alice")
+	})
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"github.com/go-kratos/kratos/v2/errors"
 	v1 "review-o/api/review/v1"
 	"review-o/internal/biz"
 
@@ -52,3 +53,76 @@ func (r *operationRepo) AuditReview(ctx context.Context, param *biz.AuditReviewP
 	}
 	return param.ReviewID, nil
 }
+
+func (r *operationRepo) BatchAuditAppeals(ctx context.Context, ids []int64, param *biz.AuditAppealParam) ([]*biz.AuditResult, error) {
+	r.log.WithContext(ctx).Infof("[data]BatchAuditAppeals,ids:%v param:%v\n", ids, param)
+	reply, err := r.data.rc.BatchAuditAppeals(ctx, &v1.BatchAuditAppealsRequest{
+		AppealIDs: ids,
+		Status:    param.Status,
+		OpUser:    param.OpUser,
+		OpReason:  param.OpReason,
+		OpRemarks: param.OpRemarks,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAuditResults(reply.Results), nil
+}
+
+func (r *operationRepo) BatchAuditReviews(ctx context.Context, ids []int64, param *biz.AuditReviewParam) ([]*biz.AuditResult, error) {
+	r.log.WithContext(ctx).Infof("[data]BatchAuditReviews,ids:%v param:%v\n", ids, param)
+	reply, err := r.data.rc.BatchAuditReviews(ctx, &v1.BatchAuditReviewsRequest{
+		ReviewIDs: ids,
+		Status:    param.Status,
+		OpUser:    param.OpUser,
+		OpReason:  param.OpReason,
+		OpRemarks: param.OpRemarks,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAuditResults(reply.Results), nil
+}
+
+// toAuditResults 把review-service返回的错误码还原成kratos error
+func toAuditResults(results []*v1.AuditResult) []*biz.AuditResult {
+	list := make([]*biz.AuditResult, 0, len(results))
+	for _, r := range results {
+		ret := &biz.AuditResult{ID: r.Id}
+		if !r.Success {
+			ret.Err = errors.New(int(r.Code), r.Reason, r.Message)
+		}
+		list = append(list, ret)
+	}
+	return list
+}
+
+func (r *operationRepo) ListReportedReviews(ctx context.Context, page int32, size int32) ([]*biz.ReportedReview, error) {
+	r.log.WithContext(ctx).Infof("[data]ListReportedReviews,page:%v size:%v\n", page, size)
+	reply, err := r.data.rc.ListReportedReviews(ctx, &v1.ListReportedReviewsRequest{
+		Page: page,
+		Size: size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.ReportedReview, 0, len(reply.List))
+	for _, info := range reply.List {
+		reasons := make([]*biz.ReasonCount, 0, len(info.Reasons))
+		for _, rc := range info.Reasons {
+			reasons = append(reasons, &biz.ReasonCount{Reason: rc.Reason, Count: rc.Count})
+		}
+		list = append(list, &biz.ReportedReview{
+			ReviewID:    info.ReviewID,
+			StoreID:     info.StoreID,
+			UserID:      info.UserID,
+			Content:     info.Content,
+			PicInfo:     info.PicInfo,
+			VideoInfo:   info.VideoInfo,
+			Status:      info.Status,
+			ReportCount: info.ReportCount,
+			Reasons:     reasons,
+		})
+	}
+	return list, nil
+}
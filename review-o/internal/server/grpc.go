@@ -1,20 +1,28 @@
 package server
 
 import (
+	"review-common/health"
+	"review-common/telemetry"
 	v1 "review-o/api/operation/v1"
 	"review-o/internal/conf"
 	"review-o/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, operation *service.OperationService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, operation *service.OperationService, h *health.Health, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
+		//用自己的health服务，按依赖检查结果返回状态
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			telemetry.ServerMetrics(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -27,6 +35,7 @@ func NewGRPCServer(c *conf.Server, operation *service.OperationService, logger l
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
+	grpc_health_v1.RegisterHealthServer(srv, h.GRPC())
 	v1.RegisterOperationServer(srv, operation)
 	return srv
 }
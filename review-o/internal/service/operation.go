@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"review-o/internal/biz"
+	"time"
 
 	pb "review-o/api/operation/v1"
 )
@@ -45,3 +47,238 @@ func (s *OperationService) AuditReview(ctx context.Context, req *pb.AuditReviewR
 	}
 	return &pb.AuditReviewReply{}, nil
 }
+
+func (s *OperationService) BatchAuditReviews(ctx context.Context, req *pb.BatchAuditReviewsRequest) (*pb.BatchAuditReply, error) {
+	fmt.Printf("[service] BatchAuditReviews, req:#%v\n", req)
+	ret, err := s.uc.BatchAuditReviews(ctx, req.ReviewIDs, &biz.AuditReviewParam{
+		OpUser:    req.OpUser,
+		OpReason:  req.OpReason,
+		OpRemarks: req.OpRemarks,
+		Status:    req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toBatchAuditReply(ret), nil
+}
+
+func (s *OperationService) BatchAuditAppeals(ctx context.Context, req *pb.BatchAuditAppealsRequest) (*pb.BatchAuditReply, error) {
+	fmt.Printf("[service] BatchAuditAppeals, req:#%v\n", req)
+	ret, err := s.uc.BatchAuditAppeals(ctx, req.AppealIDs, &biz.AuditAppealParam{
+		OpUser:    req.OpUser,
+		OpReason:  req.OpReason,
+		OpRemarks: req.OpRemarks,
+		Status:    req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toBatchAuditReply(ret), nil
+}
+
+func toBatchAuditReply(ret []*biz.AuditResult) *pb.BatchAuditReply {
+	reply := &pb.BatchAuditReply{Results: make([]*pb.AuditResult, 0, len(ret))}
+	for _, r := range ret {
+		result := &pb.AuditResult{Id: r.ID, Success: r.Err == nil}
+		if r.Err != nil {
+			e := errors.FromError(r.Err)
+			result.Code, result.Reason, result.Message = e.Code, e.Reason, e.Message
+			reply.Failed++
+		} else {
+			reply.Succeeded++
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	return reply
+}
+
+func (s *OperationService) ListReportedReviews(ctx context.Context, req *pb.ListReportedReviewsRequest) (*pb.ListReportedReviewsReply, error) {
+	fmt.Printf("[service] ListReportedReviews, req:#%v\n", req)
+	ret, err := s.uc.ListReportedReviews(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReportedReviewInfo, 0, len(ret))
+	for _, r := range ret {
+		reasons := make([]*pb.ReportReasonCount, 0, len(r.Reasons))
+		for _, rc := range r.Reasons {
+			reasons = append(reasons, &pb.ReportReasonCount{Reason: rc.Reason, Count: rc.Count})
+		}
+		list = append(list, &pb.ReportedReviewInfo{
+			ReviewID:    r.ReviewID,
+			StoreID:     r.StoreID,
+			UserID:      r.UserID,
+			Content:     r.Content,
+			PicInfo:     r.PicInfo,
+			VideoInfo:   r.VideoInfo,
+			Status:      r.Status,
+			ReportCount: r.ReportCount,
+			Reasons:     reasons,
+		})
+	}
+	return &pb.ListReportedReviewsReply{List: list}, nil
+}
+
+func (s *OperationService) ListPendingReviews(ctx context.Context, req *pb.ListPendingReviewsRequest) (*pb.ListPendingReviewsReply, error) {
+	fmt.Printf("[service] ListPendingReviews, req:#%v\n", req)
+	ret, err := s.uc.ListPendingReviews(ctx, &biz.PendingFilter{
+		StoreID:        req.StoreID,
+		HasMedia:       req.HasMedia,
+		MinReportCount: req.MinReportCount,
+		MinAge:         time.Duration(req.MinAgeMinutes) * time.Minute,
+		Page:           req.Page,
+		Size:           req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.PendingReviewInfo, 0, len(ret.List))
+	for _, r := range ret.List {
+		list = append(list, &pb.PendingReviewInfo{
+			ReviewID:    r.ReviewID,
+			StoreID:     r.StoreID,
+			UserID:      r.UserID,
+			OrderID:     r.OrderID,
+			Score:       r.Score,
+			Content:     r.Content,
+			PicInfo:     r.PicInfo,
+			VideoInfo:   r.VideoInfo,
+			HasMedia:    r.HasMedia,
+			ReportCount: r.ReportCount,
+			Anonymous:   r.Anonymous,
+			CreateAt:    r.CreateAt.Unix(),
+			AgeSeconds:  int64(r.Age.Seconds()),
+			SlaBreached: r.SlaBreached,
+			Claim:       toClaimInfo(r.Claim),
+		})
+	}
+	return &pb.ListPendingReviewsReply{List: list, Sla: toSlaInfo(&ret.SlaStats)}, nil
+}
+
+func (s *OperationService) ListPendingAppeals(ctx context.Context, req *pb.ListPendingAppealsRequest) (*pb.ListPendingAppealsReply, error) {
+	fmt.Printf("[service] ListPendingAppeals, req:#%v\n", req)
+	ret, err := s.uc.ListPendingAppeals(ctx, &biz.PendingFilter{
+		StoreID: req.StoreID,
+		MinAge:  time.Duration(req.MinAgeMinutes) * time.Minute,
+		Page:    req.Page,
+		Size:    req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.PendingAppealInfo, 0, len(ret.List))
+	for _, a := range ret.List {
+		list = append(list, &pb.PendingAppealInfo{
+			AppealID:    a.AppealID,
+			ReviewID:    a.ReviewID,
+			StoreID:     a.StoreID,
+			Reason:      a.Reason,
+			Content:     a.Content,
+			PicInfo:     a.PicInfo,
+			VideoInfo:   a.VideoInfo,
+			CreateAt:    a.CreateAt.Unix(),
+			AgeSeconds:  int64(a.Age.Seconds()),
+			SlaBreached: a.SlaBreached,
+			Claim:       toClaimInfo(a.Claim),
+		})
+	}
+	return &pb.ListPendingAppealsReply{List: list, Sla: toSlaInfo(&ret.SlaStats)}, nil
+}
+
+func (s *OperationService) ClaimReview(ctx context.Context, req *pb.ClaimReviewRequest) (*pb.ClaimReply, error) {
+	fmt.Printf("[service] ClaimReview, req:#%v\n", req)
+	claim, err := s.uc.ClaimItem(ctx, biz.ItemReview, req.ReviewID, req.OpUser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClaimReply{Claim: toClaimInfo(claim)}, nil
+}
+
+func (s *OperationService) ReleaseReview(ctx context.Context, req *pb.ReleaseReviewRequest) (*pb.ReleaseReply, error) {
+	fmt.Printf("[service] ReleaseReview, req:#%v\n", req)
+	if err := s.uc.ReleaseItem(ctx, biz.ItemReview, req.ReviewID, req.OpUser); err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseReply{}, nil
+}
+
+func (s *OperationService) ClaimAppeal(ctx context.Context, req *pb.ClaimAppealRequest) (*pb.ClaimReply, error) {
+	fmt.Printf("[service] ClaimAppeal, req:#%v\n", req)
+	claim, err := s.uc.ClaimItem(ctx, biz.ItemAppeal, req.AppealID, req.OpUser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClaimReply{Claim: toClaimInfo(claim)}, nil
+}
+
+func (s *OperationService) ReleaseAppeal(ctx context.Context, req *pb.ReleaseAppealRequest) (*pb.ReleaseReply, error) {
+	fmt.Printf("[service] ReleaseAppeal, req:#%v\n", req)
+	if err := s.uc.ReleaseItem(ctx, biz.ItemAppeal, req.AppealID, req.OpUser); err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseReply{}, nil
+}
+
+func (s *OperationService) RequestAppealInfo(ctx context.Context, req *pb.RequestAppealInfoRequest) (*pb.RequestAppealInfoReply, error) {
+	fmt.Printf("[service] RequestAppealInfo, req:#%v\n", req)
+	if err := s.uc.RequestAppealInfo(ctx, req.AppealID, req.OpUser, req.Content); err != nil {
+		return nil, err
+	}
+	return &pb.RequestAppealInfoReply{}, nil
+}
+
+func (s *OperationService) GetAppealHistory(ctx context.Context, req *pb.GetAppealHistoryRequest) (*pb.GetAppealHistoryReply, error) {
+	fmt.Printf("[service] GetAppealHistory, req:#%v\n", req)
+	history, err := s.uc.GetAppealHistory(ctx, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	appeals := make([]*pb.AppealInfo, 0, len(history.Appeals))
+	for _, a := range history.Appeals {
+		appeals = append(appeals, &pb.AppealInfo{
+			AppealID:  a.AppealID,
+			ReviewID:  a.ReviewID,
+			StoreID:   a.StoreID,
+			Round:     a.Round,
+			Status:    a.Status,
+			Reason:    a.Reason,
+			Content:   a.Content,
+			PicInfo:   a.PicInfo,
+			VideoInfo: a.VideoInfo,
+			OpRemarks: a.OpRemarks,
+			CreateAt:  a.CreateAt.Unix(),
+			UpdateAt:  a.UpdateAt.Unix(),
+		})
+	}
+	messages := make([]*pb.AppealMessageInfo, 0, len(history.Messages))
+	for _, m := range history.Messages {
+		messages = append(messages, &pb.AppealMessageInfo{
+			MessageID:  m.MessageID,
+			AppealID:   m.AppealID,
+			SenderType: m.SenderType,
+			Sender:     m.Sender,
+			Action:     m.Action,
+			Content:    m.Content,
+			PicInfo:    m.PicInfo,
+			VideoInfo:  m.VideoInfo,
+			CreateAt:   m.CreateAt.Unix(),
+		})
+	}
+	return &pb.GetAppealHistoryReply{Appeals: appeals, Messages: messages}, nil
+}
+
+func toClaimInfo(c *biz.Claim) *pb.ClaimInfo {
+	if c == nil {
+		return nil
+	}
+	return &pb.ClaimInfo{OpUser: c.OpUser, ExpireAt: c.ExpireAt.Unix()}
+}
+
+func toSlaInfo(st *biz.SlaStats) *pb.SlaInfo {
+	return &pb.SlaInfo{
+		SlaSeconds:       int64(st.SLA.Seconds()),
+		Total:            st.Total,
+		Breached:         st.Breached,
+		OldestAgeSeconds: int64(st.OldestAge.Seconds()),
+	}
+}
@@ -19,18 +19,53 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Review_CreateReview_FullMethodName        = "/api.review.v1.Review/CreateReview"
-	Review_UpdateReview_FullMethodName        = "/api.review.v1.Review/UpdateReview"
-	Review_DeleteReview_FullMethodName        = "/api.review.v1.Review/DeleteReview"
-	Review_GetReview_FullMethodName           = "/api.review.v1.Review/GetReview"
-	Review_GetAllReviews_FullMethodName       = "/api.review.v1.Review/GetAllReviews"
-	Review_ReplyReview_FullMethodName         = "/api.review.v1.Review/ReplyReview"
-	Review_ReplyReviewUpdate_FullMethodName   = "/api.review.v1.Review/ReplyReviewUpdate"
-	Review_AppealReview_FullMethodName        = "/api.review.v1.Review/AppealReview"
-	Review_AuditReview_FullMethodName         = "/api.review.v1.Review/AuditReview"
-	Review_AuditAppeal_FullMethodName         = "/api.review.v1.Review/AuditAppeal"
-	Review_ListReviewByStoreID_FullMethodName = "/api.review.v1.Review/ListReviewByStoreID"
-	Review_ListReviewByContent_FullMethodName = "/api.review.v1.Review/ListReviewByContent"
+	Review_CreateReview_FullMethodName          = "/api.review.v1.Review/CreateReview"
+	Review_UpdateReview_FullMethodName          = "/api.review.v1.Review/UpdateReview"
+	Review_DeleteReview_FullMethodName          = "/api.review.v1.Review/DeleteReview"
+	Review_GetReview_FullMethodName             = "/api.review.v1.Review/GetReview"
+	Review_GetReviewDetail_FullMethodName       = "/api.review.v1.Review/GetReviewDetail"
+	Review_GetAllReviews_FullMethodName         = "/api.review.v1.Review/GetAllReviews"
+	Review_ListUserReviews_FullMethodName       = "/api.review.v1.Review/ListUserReviews"
+	Review_BatchGetReviews_FullMethodName       = "/api.review.v1.Review/BatchGetReviews"
+	Review_GetReviewsByOrderIDs_FullMethodName  = "/api.review.v1.Review/GetReviewsByOrderIDs"
+	Review_ReplyReview_FullMethodName           = "/api.review.v1.Review/ReplyReview"
+	Review_ReplyReviewUpdate_FullMethodName     = "/api.review.v1.Review/ReplyReviewUpdate"
+	Review_AppealReview_FullMethodName          = "/api.review.v1.Review/AppealReview"
+	Review_AuditReview_FullMethodName           = "/api.review.v1.Review/AuditReview"
+	Review_AuditAppeal_FullMethodName           = "/api.review.v1.Review/AuditAppeal"
+	Review_BatchAuditReviews_FullMethodName     = "/api.review.v1.Review/BatchAuditReviews"
+	Review_BatchAuditAppeals_FullMethodName     = "/api.review.v1.Review/BatchAuditAppeals"
+	Review_ListReviewByStoreID_FullMethodName   = "/api.review.v1.Review/ListReviewByStoreID"
+	Review_ListReviewByContent_FullMethodName   = "/api.review.v1.Review/ListReviewByContent"
+	Review_VoteReview_FullMethodName            = "/api.review.v1.Review/VoteReview"
+	Review_UnvoteReview_FullMethodName          = "/api.review.v1.Review/UnvoteReview"
+	Review_ReportReview_FullMethodName          = "/api.review.v1.Review/ReportReview"
+	Review_ListReportedReviews_FullMethodName   = "/api.review.v1.Review/ListReportedReviews"
+	Review_ListPendingReviews_FullMethodName    = "/api.review.v1.Review/ListPendingReviews"
+	Review_ListPendingAppeals_FullMethodName    = "/api.review.v1.Review/ListPendingAppeals"
+	Review_ListStoreReviews_FullMethodName      = "/api.review.v1.Review/ListStoreReviews"
+	Review_CreateReviewExport_FullMethodName    = "/api.review.v1.Review/CreateReviewExport"
+	Review_GetReviewExport_FullMethodName       = "/api.review.v1.Review/GetReviewExport"
+	Review_ListReviewExports_FullMethodName     = "/api.review.v1.Review/ListReviewExports"
+	Review_DownloadReviewExport_FullMethodName  = "/api.review.v1.Review/DownloadReviewExport"
+	Review_ListStoreAppeals_FullMethodName      = "/api.review.v1.Review/ListStoreAppeals"
+	Review_GetAppeal_FullMethodName             = "/api.review.v1.Review/GetAppeal"
+	Review_SupplementAppeal_FullMethodName      = "/api.review.v1.Review/SupplementAppeal"
+	Review_WithdrawAppeal_FullMethodName        = "/api.review.v1.Review/WithdrawAppeal"
+	Review_RequestAppealInfo_FullMethodName     = "/api.review.v1.Review/RequestAppealInfo"
+	Review_GetAppealHistory_FullMethodName      = "/api.review.v1.Review/GetAppealHistory"
+	Review_CreateReplyTemplate_FullMethodName   = "/api.review.v1.Review/CreateReplyTemplate"
+	Review_UpdateReplyTemplate_FullMethodName   = "/api.review.v1.Review/UpdateReplyTemplate"
+	Review_DeleteReplyTemplate_FullMethodName   = "/api.review.v1.Review/DeleteReplyTemplate"
+	Review_ListReplyTemplates_FullMethodName    = "/api.review.v1.Review/ListReplyTemplates"
+	Review_CreateAutoReplyRule_FullMethodName   = "/api.review.v1.Review/CreateAutoReplyRule"
+	Review_UpdateAutoReplyRule_FullMethodName   = "/api.review.v1.Review/UpdateAutoReplyRule"
+	Review_DeleteAutoReplyRule_FullMethodName   = "/api.review.v1.Review/DeleteAutoReplyRule"
+	Review_ListAutoReplyRules_FullMethodName    = "/api.review.v1.Review/ListAutoReplyRules"
+	Review_CreateWebhook_FullMethodName         = "/api.review.v1.Review/CreateWebhook"
+	Review_DeleteWebhook_FullMethodName         = "/api.review.v1.Review/DeleteWebhook"
+	Review_ListWebhooks_FullMethodName          = "/api.review.v1.Review/ListWebhooks"
+	Review_ListWebhookDeliveries_FullMethodName = "/api.review.v1.Review/ListWebhookDeliveries"
 )
 
 // ReviewClient is the client API for Review service.
@@ -45,8 +80,17 @@ type ReviewClient interface {
 	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewReply, error)
 	// c端获取评价详情
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewReply, error)
-	// c端获取自己的全部评价详情
+	// 评价详情，带商家回复；O端运营和B端商家查本店评价时还带最近一次申诉和审核信息
+	GetReviewDetail(ctx context.Context, in *GetReviewDetailRequest, opts ...grpc.CallOption) (*GetReviewDetailReply, error)
+	// Deprecated: Do not use.
+	// c端获取自己的全部评价详情，不分页，已被ListUserReviews取代
 	GetAllReviews(ctx context.Context, in *GetAllReviewsRequest, opts ...grpc.CallOption) (*GetAllReviewsReply, error)
+	// c端我的评价，按状态、是否有回复和时间筛选，分页
+	ListUserReviews(ctx context.Context, in *ListUserReviewsRequest, opts ...grpc.CallOption) (*ListUserReviewsReply, error)
+	// 按评价ID批量查询，查不到的ID放在notFound里
+	BatchGetReviews(ctx context.Context, in *BatchGetReviewsRequest, opts ...grpc.CallOption) (*BatchGetReviewsReply, error)
+	// 按订单ID批量查询评价，订单列表页展示评价状态用，没有评价的订单放在notFound里
+	GetReviewsByOrderIDs(ctx context.Context, in *GetReviewsByOrderIDsRequest, opts ...grpc.CallOption) (*GetReviewsByOrderIDsReply, error)
 	// B端回复评价
 	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*ReplyReviewReply, error)
 	// B端修改回复
@@ -57,9 +101,62 @@ type ReviewClient interface {
 	AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error)
 	// O端审核申诉
 	AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...grpc.CallOption) (*AuditAppealReply, error)
+	// O端批量审核评价,逐条处理并返回每条的结果
+	BatchAuditReviews(ctx context.Context, in *BatchAuditReviewsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error)
+	// O端批量审核申诉,逐条处理并返回每条的结果
+	BatchAuditAppeals(ctx context.Context, in *BatchAuditAppealsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error)
 	// 根据商家ID查询评价列表
 	ListReviewByStoreID(ctx context.Context, in *ListReviewByStoreIDRequest, opts ...grpc.CallOption) (*ListReviewByStoreIDReply, error)
 	ListReviewByContent(ctx context.Context, in *ListReviewByContentRequest, opts ...grpc.CallOption) (*ListReviewByContentReply, error)
+	// c端给评价点有用
+	VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...grpc.CallOption) (*VoteReviewReply, error)
+	// c端取消有用
+	UnvoteReview(ctx context.Context, in *UnvoteReviewRequest, opts ...grpc.CallOption) (*UnvoteReviewReply, error)
+	// c端举报评价
+	ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*ReportReviewReply, error)
+	// O端查询被举报的评价
+	ListReportedReviews(ctx context.Context, in *ListReportedReviewsRequest, opts ...grpc.CallOption) (*ListReportedReviewsReply, error)
+	// O端查询待审核评价,按创建时间正序
+	ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsReply, error)
+	// O端查询待审核申诉,按创建时间正序
+	ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...grpc.CallOption) (*ListPendingAppealsReply, error)
+	// B端查询本店的评价
+	ListStoreReviews(ctx context.Context, in *ListStoreReviewsRequest, opts ...grpc.CallOption) (*ListStoreReviewsReply, error)
+	// B端导出本店评价，异步生成csv/xlsx文件，筛选条件同ListStoreReviews
+	CreateReviewExport(ctx context.Context, in *CreateReviewExportRequest, opts ...grpc.CallOption) (*CreateReviewExportReply, error)
+	// B端查询导出任务状态
+	GetReviewExport(ctx context.Context, in *GetReviewExportRequest, opts ...grpc.CallOption) (*GetReviewExportReply, error)
+	ListReviewExports(ctx context.Context, in *ListReviewExportsRequest, opts ...grpc.CallOption) (*ListReviewExportsReply, error)
+	// 分块读取导出文件，只走grpc，由review-b提供下载
+	DownloadReviewExport(ctx context.Context, in *DownloadReviewExportRequest, opts ...grpc.CallOption) (Review_DownloadReviewExportClient, error)
+	// B端查询本店的申诉
+	ListStoreAppeals(ctx context.Context, in *ListStoreAppealsRequest, opts ...grpc.CallOption) (*ListStoreAppealsReply, error)
+	// B端查询本店的一条申诉
+	GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...grpc.CallOption) (*GetAppealReply, error)
+	// B端补充申诉材料
+	SupplementAppeal(ctx context.Context, in *SupplementAppealRequest, opts ...grpc.CallOption) (*SupplementAppealReply, error)
+	// B端撤回申诉
+	WithdrawAppeal(ctx context.Context, in *WithdrawAppealRequest, opts ...grpc.CallOption) (*WithdrawAppealReply, error)
+	// O端要求商家补充申诉材料
+	RequestAppealInfo(ctx context.Context, in *RequestAppealInfoRequest, opts ...grpc.CallOption) (*RequestAppealInfoReply, error)
+	// 查询评价的全部申诉轮次和沟通记录
+	GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...grpc.CallOption) (*GetAppealHistoryReply, error)
+	// B端回复模板
+	CreateReplyTemplate(ctx context.Context, in *CreateReplyTemplateRequest, opts ...grpc.CallOption) (*CreateReplyTemplateReply, error)
+	UpdateReplyTemplate(ctx context.Context, in *UpdateReplyTemplateRequest, opts ...grpc.CallOption) (*UpdateReplyTemplateReply, error)
+	DeleteReplyTemplate(ctx context.Context, in *DeleteReplyTemplateRequest, opts ...grpc.CallOption) (*DeleteReplyTemplateReply, error)
+	ListReplyTemplates(ctx context.Context, in *ListReplyTemplatesRequest, opts ...grpc.CallOption) (*ListReplyTemplatesReply, error)
+	// B端自动回复规则
+	CreateAutoReplyRule(ctx context.Context, in *CreateAutoReplyRuleRequest, opts ...grpc.CallOption) (*CreateAutoReplyRuleReply, error)
+	UpdateAutoReplyRule(ctx context.Context, in *UpdateAutoReplyRuleRequest, opts ...grpc.CallOption) (*UpdateAutoReplyRuleReply, error)
+	DeleteAutoReplyRule(ctx context.Context, in *DeleteAutoReplyRuleRequest, opts ...grpc.CallOption) (*DeleteAutoReplyRuleReply, error)
+	ListAutoReplyRules(ctx context.Context, in *ListAutoReplyRulesRequest, opts ...grpc.CallOption) (*ListAutoReplyRulesReply, error)
+	// webhook订阅,ownerType 1店铺只收本店事件;2应用收全部事件
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookReply, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error)
+	// webhook投递记录
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesReply, error)
 }
 
 type reviewClient struct {
@@ -106,6 +203,16 @@ func (c *reviewClient) GetReview(ctx context.Context, in *GetReviewRequest, opts
 	return out, nil
 }
 
+func (c *reviewClient) GetReviewDetail(ctx context.Context, in *GetReviewDetailRequest, opts ...grpc.CallOption) (*GetReviewDetailReply, error) {
+	out := new(GetReviewDetailReply)
+	err := c.cc.Invoke(ctx, Review_GetReviewDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
 func (c *reviewClient) GetAllReviews(ctx context.Context, in *GetAllReviewsRequest, opts ...grpc.CallOption) (*GetAllReviewsReply, error) {
 	out := new(GetAllReviewsReply)
 	err := c.cc.Invoke(ctx, Review_GetAllReviews_FullMethodName, in, out, opts...)
@@ -115,6 +222,33 @@ func (c *reviewClient) GetAllReviews(ctx context.Context, in *GetAllReviewsReque
 	return out, nil
 }
 
+func (c *reviewClient) ListUserReviews(ctx context.Context, in *ListUserReviewsRequest, opts ...grpc.CallOption) (*ListUserReviewsReply, error) {
+	out := new(ListUserReviewsReply)
+	err := c.cc.Invoke(ctx, Review_ListUserReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) BatchGetReviews(ctx context.Context, in *BatchGetReviewsRequest, opts ...grpc.CallOption) (*BatchGetReviewsReply, error) {
+	out := new(BatchGetReviewsReply)
+	err := c.cc.Invoke(ctx, Review_BatchGetReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetReviewsByOrderIDs(ctx context.Context, in *GetReviewsByOrderIDsRequest, opts ...grpc.CallOption) (*GetReviewsByOrderIDsReply, error) {
+	out := new(GetReviewsByOrderIDsReply)
+	err := c.cc.Invoke(ctx, Review_GetReviewsByOrderIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*ReplyReviewReply, error) {
 	out := new(ReplyReviewReply)
 	err := c.cc.Invoke(ctx, Review_ReplyReview_FullMethodName, in, out, opts...)
@@ -160,6 +294,24 @@ func (c *reviewClient) AuditAppeal(ctx context.Context, in *AuditAppealRequest,
 	return out, nil
 }
 
+func (c *reviewClient) BatchAuditReviews(ctx context.Context, in *BatchAuditReviewsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error) {
+	out := new(BatchAuditReply)
+	err := c.cc.Invoke(ctx, Review_BatchAuditReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) BatchAuditAppeals(ctx context.Context, in *BatchAuditAppealsRequest, opts ...grpc.CallOption) (*BatchAuditReply, error) {
+	out := new(BatchAuditReply)
+	err := c.cc.Invoke(ctx, Review_BatchAuditAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) ListReviewByStoreID(ctx context.Context, in *ListReviewByStoreIDRequest, opts ...grpc.CallOption) (*ListReviewByStoreIDReply, error) {
 	out := new(ListReviewByStoreIDReply)
 	err := c.cc.Invoke(ctx, Review_ListReviewByStoreID_FullMethodName, in, out, opts...)
@@ -178,6 +330,290 @@ func (c *reviewClient) ListReviewByContent(ctx context.Context, in *ListReviewBy
 	return out, nil
 }
 
+func (c *reviewClient) VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...grpc.CallOption) (*VoteReviewReply, error) {
+	out := new(VoteReviewReply)
+	err := c.cc.Invoke(ctx, Review_VoteReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) UnvoteReview(ctx context.Context, in *UnvoteReviewRequest, opts ...grpc.CallOption) (*UnvoteReviewReply, error) {
+	out := new(UnvoteReviewReply)
+	err := c.cc.Invoke(ctx, Review_UnvoteReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...grpc.CallOption) (*ReportReviewReply, error) {
+	out := new(ReportReviewReply)
+	err := c.cc.Invoke(ctx, Review_ReportReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListReportedReviews(ctx context.Context, in *ListReportedReviewsRequest, opts ...grpc.CallOption) (*ListReportedReviewsReply, error) {
+	out := new(ListReportedReviewsReply)
+	err := c.cc.Invoke(ctx, Review_ListReportedReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListPendingReviewsReply, error) {
+	out := new(ListPendingReviewsReply)
+	err := c.cc.Invoke(ctx, Review_ListPendingReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...grpc.CallOption) (*ListPendingAppealsReply, error) {
+	out := new(ListPendingAppealsReply)
+	err := c.cc.Invoke(ctx, Review_ListPendingAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListStoreReviews(ctx context.Context, in *ListStoreReviewsRequest, opts ...grpc.CallOption) (*ListStoreReviewsReply, error) {
+	out := new(ListStoreReviewsReply)
+	err := c.cc.Invoke(ctx, Review_ListStoreReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CreateReviewExport(ctx context.Context, in *CreateReviewExportRequest, opts ...grpc.CallOption) (*CreateReviewExportReply, error) {
+	out := new(CreateReviewExportReply)
+	err := c.cc.Invoke(ctx, Review_CreateReviewExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetReviewExport(ctx context.Context, in *GetReviewExportRequest, opts ...grpc.CallOption) (*GetReviewExportReply, error) {
+	out := new(GetReviewExportReply)
+	err := c.cc.Invoke(ctx, Review_GetReviewExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListReviewExports(ctx context.Context, in *ListReviewExportsRequest, opts ...grpc.CallOption) (*ListReviewExportsReply, error) {
+	out := new(ListReviewExportsReply)
+	err := c.cc.Invoke(ctx, Review_ListReviewExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DownloadReviewExport(ctx context.Context, in *DownloadReviewExportRequest, opts ...grpc.CallOption) (Review_DownloadReviewExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Review_ServiceDesc.Streams[0], Review_DownloadReviewExport_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reviewDownloadReviewExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Review_DownloadReviewExportClient interface {
+	Recv() (*DownloadReviewExportReply, error)
+	grpc.ClientStream
+}
+
+type reviewDownloadReviewExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *reviewDownloadReviewExportClient) Recv() (*DownloadReviewExportReply, error) {
+	m := new(DownloadReviewExportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *reviewClient) ListStoreAppeals(ctx context.Context, in *ListStoreAppealsRequest, opts ...grpc.CallOption) (*ListStoreAppealsReply, error) {
+	out := new(ListStoreAppealsReply)
+	err := c.cc.Invoke(ctx, Review_ListStoreAppeals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...grpc.CallOption) (*GetAppealReply, error) {
+	out := new(GetAppealReply)
+	err := c.cc.Invoke(ctx, Review_GetAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) SupplementAppeal(ctx context.Context, in *SupplementAppealRequest, opts ...grpc.CallOption) (*SupplementAppealReply, error) {
+	out := new(SupplementAppealReply)
+	err := c.cc.Invoke(ctx, Review_SupplementAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) WithdrawAppeal(ctx context.Context, in *WithdrawAppealRequest, opts ...grpc.CallOption) (*WithdrawAppealReply, error) {
+	out := new(WithdrawAppealReply)
+	err := c.cc.Invoke(ctx, Review_WithdrawAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) RequestAppealInfo(ctx context.Context, in *RequestAppealInfoRequest, opts ...grpc.CallOption) (*RequestAppealInfoReply, error) {
+	out := new(RequestAppealInfoReply)
+	err := c.cc.Invoke(ctx, Review_RequestAppealInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...grpc.CallOption) (*GetAppealHistoryReply, error) {
+	out := new(GetAppealHistoryReply)
+	err := c.cc.Invoke(ctx, Review_GetAppealHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CreateReplyTemplate(ctx context.Context, in *CreateReplyTemplateRequest, opts ...grpc.CallOption) (*CreateReplyTemplateReply, error) {
+	out := new(CreateReplyTemplateReply)
+	err := c.cc.Invoke(ctx, Review_CreateReplyTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) UpdateReplyTemplate(ctx context.Context, in *UpdateReplyTemplateRequest, opts ...grpc.CallOption) (*UpdateReplyTemplateReply, error) {
+	out := new(UpdateReplyTemplateReply)
+	err := c.cc.Invoke(ctx, Review_UpdateReplyTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DeleteReplyTemplate(ctx context.Context, in *DeleteReplyTemplateRequest, opts ...grpc.CallOption) (*DeleteReplyTemplateReply, error) {
+	out := new(DeleteReplyTemplateReply)
+	err := c.cc.Invoke(ctx, Review_DeleteReplyTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListReplyTemplates(ctx context.Context, in *ListReplyTemplatesRequest, opts ...grpc.CallOption) (*ListReplyTemplatesReply, error) {
+	out := new(ListReplyTemplatesReply)
+	err := c.cc.Invoke(ctx, Review_ListReplyTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CreateAutoReplyRule(ctx context.Context, in *CreateAutoReplyRuleRequest, opts ...grpc.CallOption) (*CreateAutoReplyRuleReply, error) {
+	out := new(CreateAutoReplyRuleReply)
+	err := c.cc.Invoke(ctx, Review_CreateAutoReplyRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) UpdateAutoReplyRule(ctx context.Context, in *UpdateAutoReplyRuleRequest, opts ...grpc.CallOption) (*UpdateAutoReplyRuleReply, error) {
+	out := new(UpdateAutoReplyRuleReply)
+	err := c.cc.Invoke(ctx, Review_UpdateAutoReplyRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DeleteAutoReplyRule(ctx context.Context, in *DeleteAutoReplyRuleRequest, opts ...grpc.CallOption) (*DeleteAutoReplyRuleReply, error) {
+	out := new(DeleteAutoReplyRuleReply)
+	err := c.cc.Invoke(ctx, Review_DeleteAutoReplyRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListAutoReplyRules(ctx context.Context, in *ListAutoReplyRulesRequest, opts ...grpc.CallOption) (*ListAutoReplyRulesReply, error) {
+	out := new(ListAutoReplyRulesReply)
+	err := c.cc.Invoke(ctx, Review_ListAutoReplyRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookReply, error) {
+	out := new(CreateWebhookReply)
+	err := c.cc.Invoke(ctx, Review_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookReply, error) {
+	out := new(DeleteWebhookReply)
+	err := c.cc.Invoke(ctx, Review_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error) {
+	out := new(ListWebhooksReply)
+	err := c.cc.Invoke(ctx, Review_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesReply, error) {
+	out := new(ListWebhookDeliveriesReply)
+	err := c.cc.Invoke(ctx, Review_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServer is the server API for Review service.
 // All implementations must embed UnimplementedReviewServer
 // for forward compatibility
@@ -190,8 +626,17 @@ type ReviewServer interface {
 	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error)
 	// c端获取评价详情
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
-	// c端获取自己的全部评价详情
+	// 评价详情，带商家回复；O端运营和B端商家查本店评价时还带最近一次申诉和审核信息
+	GetReviewDetail(context.Context, *GetReviewDetailRequest) (*GetReviewDetailReply, error)
+	// Deprecated: Do not use.
+	// c端获取自己的全部评价详情，不分页，已被ListUserReviews取代
 	GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsReply, error)
+	// c端我的评价，按状态、是否有回复和时间筛选，分页
+	ListUserReviews(context.Context, *ListUserReviewsRequest) (*ListUserReviewsReply, error)
+	// 按评价ID批量查询，查不到的ID放在notFound里
+	BatchGetReviews(context.Context, *BatchGetReviewsRequest) (*BatchGetReviewsReply, error)
+	// 按订单ID批量查询评价，订单列表页展示评价状态用，没有评价的订单放在notFound里
+	GetReviewsByOrderIDs(context.Context, *GetReviewsByOrderIDsRequest) (*GetReviewsByOrderIDsReply, error)
 	// B端回复评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
 	// B端修改回复
@@ -202,277 +647,1068 @@ type ReviewServer interface {
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	// O端审核申诉
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
+	// O端批量审核评价,逐条处理并返回每条的结果
+	BatchAuditReviews(context.Context, *BatchAuditReviewsRequest) (*BatchAuditReply, error)
+	// O端批量审核申诉,逐条处理并返回每条的结果
+	BatchAuditAppeals(context.Context, *BatchAuditAppealsRequest) (*BatchAuditReply, error)
 	// 根据商家ID查询评价列表
 	ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error)
 	ListReviewByContent(context.Context, *ListReviewByContentRequest) (*ListReviewByContentReply, error)
+	// c端给评价点有用
+	VoteReview(context.Context, *VoteReviewRequest) (*VoteReviewReply, error)
+	// c端取消有用
+	UnvoteReview(context.Context, *UnvoteReviewRequest) (*UnvoteReviewReply, error)
+	// c端举报评价
+	ReportReview(context.Context, *ReportReviewRequest) (*ReportReviewReply, error)
+	// O端查询被举报的评价
+	ListReportedReviews(context.Context, *ListReportedReviewsRequest) (*ListReportedReviewsReply, error)
+	// O端查询待审核评价,按创建时间正序
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsReply, error)
+	// O端查询待审核申诉,按创建时间正序
+	ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsReply, error)
+	// B端查询本店的评价
+	ListStoreReviews(context.Context, *ListStoreReviewsRequest) (*ListStoreReviewsReply, error)
+	// B端导出本店评价，异步生成csv/xlsx文件，筛选条件同ListStoreReviews
+	CreateReviewExport(context.Context, *CreateReviewExportRequest) (*CreateReviewExportReply, error)
+	// B端查询导出任务状态
+	GetReviewExport(context.Context, *GetReviewExportRequest) (*GetReviewExportReply, error)
+	ListReviewExports(context.Context, *ListReviewExportsRequest) (*ListReviewExportsReply, error)
+	// 分块读取导出文件，只走grpc，由review-b提供下载
+	DownloadReviewExport(*DownloadReviewExportRequest, Review_DownloadReviewExportServer) error
+	// B端查询本店的申诉
+	ListStoreAppeals(context.Context, *ListStoreAppealsRequest) (*ListStoreAppealsReply, error)
+	// B端查询本店的一条申诉
+	GetAppeal(context.Context, *GetAppealRequest) (*GetAppealReply, error)
+	// B端补充申诉材料
+	SupplementAppeal(context.Context, *SupplementAppealRequest) (*SupplementAppealReply, error)
+	// B端撤回申诉
+	WithdrawAppeal(context.Context, *WithdrawAppealRequest) (*WithdrawAppealReply, error)
+	// O端要求商家补充申诉材料
+	RequestAppealInfo(context.Context, *RequestAppealInfoRequest) (*RequestAppealInfoReply, error)
+	// 查询评价的全部申诉轮次和沟通记录
+	GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error)
+	// B端回复模板
+	CreateReplyTemplate(context.Context, *CreateReplyTemplateRequest) (*CreateReplyTemplateReply, error)
+	UpdateReplyTemplate(context.Context, *UpdateReplyTemplateRequest) (*UpdateReplyTemplateReply, error)
+	DeleteReplyTemplate(context.Context, *DeleteReplyTemplateRequest) (*DeleteReplyTemplateReply, error)
+	ListReplyTemplates(context.Context, *ListReplyTemplatesRequest) (*ListReplyTemplatesReply, error)
+	// B端自动回复规则
+	CreateAutoReplyRule(context.Context, *CreateAutoReplyRuleRequest) (*CreateAutoReplyRuleReply, error)
+	UpdateAutoReplyRule(context.Context, *UpdateAutoReplyRuleRequest) (*UpdateAutoReplyRuleReply, error)
+	DeleteAutoReplyRule(context.Context, *DeleteAutoReplyRuleRequest) (*DeleteAutoReplyRuleReply, error)
+	ListAutoReplyRules(context.Context, *ListAutoReplyRulesRequest) (*ListAutoReplyRulesReply, error)
+	// webhook订阅,ownerType 1店铺只收本店事件;2应用收全部事件
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
+	// webhook投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesReply, error)
 	mustEmbedUnimplementedReviewServer()
 }
 
-// UnimplementedReviewServer must be embedded to have forward compatible implementations.
-type UnimplementedReviewServer struct {
+// UnimplementedReviewServer must be embedded to have forward compatible implementations.
+type UnimplementedReviewServer struct {
+}
+
+func (UnimplementedReviewServer) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedReviewServer) UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedReviewServer) GetReviewDetail(context.Context, *GetReviewDetailRequest) (*GetReviewDetailReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewDetail not implemented")
+}
+func (UnimplementedReviewServer) GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviews not implemented")
+}
+func (UnimplementedReviewServer) ListUserReviews(context.Context, *ListUserReviewsRequest) (*ListUserReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserReviews not implemented")
+}
+func (UnimplementedReviewServer) BatchGetReviews(context.Context, *BatchGetReviewsRequest) (*BatchGetReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetReviews not implemented")
+}
+func (UnimplementedReviewServer) GetReviewsByOrderIDs(context.Context, *GetReviewsByOrderIDsRequest) (*GetReviewsByOrderIDsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewsByOrderIDs not implemented")
+}
+func (UnimplementedReviewServer) ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
+func (UnimplementedReviewServer) ReplyReviewUpdate(context.Context, *ReplyReviewUpdateRequest) (*ReplyReviewUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReviewUpdate not implemented")
+}
+func (UnimplementedReviewServer) AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+}
+func (UnimplementedReviewServer) AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditReview not implemented")
+}
+func (UnimplementedReviewServer) AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditAppeal not implemented")
+}
+func (UnimplementedReviewServer) BatchAuditReviews(context.Context, *BatchAuditReviewsRequest) (*BatchAuditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAuditReviews not implemented")
+}
+func (UnimplementedReviewServer) BatchAuditAppeals(context.Context, *BatchAuditAppealsRequest) (*BatchAuditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAuditAppeals not implemented")
+}
+func (UnimplementedReviewServer) ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByStoreID not implemented")
+}
+func (UnimplementedReviewServer) ListReviewByContent(context.Context, *ListReviewByContentRequest) (*ListReviewByContentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByContent not implemented")
+}
+func (UnimplementedReviewServer) VoteReview(context.Context, *VoteReviewRequest) (*VoteReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteReview not implemented")
+}
+func (UnimplementedReviewServer) UnvoteReview(context.Context, *UnvoteReviewRequest) (*UnvoteReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnvoteReview not implemented")
+}
+func (UnimplementedReviewServer) ReportReview(context.Context, *ReportReviewRequest) (*ReportReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReview not implemented")
+}
+func (UnimplementedReviewServer) ListReportedReviews(context.Context, *ListReportedReviewsRequest) (*ListReportedReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportedReviews not implemented")
+}
+func (UnimplementedReviewServer) ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedReviewServer) ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingAppeals not implemented")
+}
+func (UnimplementedReviewServer) ListStoreReviews(context.Context, *ListStoreReviewsRequest) (*ListStoreReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreReviews not implemented")
+}
+func (UnimplementedReviewServer) CreateReviewExport(context.Context, *CreateReviewExportRequest) (*CreateReviewExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewExport not implemented")
+}
+func (UnimplementedReviewServer) GetReviewExport(context.Context, *GetReviewExportRequest) (*GetReviewExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewExport not implemented")
+}
+func (UnimplementedReviewServer) ListReviewExports(context.Context, *ListReviewExportsRequest) (*ListReviewExportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewExports not implemented")
+}
+func (UnimplementedReviewServer) DownloadReviewExport(*DownloadReviewExportRequest, Review_DownloadReviewExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadReviewExport not implemented")
+}
+func (UnimplementedReviewServer) ListStoreAppeals(context.Context, *ListStoreAppealsRequest) (*ListStoreAppealsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoreAppeals not implemented")
+}
+func (UnimplementedReviewServer) GetAppeal(context.Context, *GetAppealRequest) (*GetAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppeal not implemented")
+}
+func (UnimplementedReviewServer) SupplementAppeal(context.Context, *SupplementAppealRequest) (*SupplementAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplementAppeal not implemented")
+}
+func (UnimplementedReviewServer) WithdrawAppeal(context.Context, *WithdrawAppealRequest) (*WithdrawAppealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAppeal not implemented")
+}
+func (UnimplementedReviewServer) RequestAppealInfo(context.Context, *RequestAppealInfoRequest) (*RequestAppealInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAppealInfo not implemented")
+}
+func (UnimplementedReviewServer) GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppealHistory not implemented")
+}
+func (UnimplementedReviewServer) CreateReplyTemplate(context.Context, *CreateReplyTemplateRequest) (*CreateReplyTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplyTemplate not implemented")
+}
+func (UnimplementedReviewServer) UpdateReplyTemplate(context.Context, *UpdateReplyTemplateRequest) (*UpdateReplyTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplyTemplate not implemented")
+}
+func (UnimplementedReviewServer) DeleteReplyTemplate(context.Context, *DeleteReplyTemplateRequest) (*DeleteReplyTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplyTemplate not implemented")
+}
+func (UnimplementedReviewServer) ListReplyTemplates(context.Context, *ListReplyTemplatesRequest) (*ListReplyTemplatesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplyTemplates not implemented")
+}
+func (UnimplementedReviewServer) CreateAutoReplyRule(context.Context, *CreateAutoReplyRuleRequest) (*CreateAutoReplyRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAutoReplyRule not implemented")
+}
+func (UnimplementedReviewServer) UpdateAutoReplyRule(context.Context, *UpdateAutoReplyRuleRequest) (*UpdateAutoReplyRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoReplyRule not implemented")
+}
+func (UnimplementedReviewServer) DeleteAutoReplyRule(context.Context, *DeleteAutoReplyRuleRequest) (*DeleteAutoReplyRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoReplyRule not implemented")
+}
+func (UnimplementedReviewServer) ListAutoReplyRules(context.Context, *ListAutoReplyRulesRequest) (*ListAutoReplyRulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoReplyRules not implemented")
+}
+func (UnimplementedReviewServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedReviewServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedReviewServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedReviewServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
+
+// UnsafeReviewServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewServer will
+// result in compilation errors.
+type UnsafeReviewServer interface {
+	mustEmbedUnimplementedReviewServer()
+}
+
+func RegisterReviewServer(s grpc.ServiceRegistrar, srv ReviewServer) {
+	s.RegisterService(&Review_ServiceDesc, srv)
+}
+
+func _Review_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetReview(ctx, req.(*GetReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetReviewDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetReviewDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetReviewDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetReviewDetail(ctx, req.(*GetReviewDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetAllReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetAllReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetAllReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetAllReviews(ctx, req.(*GetAllReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListUserReviews(ctx, req.(*ListUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_BatchGetReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).BatchGetReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_BatchGetReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).BatchGetReviews(ctx, req.(*BatchGetReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetReviewsByOrderIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewsByOrderIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetReviewsByOrderIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetReviewsByOrderIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetReviewsByOrderIDs(ctx, req.(*GetReviewsByOrderIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ReplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ReplyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ReplyReview(ctx, req.(*ReplyReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ReplyReviewUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyReviewUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ReplyReviewUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ReplyReviewUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ReplyReviewUpdate(ctx, req.(*ReplyReviewUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AppealReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AppealReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AppealReview(ctx, req.(*AppealReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AuditReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AuditReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AuditReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AuditReview(ctx, req.(*AuditReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AuditAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AuditAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_AuditAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AuditAppeal(ctx, req.(*AuditAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_BatchAuditReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAuditReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).BatchAuditReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_BatchAuditReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).BatchAuditReviews(ctx, req.(*BatchAuditReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_BatchAuditAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAuditAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).BatchAuditAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_BatchAuditAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).BatchAuditAppeals(ctx, req.(*BatchAuditAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReviewByStoreID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewByStoreIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReviewByStoreID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReviewByStoreID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReviewByStoreID(ctx, req.(*ListReviewByStoreIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReviewByContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewByContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReviewByContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReviewByContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReviewByContent(ctx, req.(*ListReviewByContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_VoteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).VoteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_VoteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).VoteReview(ctx, req.(*VoteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_UnvoteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnvoteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).UnvoteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_UnvoteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).UnvoteReview(ctx, req.(*UnvoteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ReportReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ReportReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ReportReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ReportReview(ctx, req.(*ReportReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReportedReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportedReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReportedReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReportedReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReportedReviews(ctx, req.(*ListReportedReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListPendingReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListPendingReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListPendingAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListPendingAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListPendingAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListPendingAppeals(ctx, req.(*ListPendingAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListStoreReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListStoreReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListStoreReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListStoreReviews(ctx, req.(*ListStoreReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_CreateReviewExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CreateReviewExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_CreateReviewExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CreateReviewExport(ctx, req.(*CreateReviewExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetReviewExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetReviewExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetReviewExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetReviewExport(ctx, req.(*GetReviewExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_ListReviewExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListReviewExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListReviewExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListReviewExports(ctx, req.(*ListReviewExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedReviewServer) CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
-}
-func (UnimplementedReviewServer) UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
-}
-func (UnimplementedReviewServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
-}
-func (UnimplementedReviewServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
-}
-func (UnimplementedReviewServer) GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllReviews not implemented")
+func _Review_DownloadReviewExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadReviewExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServer).DownloadReviewExport(m, &reviewDownloadReviewExportServer{stream})
 }
-func (UnimplementedReviewServer) ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+
+type Review_DownloadReviewExportServer interface {
+	Send(*DownloadReviewExportReply) error
+	grpc.ServerStream
 }
-func (UnimplementedReviewServer) ReplyReviewUpdate(context.Context, *ReplyReviewUpdateRequest) (*ReplyReviewUpdateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplyReviewUpdate not implemented")
+
+type reviewDownloadReviewExportServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedReviewServer) AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+
+func (x *reviewDownloadReviewExportServer) Send(m *DownloadReviewExportReply) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedReviewServer) AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuditReview not implemented")
+
+func _Review_ListStoreAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoreAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).ListStoreAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_ListStoreAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).ListStoreAppeals(ctx, req.(*ListStoreAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServer) AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuditAppeal not implemented")
+
+func _Review_GetAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetAppeal(ctx, req.(*GetAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServer) ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByStoreID not implemented")
+
+func _Review_SupplementAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplementAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).SupplementAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_SupplementAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).SupplementAppeal(ctx, req.(*SupplementAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServer) ListReviewByContent(context.Context, *ListReviewByContentRequest) (*ListReviewByContentReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByContent not implemented")
+
+func _Review_WithdrawAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).WithdrawAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_WithdrawAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).WithdrawAppeal(ctx, req.(*WithdrawAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
 
-// UnsafeReviewServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ReviewServer will
-// result in compilation errors.
-type UnsafeReviewServer interface {
-	mustEmbedUnimplementedReviewServer()
+func _Review_RequestAppealInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAppealInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).RequestAppealInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_RequestAppealInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).RequestAppealInfo(ctx, req.(*RequestAppealInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterReviewServer(s grpc.ServiceRegistrar, srv ReviewServer) {
-	s.RegisterService(&Review_ServiceDesc, srv)
+func _Review_GetAppealHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppealHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetAppealHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Review_GetAppealHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetAppealHistory(ctx, req.(*GetAppealHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReviewRequest)
+func _Review_CreateReplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplyTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).CreateReview(ctx, in)
+		return srv.(ReviewServer).CreateReplyTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_CreateReview_FullMethodName,
+		FullMethod: Review_CreateReplyTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).CreateReview(ctx, req.(*CreateReviewRequest))
+		return srv.(ReviewServer).CreateReplyTemplate(ctx, req.(*CreateReplyTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateReviewRequest)
+func _Review_UpdateReplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplyTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).UpdateReview(ctx, in)
+		return srv.(ReviewServer).UpdateReplyTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_UpdateReview_FullMethodName,
+		FullMethod: Review_UpdateReplyTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+		return srv.(ReviewServer).UpdateReplyTemplate(ctx, req.(*UpdateReplyTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReviewRequest)
+func _Review_DeleteReplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplyTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).DeleteReview(ctx, in)
+		return srv.(ReviewServer).DeleteReplyTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_DeleteReview_FullMethodName,
+		FullMethod: Review_DeleteReplyTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+		return srv.(ReviewServer).DeleteReplyTemplate(ctx, req.(*DeleteReplyTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReviewRequest)
+func _Review_ListReplyTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplyTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).GetReview(ctx, in)
+		return srv.(ReviewServer).ListReplyTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_GetReview_FullMethodName,
+		FullMethod: Review_ListReplyTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).GetReview(ctx, req.(*GetReviewRequest))
+		return srv.(ReviewServer).ListReplyTemplates(ctx, req.(*ListReplyTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_GetAllReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllReviewsRequest)
+func _Review_CreateAutoReplyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAutoReplyRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).GetAllReviews(ctx, in)
+		return srv.(ReviewServer).CreateAutoReplyRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_GetAllReviews_FullMethodName,
+		FullMethod: Review_CreateAutoReplyRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).GetAllReviews(ctx, req.(*GetAllReviewsRequest))
+		return srv.(ReviewServer).CreateAutoReplyRule(ctx, req.(*CreateAutoReplyRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReplyReviewRequest)
+func _Review_UpdateAutoReplyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAutoReplyRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).ReplyReview(ctx, in)
+		return srv.(ReviewServer).UpdateAutoReplyRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_ReplyReview_FullMethodName,
+		FullMethod: Review_UpdateAutoReplyRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).ReplyReview(ctx, req.(*ReplyReviewRequest))
+		return srv.(ReviewServer).UpdateAutoReplyRule(ctx, req.(*UpdateAutoReplyRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_ReplyReviewUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReplyReviewUpdateRequest)
+func _Review_DeleteAutoReplyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoReplyRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).ReplyReviewUpdate(ctx, in)
+		return srv.(ReviewServer).DeleteAutoReplyRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_ReplyReviewUpdate_FullMethodName,
+		FullMethod: Review_DeleteAutoReplyRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).ReplyReviewUpdate(ctx, req.(*ReplyReviewUpdateRequest))
+		return srv.(ReviewServer).DeleteAutoReplyRule(ctx, req.(*DeleteAutoReplyRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppealReviewRequest)
+func _Review_ListAutoReplyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoReplyRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).AppealReview(ctx, in)
+		return srv.(ReviewServer).ListAutoReplyRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_AppealReview_FullMethodName,
+		FullMethod: Review_ListAutoReplyRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).AppealReview(ctx, req.(*AppealReviewRequest))
+		return srv.(ReviewServer).ListAutoReplyRules(ctx, req.(*ListAutoReplyRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_AuditReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuditReviewRequest)
+func _Review_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).AuditReview(ctx, in)
+		return srv.(ReviewServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_AuditReview_FullMethodName,
+		FullMethod: Review_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).AuditReview(ctx, req.(*AuditReviewRequest))
+		return srv.(ReviewServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_AuditAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuditAppealRequest)
+func _Review_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).AuditAppeal(ctx, in)
+		return srv.(ReviewServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_AuditAppeal_FullMethodName,
+		FullMethod: Review_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).AuditAppeal(ctx, req.(*AuditAppealRequest))
+		return srv.(ReviewServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_ListReviewByStoreID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReviewByStoreIDRequest)
+func _Review_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).ListReviewByStoreID(ctx, in)
+		return srv.(ReviewServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_ListReviewByStoreID_FullMethodName,
+		FullMethod: Review_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).ListReviewByStoreID(ctx, req.(*ListReviewByStoreIDRequest))
+		return srv.(ReviewServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Review_ListReviewByContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReviewByContentRequest)
+func _Review_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServer).ListReviewByContent(ctx, in)
+		return srv.(ReviewServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Review_ListReviewByContent_FullMethodName,
+		FullMethod: Review_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServer).ListReviewByContent(ctx, req.(*ListReviewByContentRequest))
+		return srv.(ReviewServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -500,10 +1736,26 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReview",
 			Handler:    _Review_GetReview_Handler,
 		},
+		{
+			MethodName: "GetReviewDetail",
+			Handler:    _Review_GetReviewDetail_Handler,
+		},
 		{
 			MethodName: "GetAllReviews",
 			Handler:    _Review_GetAllReviews_Handler,
 		},
+		{
+			MethodName: "ListUserReviews",
+			Handler:    _Review_ListUserReviews_Handler,
+		},
+		{
+			MethodName: "BatchGetReviews",
+			Handler:    _Review_BatchGetReviews_Handler,
+		},
+		{
+			MethodName: "GetReviewsByOrderIDs",
+			Handler:    _Review_GetReviewsByOrderIDs_Handler,
+		},
 		{
 			MethodName: "ReplyReview",
 			Handler:    _Review_ReplyReview_Handler,
@@ -524,6 +1776,14 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuditAppeal",
 			Handler:    _Review_AuditAppeal_Handler,
 		},
+		{
+			MethodName: "BatchAuditReviews",
+			Handler:    _Review_BatchAuditReviews_Handler,
+		},
+		{
+			MethodName: "BatchAuditAppeals",
+			Handler:    _Review_BatchAuditAppeals_Handler,
+		},
 		{
 			MethodName: "ListReviewByStoreID",
 			Handler:    _Review_ListReviewByStoreID_Handler,
@@ -532,7 +1792,125 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReviewByContent",
 			Handler:    _Review_ListReviewByContent_Handler,
 		},
+		{
+			MethodName: "VoteReview",
+			Handler:    _Review_VoteReview_Handler,
+		},
+		{
+			MethodName: "UnvoteReview",
+			Handler:    _Review_UnvoteReview_Handler,
+		},
+		{
+			MethodName: "ReportReview",
+			Handler:    _Review_ReportReview_Handler,
+		},
+		{
+			MethodName: "ListReportedReviews",
+			Handler:    _Review_ListReportedReviews_Handler,
+		},
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _Review_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "ListPendingAppeals",
+			Handler:    _Review_ListPendingAppeals_Handler,
+		},
+		{
+			MethodName: "ListStoreReviews",
+			Handler:    _Review_ListStoreReviews_Handler,
+		},
+		{
+			MethodName: "CreateReviewExport",
+			Handler:    _Review_CreateReviewExport_Handler,
+		},
+		{
+			MethodName: "GetReviewExport",
+			Handler:    _Review_GetReviewExport_Handler,
+		},
+		{
+			MethodName: "ListReviewExports",
+			Handler:    _Review_ListReviewExports_Handler,
+		},
+		{
+			MethodName: "ListStoreAppeals",
+			Handler:    _Review_ListStoreAppeals_Handler,
+		},
+		{
+			MethodName: "GetAppeal",
+			Handler:    _Review_GetAppeal_Handler,
+		},
+		{
+			MethodName: "SupplementAppeal",
+			Handler:    _Review_SupplementAppeal_Handler,
+		},
+		{
+			MethodName: "WithdrawAppeal",
+			Handler:    _Review_WithdrawAppeal_Handler,
+		},
+		{
+			MethodName: "RequestAppealInfo",
+			Handler:    _Review_RequestAppealInfo_Handler,
+		},
+		{
+			MethodName: "GetAppealHistory",
+			Handler:    _Review_GetAppealHistory_Handler,
+		},
+		{
+			MethodName: "CreateReplyTemplate",
+			Handler:    _Review_CreateReplyTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateReplyTemplate",
+			Handler:    _Review_UpdateReplyTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteReplyTemplate",
+			Handler:    _Review_DeleteReplyTemplate_Handler,
+		},
+		{
+			MethodName: "ListReplyTemplates",
+			Handler:    _Review_ListReplyTemplates_Handler,
+		},
+		{
+			MethodName: "CreateAutoReplyRule",
+			Handler:    _Review_CreateAutoReplyRule_Handler,
+		},
+		{
+			MethodName: "UpdateAutoReplyRule",
+			Handler:    _Review_UpdateAutoReplyRule_Handler,
+		},
+		{
+			MethodName: "DeleteAutoReplyRule",
+			Handler:    _Review_DeleteAutoReplyRule_Handler,
+		},
+		{
+			MethodName: "ListAutoReplyRules",
+			Handler:    _Review_ListAutoReplyRules_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Review_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Review_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Review_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Review_ListWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadReviewExport",
+			Handler:       _Review_DownloadReviewExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "review/v1/review.proto",
 }
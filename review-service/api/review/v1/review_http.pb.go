@@ -22,15 +22,49 @@ const _ = http.SupportPackageIsVersion1
 const OperationReviewAppealReview = "/api.review.v1.Review/AppealReview"
 const OperationReviewAuditAppeal = "/api.review.v1.Review/AuditAppeal"
 const OperationReviewAuditReview = "/api.review.v1.Review/AuditReview"
+const OperationReviewBatchAuditAppeals = "/api.review.v1.Review/BatchAuditAppeals"
+const OperationReviewBatchAuditReviews = "/api.review.v1.Review/BatchAuditReviews"
+const OperationReviewBatchGetReviews = "/api.review.v1.Review/BatchGetReviews"
+const OperationReviewCreateAutoReplyRule = "/api.review.v1.Review/CreateAutoReplyRule"
+const OperationReviewCreateReplyTemplate = "/api.review.v1.Review/CreateReplyTemplate"
 const OperationReviewCreateReview = "/api.review.v1.Review/CreateReview"
+const OperationReviewCreateReviewExport = "/api.review.v1.Review/CreateReviewExport"
+const OperationReviewCreateWebhook = "/api.review.v1.Review/CreateWebhook"
+const OperationReviewDeleteAutoReplyRule = "/api.review.v1.Review/DeleteAutoReplyRule"
+const OperationReviewDeleteReplyTemplate = "/api.review.v1.Review/DeleteReplyTemplate"
 const OperationReviewDeleteReview = "/api.review.v1.Review/DeleteReview"
+const OperationReviewDeleteWebhook = "/api.review.v1.Review/DeleteWebhook"
 const OperationReviewGetAllReviews = "/api.review.v1.Review/GetAllReviews"
+const OperationReviewGetAppeal = "/api.review.v1.Review/GetAppeal"
+const OperationReviewGetAppealHistory = "/api.review.v1.Review/GetAppealHistory"
 const OperationReviewGetReview = "/api.review.v1.Review/GetReview"
+const OperationReviewGetReviewDetail = "/api.review.v1.Review/GetReviewDetail"
+const OperationReviewGetReviewExport = "/api.review.v1.Review/GetReviewExport"
+const OperationReviewGetReviewsByOrderIDs = "/api.review.v1.Review/GetReviewsByOrderIDs"
+const OperationReviewListAutoReplyRules = "/api.review.v1.Review/ListAutoReplyRules"
+const OperationReviewListPendingAppeals = "/api.review.v1.Review/ListPendingAppeals"
+const OperationReviewListPendingReviews = "/api.review.v1.Review/ListPendingReviews"
+const OperationReviewListReplyTemplates = "/api.review.v1.Review/ListReplyTemplates"
+const OperationReviewListReportedReviews = "/api.review.v1.Review/ListReportedReviews"
 const OperationReviewListReviewByContent = "/api.review.v1.Review/ListReviewByContent"
 const OperationReviewListReviewByStoreID = "/api.review.v1.Review/ListReviewByStoreID"
+const OperationReviewListReviewExports = "/api.review.v1.Review/ListReviewExports"
+const OperationReviewListStoreAppeals = "/api.review.v1.Review/ListStoreAppeals"
+const OperationReviewListStoreReviews = "/api.review.v1.Review/ListStoreReviews"
+const OperationReviewListUserReviews = "/api.review.v1.Review/ListUserReviews"
+const OperationReviewListWebhookDeliveries = "/api.review.v1.Review/ListWebhookDeliveries"
+const OperationReviewListWebhooks = "/api.review.v1.Review/ListWebhooks"
 const OperationReviewReplyReview = "/api.review.v1.Review/ReplyReview"
 const OperationReviewReplyReviewUpdate = "/api.review.v1.Review/ReplyReviewUpdate"
+const OperationReviewReportReview = "/api.review.v1.Review/ReportReview"
+const OperationReviewRequestAppealInfo = "/api.review.v1.Review/RequestAppealInfo"
+const OperationReviewSupplementAppeal = "/api.review.v1.Review/SupplementAppeal"
+const OperationReviewUnvoteReview = "/api.review.v1.Review/UnvoteReview"
+const OperationReviewUpdateAutoReplyRule = "/api.review.v1.Review/UpdateAutoReplyRule"
+const OperationReviewUpdateReplyTemplate = "/api.review.v1.Review/UpdateReplyTemplate"
 const OperationReviewUpdateReview = "/api.review.v1.Review/UpdateReview"
+const OperationReviewVoteReview = "/api.review.v1.Review/VoteReview"
+const OperationReviewWithdrawAppeal = "/api.review.v1.Review/WithdrawAppeal"
 
 type ReviewHTTPServer interface {
 	// AppealReviewB端申诉评价
@@ -39,23 +73,82 @@ type ReviewHTTPServer interface {
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
 	// AuditReviewO端审核评价
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
+	// BatchAuditAppealsO端批量审核申诉,逐条处理并返回每条的结果
+	BatchAuditAppeals(context.Context, *BatchAuditAppealsRequest) (*BatchAuditReply, error)
+	// BatchAuditReviewsO端批量审核评价,逐条处理并返回每条的结果
+	BatchAuditReviews(context.Context, *BatchAuditReviewsRequest) (*BatchAuditReply, error)
+	// BatchGetReviews按评价ID批量查询，查不到的ID放在notFound里
+	BatchGetReviews(context.Context, *BatchGetReviewsRequest) (*BatchGetReviewsReply, error)
+	// CreateAutoReplyRuleB端自动回复规则
+	CreateAutoReplyRule(context.Context, *CreateAutoReplyRuleRequest) (*CreateAutoReplyRuleReply, error)
+	// CreateReplyTemplateB端回复模板
+	CreateReplyTemplate(context.Context, *CreateReplyTemplateRequest) (*CreateReplyTemplateReply, error)
 	// CreateReviewc端创建评价
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
+	// CreateReviewExportB端导出本店评价，异步生成csv/xlsx文件，筛选条件同ListStoreReviews
+	CreateReviewExport(context.Context, *CreateReviewExportRequest) (*CreateReviewExportReply, error)
+	// CreateWebhookwebhook订阅,ownerType 1店铺只收本店事件;2应用收全部事件
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookReply, error)
+	DeleteAutoReplyRule(context.Context, *DeleteAutoReplyRuleRequest) (*DeleteAutoReplyRuleReply, error)
+	DeleteReplyTemplate(context.Context, *DeleteReplyTemplateRequest) (*DeleteReplyTemplateReply, error)
 	// DeleteReviewc端删除评价
 	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewReply, error)
-	// GetAllReviewsc端获取自己的全部评价详情
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookReply, error)
+	// GetAllReviewsc端获取自己的全部评价详情，不分页，已被ListUserReviews取代
 	GetAllReviews(context.Context, *GetAllReviewsRequest) (*GetAllReviewsReply, error)
+	// GetAppealB端查询本店的一条申诉
+	GetAppeal(context.Context, *GetAppealRequest) (*GetAppealReply, error)
+	// GetAppealHistory查询评价的全部申诉轮次和沟通记录
+	GetAppealHistory(context.Context, *GetAppealHistoryRequest) (*GetAppealHistoryReply, error)
 	// GetReviewc端获取评价详情
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// GetReviewDetail评价详情，带商家回复；O端运营和B端商家查本店评价时还带最近一次申诉和审核信息
+	GetReviewDetail(context.Context, *GetReviewDetailRequest) (*GetReviewDetailReply, error)
+	// GetReviewExportB端查询导出任务状态
+	GetReviewExport(context.Context, *GetReviewExportRequest) (*GetReviewExportReply, error)
+	// GetReviewsByOrderIDs按订单ID批量查询评价，订单列表页展示评价状态用，没有评价的订单放在notFound里
+	GetReviewsByOrderIDs(context.Context, *GetReviewsByOrderIDsRequest) (*GetReviewsByOrderIDsReply, error)
+	ListAutoReplyRules(context.Context, *ListAutoReplyRulesRequest) (*ListAutoReplyRulesReply, error)
+	// ListPendingAppealsO端查询待审核申诉,按创建时间正序
+	ListPendingAppeals(context.Context, *ListPendingAppealsRequest) (*ListPendingAppealsReply, error)
+	// ListPendingReviewsO端查询待审核评价,按创建时间正序
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListPendingReviewsReply, error)
+	ListReplyTemplates(context.Context, *ListReplyTemplatesRequest) (*ListReplyTemplatesReply, error)
+	// ListReportedReviewsO端查询被举报的评价
+	ListReportedReviews(context.Context, *ListReportedReviewsRequest) (*ListReportedReviewsReply, error)
 	ListReviewByContent(context.Context, *ListReviewByContentRequest) (*ListReviewByContentReply, error)
 	// ListReviewByStoreID根据商家ID查询评价列表
 	ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error)
+	ListReviewExports(context.Context, *ListReviewExportsRequest) (*ListReviewExportsReply, error)
+	// ListStoreAppealsB端查询本店的申诉
+	ListStoreAppeals(context.Context, *ListStoreAppealsRequest) (*ListStoreAppealsReply, error)
+	// ListStoreReviewsB端查询本店的评价
+	ListStoreReviews(context.Context, *ListStoreReviewsRequest) (*ListStoreReviewsReply, error)
+	// ListUserReviewsc端我的评价，按状态、是否有回复和时间筛选，分页
+	ListUserReviews(context.Context, *ListUserReviewsRequest) (*ListUserReviewsReply, error)
+	// ListWebhookDeliverieswebhook投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
 	// ReplyReviewB端回复评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
 	// ReplyReviewUpdateB端修改回复
 	ReplyReviewUpdate(context.Context, *ReplyReviewUpdateRequest) (*ReplyReviewUpdateReply, error)
+	// ReportReviewc端举报评价
+	ReportReview(context.Context, *ReportReviewRequest) (*ReportReviewReply, error)
+	// RequestAppealInfoO端要求商家补充申诉材料
+	RequestAppealInfo(context.Context, *RequestAppealInfoRequest) (*RequestAppealInfoReply, error)
+	// SupplementAppealB端补充申诉材料
+	SupplementAppeal(context.Context, *SupplementAppealRequest) (*SupplementAppealReply, error)
+	// UnvoteReviewc端取消有用
+	UnvoteReview(context.Context, *UnvoteReviewRequest) (*UnvoteReviewReply, error)
+	UpdateAutoReplyRule(context.Context, *UpdateAutoReplyRuleRequest) (*UpdateAutoReplyRuleReply, error)
+	UpdateReplyTemplate(context.Context, *UpdateReplyTemplateRequest) (*UpdateReplyTemplateReply, error)
 	// UpdateReviewc端更新评价
 	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error)
+	// VoteReviewc端给评价点有用
+	VoteReview(context.Context, *VoteReviewRequest) (*VoteReviewReply, error)
+	// WithdrawAppealB端撤回申诉
+	WithdrawAppeal(context.Context, *WithdrawAppealRequest) (*WithdrawAppealReply, error)
 }
 
 func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
@@ -64,14 +157,48 @@ func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
 	r.PUT("/v1/update/{reviewID}", _Review_UpdateReview0_HTTP_Handler(srv))
 	r.DELETE("/v1/delete/{reviewID}", _Review_DeleteReview0_HTTP_Handler(srv))
 	r.GET("v1/review/{reviewID}", _Review_GetReview0_HTTP_Handler(srv))
+	r.GET("v1/review/{reviewID}/detail", _Review_GetReviewDetail0_HTTP_Handler(srv))
 	r.GET("v1/reviews/{userID}", _Review_GetAllReviews0_HTTP_Handler(srv))
+	r.GET("v1/user/{userID}/reviews", _Review_ListUserReviews0_HTTP_Handler(srv))
+	r.GET("v1/reviews/batch", _Review_BatchGetReviews0_HTTP_Handler(srv))
+	r.GET("v1/orders/reviews", _Review_GetReviewsByOrderIDs0_HTTP_Handler(srv))
 	r.POST("/v1/review/reply", _Review_ReplyReview0_HTTP_Handler(srv))
 	r.PUT("/v1/review/replyupdate", _Review_ReplyReviewUpdate0_HTTP_Handler(srv))
 	r.POST("v1/review/appeal", _Review_AppealReview0_HTTP_Handler(srv))
 	r.POST("v1/review/audit", _Review_AuditReview0_HTTP_Handler(srv))
 	r.POST("v1/appeal/audit", _Review_AuditAppeal0_HTTP_Handler(srv))
+	r.POST("v1/reviews/audit", _Review_BatchAuditReviews0_HTTP_Handler(srv))
+	r.POST("v1/appeals/audit", _Review_BatchAuditAppeals0_HTTP_Handler(srv))
 	r.GET("/v1/{storeID}/reviews", _Review_ListReviewByStoreID0_HTTP_Handler(srv))
 	r.GET("/v1/reviewshavecontent", _Review_ListReviewByContent0_HTTP_Handler(srv))
+	r.POST("/v1/review/{reviewID}/vote", _Review_VoteReview0_HTTP_Handler(srv))
+	r.DELETE("/v1/review/{reviewID}/vote", _Review_UnvoteReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/{reviewID}/report", _Review_ReportReview0_HTTP_Handler(srv))
+	r.GET("/v1/reviews/reported", _Review_ListReportedReviews0_HTTP_Handler(srv))
+	r.GET("/v1/reviews/pending", _Review_ListPendingReviews0_HTTP_Handler(srv))
+	r.GET("/v1/appeals/pending", _Review_ListPendingAppeals0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/reviews", _Review_ListStoreReviews0_HTTP_Handler(srv))
+	r.POST("/v1/store/{storeID}/review/export", _Review_CreateReviewExport0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/review/export/{exportID}", _Review_GetReviewExport0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/review/exports", _Review_ListReviewExports0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/appeals", _Review_ListStoreAppeals0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/appeal/{appealID}", _Review_GetAppeal0_HTTP_Handler(srv))
+	r.POST("/v1/store/{storeID}/appeal/{appealID}/supplement", _Review_SupplementAppeal0_HTTP_Handler(srv))
+	r.POST("/v1/store/{storeID}/appeal/{appealID}/withdraw", _Review_WithdrawAppeal0_HTTP_Handler(srv))
+	r.POST("/v1/appeal/{appealID}/request-info", _Review_RequestAppealInfo0_HTTP_Handler(srv))
+	r.GET("/v1/review/{reviewID}/appeals", _Review_GetAppealHistory0_HTTP_Handler(srv))
+	r.POST("/v1/store/{storeID}/reply-template", _Review_CreateReplyTemplate0_HTTP_Handler(srv))
+	r.PUT("/v1/store/{storeID}/reply-template/{templateID}", _Review_UpdateReplyTemplate0_HTTP_Handler(srv))
+	r.DELETE("/v1/store/{storeID}/reply-template/{templateID}", _Review_DeleteReplyTemplate0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/reply-templates", _Review_ListReplyTemplates0_HTTP_Handler(srv))
+	r.POST("/v1/store/{storeID}/auto-reply-rule", _Review_CreateAutoReplyRule0_HTTP_Handler(srv))
+	r.PUT("/v1/store/{storeID}/auto-reply-rule/{ruleID}", _Review_UpdateAutoReplyRule0_HTTP_Handler(srv))
+	r.DELETE("/v1/store/{storeID}/auto-reply-rule/{ruleID}", _Review_DeleteAutoReplyRule0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/auto-reply-rules", _Review_ListAutoReplyRules0_HTTP_Handler(srv))
+	r.POST("/v1/webhook", _Review_CreateWebhook0_HTTP_Handler(srv))
+	r.DELETE("/v1/webhook/{subscriptionID}", _Review_DeleteWebhook0_HTTP_Handler(srv))
+	r.GET("/v1/webhooks", _Review_ListWebhooks0_HTTP_Handler(srv))
+	r.GET("/v1/webhook/{subscriptionID}/deliveries", _Review_ListWebhookDeliveries0_HTTP_Handler(srv))
 }
 
 func _Review_CreateReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
@@ -165,6 +292,28 @@ func _Review_GetReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context
 	}
 }
 
+func _Review_GetReviewDetail0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewDetailRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetReviewDetail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewDetail(ctx, req.(*GetReviewDetailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewDetailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_GetAllReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetAllReviewsRequest
@@ -187,6 +336,66 @@ func _Review_GetAllReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Con
 	}
 }
 
+func _Review_ListUserReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListUserReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserReviews(ctx, req.(*ListUserReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_BatchGetReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewBatchGetReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetReviews(ctx, req.(*BatchGetReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetReviewsByOrderIDs0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewsByOrderIDsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetReviewsByOrderIDs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewsByOrderIDs(ctx, req.(*GetReviewsByOrderIDsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewsByOrderIDsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_ReplyReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ReplyReviewRequest
@@ -297,6 +506,50 @@ func _Review_AuditAppeal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _Review_BatchAuditReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchAuditReviewsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewBatchAuditReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchAuditReviews(ctx, req.(*BatchAuditReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchAuditReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_BatchAuditAppeals0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchAuditAppealsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewBatchAuditAppeals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchAuditAppeals(ctx, req.(*BatchAuditAppealsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchAuditReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_ListReviewByStoreID0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListReviewByStoreIDRequest
@@ -338,86 +591,884 @@ func _Review_ListReviewByContent0_HTTP_Handler(srv ReviewHTTPServer) func(ctx ht
 	}
 }
 
-type ReviewHTTPClient interface {
-	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
-	AuditAppeal(ctx context.Context, req *AuditAppealRequest, opts ...http.CallOption) (rsp *AuditAppealReply, err error)
-	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
-	CreateReview(ctx context.Context, req *CreateReviewRequest, opts ...http.CallOption) (rsp *CreateReviewReply, err error)
-	DeleteReview(ctx context.Context, req *DeleteReviewRequest, opts ...http.CallOption) (rsp *DeleteReviewReply, err error)
-	GetAllReviews(ctx context.Context, req *GetAllReviewsRequest, opts ...http.CallOption) (rsp *GetAllReviewsReply, err error)
-	GetReview(ctx context.Context, req *GetReviewRequest, opts ...http.CallOption) (rsp *GetReviewReply, err error)
-	ListReviewByContent(ctx context.Context, req *ListReviewByContentRequest, opts ...http.CallOption) (rsp *ListReviewByContentReply, err error)
-	ListReviewByStoreID(ctx context.Context, req *ListReviewByStoreIDRequest, opts ...http.CallOption) (rsp *ListReviewByStoreIDReply, err error)
-	ReplyReview(ctx context.Context, req *ReplyReviewRequest, opts ...http.CallOption) (rsp *ReplyReviewReply, err error)
-	ReplyReviewUpdate(ctx context.Context, req *ReplyReviewUpdateRequest, opts ...http.CallOption) (rsp *ReplyReviewUpdateReply, err error)
-	UpdateReview(ctx context.Context, req *UpdateReviewRequest, opts ...http.CallOption) (rsp *UpdateReviewReply, err error)
+func _Review_VoteReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VoteReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewVoteReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VoteReview(ctx, req.(*VoteReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VoteReviewReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type ReviewHTTPClientImpl struct {
-	cc *http.Client
+func _Review_UnvoteReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnvoteReviewRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewUnvoteReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnvoteReview(ctx, req.(*UnvoteReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnvoteReviewReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewReviewHTTPClient(client *http.Client) ReviewHTTPClient {
-	return &ReviewHTTPClientImpl{client}
+func _Review_ReportReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewReportReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportReview(ctx, req.(*ReportReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportReviewReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *ReviewHTTPClientImpl) AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...http.CallOption) (*AppealReviewReply, error) {
-	var out AppealReviewReply
-	pattern := "v1/review/appeal"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationReviewAppealReview))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Review_ListReportedReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReportedReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReportedReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReportedReviews(ctx, req.(*ListReportedReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReportedReviewsReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...http.CallOption) (*AuditAppealReply, error) {
-	var out AuditAppealReply
-	pattern := "v1/appeal/audit"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationReviewAuditAppeal))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Review_ListPendingReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListPendingReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPendingReviewsReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...http.CallOption) (*AuditReviewReply, error) {
-	var out AuditReviewReply
-	pattern := "v1/review/audit"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationReviewAuditReview))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Review_ListPendingAppeals0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingAppealsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListPendingAppeals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingAppeals(ctx, req.(*ListPendingAppealsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPendingAppealsReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...http.CallOption) (*CreateReviewReply, error) {
-	var out CreateReviewReply
-	pattern := "/v1/review"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationReviewCreateReview))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Review_ListStoreReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStoreReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListStoreReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStoreReviews(ctx, req.(*ListStoreReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStoreReviewsReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *ReviewHTTPClientImpl) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...http.CallOption) (*DeleteReviewReply, error) {
-	var out DeleteReviewReply
-	pattern := "/v1/delete/{reviewID}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationReviewDeleteReview))
+func _Review_CreateReviewExport0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReviewExportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCreateReviewExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReviewExport(ctx, req.(*CreateReviewExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReviewExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetReviewExport0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetReviewExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewExport(ctx, req.(*GetReviewExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListReviewExports0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReviewExportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReviewExports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReviewExports(ctx, req.(*ListReviewExportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReviewExportsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListStoreAppeals0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListStoreAppealsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListStoreAppeals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListStoreAppeals(ctx, req.(*ListStoreAppealsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListStoreAppealsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetAppeal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppealRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppeal(ctx, req.(*GetAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_SupplementAppeal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SupplementAppealRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewSupplementAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SupplementAppeal(ctx, req.(*SupplementAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SupplementAppealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_WithdrawAppeal0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAppealRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewWithdrawAppeal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAppeal(ctx, req.(*WithdrawAppealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAppealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_RequestAppealInfo0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestAppealInfoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewRequestAppealInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestAppealInfo(ctx, req.(*RequestAppealInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestAppealInfoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetAppealHistory0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAppealHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetAppealHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAppealHistory(ctx, req.(*GetAppealHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAppealHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CreateReplyTemplate0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReplyTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCreateReplyTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReplyTemplate(ctx, req.(*CreateReplyTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReplyTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_UpdateReplyTemplate0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReplyTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewUpdateReplyTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReplyTemplate(ctx, req.(*UpdateReplyTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReplyTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DeleteReplyTemplate0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteReplyTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDeleteReplyTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReplyTemplate(ctx, req.(*DeleteReplyTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteReplyTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListReplyTemplates0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReplyTemplatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListReplyTemplates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReplyTemplates(ctx, req.(*ListReplyTemplatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReplyTemplatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CreateAutoReplyRule0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAutoReplyRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCreateAutoReplyRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAutoReplyRule(ctx, req.(*CreateAutoReplyRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAutoReplyRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_UpdateAutoReplyRule0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateAutoReplyRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewUpdateAutoReplyRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateAutoReplyRule(ctx, req.(*UpdateAutoReplyRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateAutoReplyRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DeleteAutoReplyRule0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAutoReplyRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDeleteAutoReplyRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAutoReplyRule(ctx, req.(*DeleteAutoReplyRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAutoReplyRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListAutoReplyRules0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAutoReplyRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListAutoReplyRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAutoReplyRules(ctx, req.(*ListAutoReplyRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAutoReplyRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CreateWebhook0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWebhookRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCreateWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWebhook(ctx, req.(*CreateWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_DeleteWebhook0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWebhookRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewDeleteWebhook)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteWebhookReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListWebhooks0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhooksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListWebhooks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhooks(ctx, req.(*ListWebhooksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhooksReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_ListWebhookDeliveries0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhookDeliveriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewListWebhookDeliveries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhookDeliveriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ReviewHTTPClient interface {
+	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
+	AuditAppeal(ctx context.Context, req *AuditAppealRequest, opts ...http.CallOption) (rsp *AuditAppealReply, err error)
+	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
+	BatchAuditAppeals(ctx context.Context, req *BatchAuditAppealsRequest, opts ...http.CallOption) (rsp *BatchAuditReply, err error)
+	BatchAuditReviews(ctx context.Context, req *BatchAuditReviewsRequest, opts ...http.CallOption) (rsp *BatchAuditReply, err error)
+	BatchGetReviews(ctx context.Context, req *BatchGetReviewsRequest, opts ...http.CallOption) (rsp *BatchGetReviewsReply, err error)
+	CreateAutoReplyRule(ctx context.Context, req *CreateAutoReplyRuleRequest, opts ...http.CallOption) (rsp *CreateAutoReplyRuleReply, err error)
+	CreateReplyTemplate(ctx context.Context, req *CreateReplyTemplateRequest, opts ...http.CallOption) (rsp *CreateReplyTemplateReply, err error)
+	CreateReview(ctx context.Context, req *CreateReviewRequest, opts ...http.CallOption) (rsp *CreateReviewReply, err error)
+	CreateReviewExport(ctx context.Context, req *CreateReviewExportRequest, opts ...http.CallOption) (rsp *CreateReviewExportReply, err error)
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest, opts ...http.CallOption) (rsp *CreateWebhookReply, err error)
+	DeleteAutoReplyRule(ctx context.Context, req *DeleteAutoReplyRuleRequest, opts ...http.CallOption) (rsp *DeleteAutoReplyRuleReply, err error)
+	DeleteReplyTemplate(ctx context.Context, req *DeleteReplyTemplateRequest, opts ...http.CallOption) (rsp *DeleteReplyTemplateReply, err error)
+	DeleteReview(ctx context.Context, req *DeleteReviewRequest, opts ...http.CallOption) (rsp *DeleteReviewReply, err error)
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest, opts ...http.CallOption) (rsp *DeleteWebhookReply, err error)
+	GetAllReviews(ctx context.Context, req *GetAllReviewsRequest, opts ...http.CallOption) (rsp *GetAllReviewsReply, err error)
+	GetAppeal(ctx context.Context, req *GetAppealRequest, opts ...http.CallOption) (rsp *GetAppealReply, err error)
+	GetAppealHistory(ctx context.Context, req *GetAppealHistoryRequest, opts ...http.CallOption) (rsp *GetAppealHistoryReply, err error)
+	GetReview(ctx context.Context, req *GetReviewRequest, opts ...http.CallOption) (rsp *GetReviewReply, err error)
+	GetReviewDetail(ctx context.Context, req *GetReviewDetailRequest, opts ...http.CallOption) (rsp *GetReviewDetailReply, err error)
+	GetReviewExport(ctx context.Context, req *GetReviewExportRequest, opts ...http.CallOption) (rsp *GetReviewExportReply, err error)
+	GetReviewsByOrderIDs(ctx context.Context, req *GetReviewsByOrderIDsRequest, opts ...http.CallOption) (rsp *GetReviewsByOrderIDsReply, err error)
+	ListAutoReplyRules(ctx context.Context, req *ListAutoReplyRulesRequest, opts ...http.CallOption) (rsp *ListAutoReplyRulesReply, err error)
+	ListPendingAppeals(ctx context.Context, req *ListPendingAppealsRequest, opts ...http.CallOption) (rsp *ListPendingAppealsReply, err error)
+	ListPendingReviews(ctx context.Context, req *ListPendingReviewsRequest, opts ...http.CallOption) (rsp *ListPendingReviewsReply, err error)
+	ListReplyTemplates(ctx context.Context, req *ListReplyTemplatesRequest, opts ...http.CallOption) (rsp *ListReplyTemplatesReply, err error)
+	ListReportedReviews(ctx context.Context, req *ListReportedReviewsRequest, opts ...http.CallOption) (rsp *ListReportedReviewsReply, err error)
+	ListReviewByContent(ctx context.Context, req *ListReviewByContentRequest, opts ...http.CallOption) (rsp *ListReviewByContentReply, err error)
+	ListReviewByStoreID(ctx context.Context, req *ListReviewByStoreIDRequest, opts ...http.CallOption) (rsp *ListReviewByStoreIDReply, err error)
+	ListReviewExports(ctx context.Context, req *ListReviewExportsRequest, opts ...http.CallOption) (rsp *ListReviewExportsReply, err error)
+	ListStoreAppeals(ctx context.Context, req *ListStoreAppealsRequest, opts ...http.CallOption) (rsp *ListStoreAppealsReply, err error)
+	ListStoreReviews(ctx context.Context, req *ListStoreReviewsRequest, opts ...http.CallOption) (rsp *ListStoreReviewsReply, err error)
+	ListUserReviews(ctx context.Context, req *ListUserReviewsRequest, opts ...http.CallOption) (rsp *ListUserReviewsReply, err error)
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest, opts ...http.CallOption) (rsp *ListWebhookDeliveriesReply, err error)
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest, opts ...http.CallOption) (rsp *ListWebhooksReply, err error)
+	ReplyReview(ctx context.Context, req *ReplyReviewRequest, opts ...http.CallOption) (rsp *ReplyReviewReply, err error)
+	ReplyReviewUpdate(ctx context.Context, req *ReplyReviewUpdateRequest, opts ...http.CallOption) (rsp *ReplyReviewUpdateReply, err error)
+	ReportReview(ctx context.Context, req *ReportReviewRequest, opts ...http.CallOption) (rsp *ReportReviewReply, err error)
+	RequestAppealInfo(ctx context.Context, req *RequestAppealInfoRequest, opts ...http.CallOption) (rsp *RequestAppealInfoReply, err error)
+	SupplementAppeal(ctx context.Context, req *SupplementAppealRequest, opts ...http.CallOption) (rsp *SupplementAppealReply, err error)
+	UnvoteReview(ctx context.Context, req *UnvoteReviewRequest, opts ...http.CallOption) (rsp *UnvoteReviewReply, err error)
+	UpdateAutoReplyRule(ctx context.Context, req *UpdateAutoReplyRuleRequest, opts ...http.CallOption) (rsp *UpdateAutoReplyRuleReply, err error)
+	UpdateReplyTemplate(ctx context.Context, req *UpdateReplyTemplateRequest, opts ...http.CallOption) (rsp *UpdateReplyTemplateReply, err error)
+	UpdateReview(ctx context.Context, req *UpdateReviewRequest, opts ...http.CallOption) (rsp *UpdateReviewReply, err error)
+	VoteReview(ctx context.Context, req *VoteReviewRequest, opts ...http.CallOption) (rsp *VoteReviewReply, err error)
+	WithdrawAppeal(ctx context.Context, req *WithdrawAppealRequest, opts ...http.CallOption) (rsp *WithdrawAppealReply, err error)
+}
+
+type ReviewHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewReviewHTTPClient(client *http.Client) ReviewHTTPClient {
+	return &ReviewHTTPClientImpl{client}
+}
+
+func (c *ReviewHTTPClientImpl) AppealReview(ctx context.Context, in *AppealReviewRequest, opts ...http.CallOption) (*AppealReviewReply, error) {
+	var out AppealReviewReply
+	pattern := "v1/review/appeal"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAppealReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...http.CallOption) (*AuditAppealReply, error) {
+	var out AuditAppealReply
+	pattern := "v1/appeal/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAuditAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...http.CallOption) (*AuditReviewReply, error) {
+	var out AuditReviewReply
+	pattern := "v1/review/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAuditReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) BatchAuditAppeals(ctx context.Context, in *BatchAuditAppealsRequest, opts ...http.CallOption) (*BatchAuditReply, error) {
+	var out BatchAuditReply
+	pattern := "v1/appeals/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewBatchAuditAppeals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) BatchAuditReviews(ctx context.Context, in *BatchAuditReviewsRequest, opts ...http.CallOption) (*BatchAuditReply, error) {
+	var out BatchAuditReply
+	pattern := "v1/reviews/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewBatchAuditReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) BatchGetReviews(ctx context.Context, in *BatchGetReviewsRequest, opts ...http.CallOption) (*BatchGetReviewsReply, error) {
+	var out BatchGetReviewsReply
+	pattern := "v1/reviews/batch"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewBatchGetReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) CreateAutoReplyRule(ctx context.Context, in *CreateAutoReplyRuleRequest, opts ...http.CallOption) (*CreateAutoReplyRuleReply, error) {
+	var out CreateAutoReplyRuleReply
+	pattern := "/v1/store/{storeID}/auto-reply-rule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCreateAutoReplyRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) CreateReplyTemplate(ctx context.Context, in *CreateReplyTemplateRequest, opts ...http.CallOption) (*CreateReplyTemplateReply, error) {
+	var out CreateReplyTemplateReply
+	pattern := "/v1/store/{storeID}/reply-template"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCreateReplyTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...http.CallOption) (*CreateReviewReply, error) {
+	var out CreateReviewReply
+	pattern := "/v1/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCreateReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) CreateReviewExport(ctx context.Context, in *CreateReviewExportRequest, opts ...http.CallOption) (*CreateReviewExportReply, error) {
+	var out CreateReviewExportReply
+	pattern := "/v1/store/{storeID}/review/export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCreateReviewExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...http.CallOption) (*CreateWebhookReply, error) {
+	var out CreateWebhookReply
+	pattern := "/v1/webhook"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCreateWebhook))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) DeleteAutoReplyRule(ctx context.Context, in *DeleteAutoReplyRuleRequest, opts ...http.CallOption) (*DeleteAutoReplyRuleReply, error) {
+	var out DeleteAutoReplyRuleReply
+	pattern := "/v1/store/{storeID}/auto-reply-rule/{ruleID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewDeleteAutoReplyRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) DeleteReplyTemplate(ctx context.Context, in *DeleteReplyTemplateRequest, opts ...http.CallOption) (*DeleteReplyTemplateReply, error) {
+	var out DeleteReplyTemplateReply
+	pattern := "/v1/store/{storeID}/reply-template/{templateID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewDeleteReplyTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...http.CallOption) (*DeleteReviewReply, error) {
+	var out DeleteReviewReply
+	pattern := "/v1/delete/{reviewID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewDeleteReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...http.CallOption) (*DeleteWebhookReply, error) {
+	var out DeleteWebhookReply
+	pattern := "/v1/webhook/{subscriptionID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewDeleteWebhook))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
 	if err != nil {
@@ -439,6 +1490,32 @@ func (c *ReviewHTTPClientImpl) GetAllReviews(ctx context.Context, in *GetAllRevi
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) GetAppeal(ctx context.Context, in *GetAppealRequest, opts ...http.CallOption) (*GetAppealReply, error) {
+	var out GetAppealReply
+	pattern := "/v1/store/{storeID}/appeal/{appealID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetAppealHistory(ctx context.Context, in *GetAppealHistoryRequest, opts ...http.CallOption) (*GetAppealHistoryReply, error) {
+	var out GetAppealHistoryReply
+	pattern := "/v1/review/{reviewID}/appeals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetAppealHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewRequest, opts ...http.CallOption) (*GetReviewReply, error) {
 	var out GetReviewReply
 	pattern := "v1/review/{reviewID}"
@@ -452,6 +1529,110 @@ func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewReque
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) GetReviewDetail(ctx context.Context, in *GetReviewDetailRequest, opts ...http.CallOption) (*GetReviewDetailReply, error) {
+	var out GetReviewDetailReply
+	pattern := "v1/review/{reviewID}/detail"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetReviewDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetReviewExport(ctx context.Context, in *GetReviewExportRequest, opts ...http.CallOption) (*GetReviewExportReply, error) {
+	var out GetReviewExportReply
+	pattern := "/v1/store/{storeID}/review/export/{exportID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetReviewExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetReviewsByOrderIDs(ctx context.Context, in *GetReviewsByOrderIDsRequest, opts ...http.CallOption) (*GetReviewsByOrderIDsReply, error) {
+	var out GetReviewsByOrderIDsReply
+	pattern := "v1/orders/reviews"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetReviewsByOrderIDs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListAutoReplyRules(ctx context.Context, in *ListAutoReplyRulesRequest, opts ...http.CallOption) (*ListAutoReplyRulesReply, error) {
+	var out ListAutoReplyRulesReply
+	pattern := "/v1/store/{storeID}/auto-reply-rules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListAutoReplyRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListPendingAppeals(ctx context.Context, in *ListPendingAppealsRequest, opts ...http.CallOption) (*ListPendingAppealsReply, error) {
+	var out ListPendingAppealsReply
+	pattern := "/v1/appeals/pending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListPendingAppeals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...http.CallOption) (*ListPendingReviewsReply, error) {
+	var out ListPendingReviewsReply
+	pattern := "/v1/reviews/pending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListPendingReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListReplyTemplates(ctx context.Context, in *ListReplyTemplatesRequest, opts ...http.CallOption) (*ListReplyTemplatesReply, error) {
+	var out ListReplyTemplatesReply
+	pattern := "/v1/store/{storeID}/reply-templates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListReplyTemplates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListReportedReviews(ctx context.Context, in *ListReportedReviewsRequest, opts ...http.CallOption) (*ListReportedReviewsReply, error) {
+	var out ListReportedReviewsReply
+	pattern := "/v1/reviews/reported"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListReportedReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) ListReviewByContent(ctx context.Context, in *ListReviewByContentRequest, opts ...http.CallOption) (*ListReviewByContentReply, error) {
 	var out ListReviewByContentReply
 	pattern := "/v1/reviewshavecontent"
@@ -478,6 +1659,84 @@ func (c *ReviewHTTPClientImpl) ListReviewByStoreID(ctx context.Context, in *List
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) ListReviewExports(ctx context.Context, in *ListReviewExportsRequest, opts ...http.CallOption) (*ListReviewExportsReply, error) {
+	var out ListReviewExportsReply
+	pattern := "/v1/store/{storeID}/review/exports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListReviewExports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListStoreAppeals(ctx context.Context, in *ListStoreAppealsRequest, opts ...http.CallOption) (*ListStoreAppealsReply, error) {
+	var out ListStoreAppealsReply
+	pattern := "/v1/store/{storeID}/appeals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListStoreAppeals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListStoreReviews(ctx context.Context, in *ListStoreReviewsRequest, opts ...http.CallOption) (*ListStoreReviewsReply, error) {
+	var out ListStoreReviewsReply
+	pattern := "/v1/store/{storeID}/reviews"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListStoreReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListUserReviews(ctx context.Context, in *ListUserReviewsRequest, opts ...http.CallOption) (*ListUserReviewsReply, error) {
+	var out ListUserReviewsReply
+	pattern := "v1/user/{userID}/reviews"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListUserReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...http.CallOption) (*ListWebhookDeliveriesReply, error) {
+	var out ListWebhookDeliveriesReply
+	pattern := "/v1/webhook/{subscriptionID}/deliveries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListWebhookDeliveries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...http.CallOption) (*ListWebhooksReply, error) {
+	var out ListWebhooksReply
+	pattern := "/v1/webhooks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewListWebhooks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...http.CallOption) (*ReplyReviewReply, error) {
 	var out ReplyReviewReply
 	pattern := "/v1/review/reply"
@@ -504,6 +1763,84 @@ func (c *ReviewHTTPClientImpl) ReplyReviewUpdate(ctx context.Context, in *ReplyR
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...http.CallOption) (*ReportReviewReply, error) {
+	var out ReportReviewReply
+	pattern := "/v1/review/{reviewID}/report"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewReportReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) RequestAppealInfo(ctx context.Context, in *RequestAppealInfoRequest, opts ...http.CallOption) (*RequestAppealInfoReply, error) {
+	var out RequestAppealInfoReply
+	pattern := "/v1/appeal/{appealID}/request-info"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewRequestAppealInfo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) SupplementAppeal(ctx context.Context, in *SupplementAppealRequest, opts ...http.CallOption) (*SupplementAppealReply, error) {
+	var out SupplementAppealReply
+	pattern := "/v1/store/{storeID}/appeal/{appealID}/supplement"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewSupplementAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) UnvoteReview(ctx context.Context, in *UnvoteReviewRequest, opts ...http.CallOption) (*UnvoteReviewReply, error) {
+	var out UnvoteReviewReply
+	pattern := "/v1/review/{reviewID}/vote"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewUnvoteReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) UpdateAutoReplyRule(ctx context.Context, in *UpdateAutoReplyRuleRequest, opts ...http.CallOption) (*UpdateAutoReplyRuleReply, error) {
+	var out UpdateAutoReplyRuleReply
+	pattern := "/v1/store/{storeID}/auto-reply-rule/{ruleID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewUpdateAutoReplyRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) UpdateReplyTemplate(ctx context.Context, in *UpdateReplyTemplateRequest, opts ...http.CallOption) (*UpdateReplyTemplateReply, error) {
+	var out UpdateReplyTemplateReply
+	pattern := "/v1/store/{storeID}/reply-template/{templateID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewUpdateReplyTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...http.CallOption) (*UpdateReviewReply, error) {
 	var out UpdateReviewReply
 	pattern := "/v1/update/{reviewID}"
@@ -516,3 +1853,29 @@ func (c *ReviewHTTPClientImpl) UpdateReview(ctx context.Context, in *UpdateRevie
 	}
 	return &out, nil
 }
+
+func (c *ReviewHTTPClientImpl) VoteReview(ctx context.Context, in *VoteReviewRequest, opts ...http.CallOption) (*VoteReviewReply, error) {
+	var out VoteReviewReply
+	pattern := "/v1/review/{reviewID}/vote"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewVoteReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) WithdrawAppeal(ctx context.Context, in *WithdrawAppealRequest, opts ...http.CallOption) (*WithdrawAppealReply, error) {
+	var out WithdrawAppealReply
+	pattern := "/v1/store/{storeID}/appeal/{appealID}/withdraw"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewWithdrawAppeal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
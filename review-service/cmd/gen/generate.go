@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -10,7 +11,9 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gen"
 	"gorm.io/gorm"
+	"regexp"
 	"review-service/internal/conf"
+	"review-service/internal/data/migrations"
 	"strings"
 )
 
@@ -69,7 +72,35 @@ func main() {
 		FieldNullable: true,
 	})
 
-	g.UseDB(connectDB(bc.Data.Database))
-	g.ApplyBasic(g.GenerateAllTable()...)
+	//先把库迁移到最新版本，按迁移后的表结构生成
+	//model字段类型取决于数据库类型，生成时请连mysql
+	db := connectDB(bc.Data.Database)
+	m, err := migrations.New(db, bc.Data.Database.GetDriver())
+	if err != nil {
+		panic(err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		panic(err)
+	}
+	g.UseDB(db)
+	g.ApplyBasic(generateTables(g, db)...)
 	g.Execute()
 }
+
+// shardTable review_info分表和review_info同结构，只生成review_info
+var shardTable = regexp.MustCompile(`^review_info_\d+$`)
+
+func generateTables(g *gen.Generator, db *gorm.DB) []interface{} {
+	tables, err := db.Migrator().GetTables()
+	if err != nil {
+		panic(fmt.Errorf("get all tables fail: %w", err))
+	}
+	var models []interface{}
+	for _, table := range tables {
+		if table == migrations.TableName || shardTable.MatchString(table) {
+			continue
+		}
+		models = append(models, g.GenerateModel(table))
+	}
+	return models
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/pkg/snowflake"
+	"strings"
+)
+
+//从其他平台导入历史评价，按-source和source_id去重，重复执行只会导入之前失败的行
+//go run ./cmd/import -conf configs -source legacy -file reviews.jsonl
+//go run ./cmd/import -conf configs -source legacy -file reviews.csv -report import-report.jsonl
+//导入的评价和普通评价一样经canal同步到ES
+
+var (
+	flagconf   string
+	flagfile   string
+	flagformat string
+	flagsource string
+	flagreport string
+	flagsize   int
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&flagfile, "file", "", "file to import")
+	flag.StringVar(&flagformat, "format", "", "jsonl or csv, defaults to the file extension")
+	flag.StringVar(&flagsource, "source", "", "source platform of the reviews, eg: -source legacy")
+	flag.StringVar(&flagreport, "report", "", "where to write skipped and failed rows as jsonl, defaults to <file>.report.jsonl")
+	flag.IntVar(&flagsize, "batch", 500, "rows per batch")
+}
+
+func main() {
+	flag.Parse()
+	if flagfile == "" || flagsource == "" {
+		panic("import: -file and -source are required")
+	}
+	format := flagformat
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(flagfile), ".")
+	}
+	report := flagreport
+	if report == "" {
+		report = flagfile + ".report.jsonl"
+	}
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+	logger := log.NewStdLogger(os.Stderr)
+	//导入后要删掉订单和评价的缓存，租用机器ID也用这个连接
+	rdb, err := data.NewRedisClient(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	defer rdb.Close()
+	//和服务用同一个机器ID会生成重复的评价ID，没有开启租用时请配置一个空闲的machine_id
+	if bc.Snowflake.GetLease() {
+		lease, err := snowflake.LeaseMachineID(rdb, bc.Snowflake.GetStartTime(), bc.Snowflake.GetLeaseTtl().AsDuration(), logger)
+		if err != nil {
+			panic(err)
+		}
+		defer lease.Stop(context.Background())
+	} else if err := snowflake.Init(bc.Snowflake.GetStartTime(), bc.Snowflake.GetMachineId()); err != nil {
+		panic(err)
+	}
+
+	db, err := data.NewDB(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	in, err := os.Open(flagfile)
+	if err != nil {
+		panic(err)
+	}
+	defer in.Close()
+	rd, err := biz.NewImportReader(format, in)
+	if err != nil {
+		panic(err)
+	}
+	out, err := os.Create(report)
+	if err != nil {
+		panic(err)
+	}
+	defer out.Close()
+	enc := json.NewEncoder(out)
+
+	im := data.NewImporter(bc.Data, db, rdb, logger)
+	//去重检查读主库，从库延迟时会重复导入
+	ret, err := biz.RunImport(biz.WithPrimary(context.Background()), im, rd, &biz.ImportOption{
+		Source: flagsource,
+		Batch:  flagsize,
+	}, func(r *biz.ImportResult) {
+		if r.Result == biz.ImportImported {
+			return
+		}
+		if err := enc.Encode(r); err != nil {
+			panic(err)
+		}
+	})
+	if ret != nil {
+		fmt.Printf("import: %d rows, imported %d, skipped %d, failed %d\n", ret.Total, ret.Imported, ret.Skipped, ret.Failed)
+		fmt.Printf("report: %s\n", report)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
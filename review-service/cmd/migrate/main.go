@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"os"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/internal/data/migrations"
+)
+
+//表结构迁移工具
+//go run ./cmd/migrate -conf configs up
+//go run ./cmd/migrate -conf configs down -steps 1
+//go run ./cmd/migrate -conf configs status
+
+var (
+	flagconf  string
+	flagsteps int
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.IntVar(&flagsteps, "steps", 1, "migrations to roll back with down")
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: migrate [-conf path] [-steps n] up|down|status")
+		os.Exit(2)
+	}
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+	//这里显式执行，不需要打开连接时自动迁移
+	bc.Data.Database.AutoMigrate = false
+	db, err := data.NewDB(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	m, err := migrations.New(db, bc.Data.Database.GetDriver())
+	if err != nil {
+		panic(err)
+	}
+
+	ctx := context.Background()
+	switch flag.Arg(0) {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("migrate: %d applied\n", n)
+	case "down":
+		n, err := m.Down(ctx, flagsteps)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("migrate: %d rolled back\n", n)
+	case "status":
+		list, err := m.Status(ctx)
+		if err != nil {
+			panic(err)
+		}
+		for _, s := range list {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%s\t%s\n", s.Version, s.Name, state)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "migrate: unknown command %q\n", flag.Arg(0))
+		os.Exit(2)
+	}
+}
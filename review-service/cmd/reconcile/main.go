@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+)
+
+//mysql和ES对账工具，服务里也会按job.reconcile_interval定时执行
+//go run ./cmd/reconcile -conf configs
+//go run ./cmd/reconcile -conf configs -from 100 -to 200 -repair
+
+var (
+	flagconf   string
+	flagfrom   int64
+	flagto     int64
+	flagsize   int
+	flagrepair bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Int64Var(&flagfrom, "from", 0, "first review_id to check")
+	flag.Int64Var(&flagto, "to", 0, "stop before this review_id, 0 means no limit")
+	flag.IntVar(&flagsize, "batch", 500, "rows per batch")
+	flag.BoolVar(&flagrepair, "repair", false, "reindex missing and stale documents, delete orphans")
+}
+
+func main() {
+	flag.Parse()
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	db, err := data.NewDB(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	es, err := data.NewEsclient(bc.Elasticsearch)
+	if err != nil {
+		panic(err)
+	}
+	rc := data.NewReconciler(bc.Data, db, es, log.NewStdLogger(os.Stderr))
+	//从库有延迟，对账读主库
+	report, err := rc.Run(biz.WithPrimary(context.Background()), &biz.ReconcileOption{
+		FromID: flagfrom,
+		ToID:   flagto,
+		Batch:  flagsize,
+		Repair: flagrepair,
+	})
+	if report != nil {
+		fmt.Printf("reconcile: scanned %d rows, %d documents\n", report.Scanned, report.Documents)
+		fmt.Printf("missing %d %v\n", report.Missing, report.MissingIDs)
+		fmt.Printf("stale %d %v\n", report.Stale, report.StaleIDs)
+		fmt.Printf("orphan %d %v\n", report.Orphan, report.OrphanIDs)
+		if flagrepair {
+			fmt.Printf("repaired %d\n", report.Repaired)
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/internal/data/model"
+)
+
+//review_info重新分表工具
+//1.服务不停机先跑一遍，把历史数据搬到新分表并补齐索引表
+//2.停写后再跑一遍，补上第一遍之后的新增和修改
+//3.修改配置data.sharding.review_shards为-to的值后重启服务
+//4.带-cleanup -from 原分表数再跑一遍，核对目标分表里已有同样或更新的数据后从原表删除
+//  不删的话按新分表数跨表查询时，旧表里还会扫到重复的评价
+
+var (
+	flagconf    string
+	flagto      int
+	flagfrom    int
+	flagsize    int
+	flagcleanup bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.IntVar(&flagto, "to", 0, "target shards, eg: -to 8")
+	flag.IntVar(&flagfrom, "from", 0, "source shards, defaults to data.sharding.review_shards, eg: -from 4")
+	flag.IntVar(&flagsize, "batch", 500, "rows per batch")
+	flag.BoolVar(&flagcleanup, "cleanup", false, "delete moved rows from the source shards after the config switch")
+}
+
+func main() {
+	flag.Parse()
+	if flagto <= 0 || flagsize <= 0 {
+		panic("reshard: -to and -batch must be positive")
+	}
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	db, err := data.NewDB(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	//配置了从库时也从主库读，第二遍要拿到最新数据
+	db = db.WithContext(biz.WithPrimary(context.Background()))
+	from := bc.Data.GetSharding().GetReviewShards()
+	if flagfrom > 0 {
+		from = int32(flagfrom)
+	}
+	to := int32(flagto)
+	if flagcleanup {
+		if from == to {
+			panic("reshard: -cleanup needs -from with the old shard count")
+		}
+		for _, table := range data.ReviewTableNames(from) {
+			n, skipped, err := cleanup(db, table, to)
+			if err != nil {
+				panic(err)
+			}
+			fmt.Printf("reshard: cleanup %s, %d rows deleted, %d rows not verified\n", table, n, skipped)
+		}
+		return
+	}
+	//目标分表和原表同结构
+	if err := data.EnsureReviewShards(db, bc.Data.GetDatabase().GetDriver(), to); err != nil {
+		panic(err)
+	}
+	for _, table := range data.ReviewTableNames(from) {
+		n, err := migrate(db, table, to)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("reshard: %s -> %d shards, %d rows\n", table, to, n)
+	}
+}
+
+// migrate 按自增ID分批读源表，写到店铺所在的目标分表，并补索引表
+func migrate(db *gorm.DB, table string, to int32) (int, error) {
+	var lastID int64
+	var total int
+	for {
+		var batch []*model.ReviewInfo
+		err := db.Table(table).Where("id > ?", lastID).Order("id").Limit(flagsize).Find(&batch).Error
+		if err != nil {
+			return total, err
+		}
+		if len(batch) == 0 {
+			return total, nil
+		}
+		lastID = batch[len(batch)-1].ID
+		err = db.Transaction(func(tx *gorm.DB) error {
+			for _, review := range batch {
+				if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ReviewInfoIndex{
+					ReviewID: review.ReviewID,
+					OrderID:  review.OrderID,
+					UserID:   review.UserID,
+					StoreID:  review.StoreID,
+				}).Error; err != nil {
+					return err
+				}
+				target := data.ReviewTableName(to, review.StoreID)
+				if target == table {
+					continue
+				}
+				//目标表自增ID重新生成，按review_id唯一键覆盖
+				if err := tx.Table(target).Omit("id").
+					Clauses(clause.OnConflict{UpdateAll: true}).
+					Create(review).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(batch)
+	}
+}
+
+// cleanup 删除原表里已经搬到其他分表的评价，只删目标分表里有同一条评价且不比原表旧的
+// 没核对上的留在原表并计数，需要再跑一遍搬数据后重新清理
+func cleanup(db *gorm.DB, table string, to int32) (int, int, error) {
+	var lastID int64
+	var deleted, skipped int
+	for {
+		var batch []*model.ReviewInfo
+		err := db.Table(table).Where("id > ?", lastID).Order("id").Limit(flagsize).Find(&batch).Error
+		if err != nil {
+			return deleted, skipped, err
+		}
+		if len(batch) == 0 {
+			return deleted, skipped, nil
+		}
+		lastID = batch[len(batch)-1].ID
+		for _, review := range batch {
+			target := data.ReviewTableName(to, review.StoreID)
+			if target == table {
+				continue
+			}
+			var moved model.ReviewInfo
+			err := db.Table(target).Where("review_id = ?", review.ReviewID).Take(&moved).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && moved.UpdateAt.Before(review.UpdateAt)) {
+				fmt.Printf("reshard: review %d in %s not verified in %s, kept\n", review.ReviewID, table, target)
+				skipped++
+				continue
+			}
+			if err != nil {
+				return deleted, skipped, err
+			}
+			//切换配置后原表不再有写入，核对过的行可以直接删
+			res := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", table), review.ID)
+			if res.Error != nil {
+				return deleted, skipped, res.Error
+			}
+			deleted += int(res.RowsAffected)
+		}
+	}
+}
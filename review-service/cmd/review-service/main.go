@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"flag"
-	"github.com/go-kratos/kratos/v2/registry"
 	"os"
 
+	"review-common/health"
+	"review-common/telemetry"
 	"review-service/internal/conf"
+	"review-service/internal/data"
+	"review-service/internal/job"
 	"review-service/pkg/snowflake"
 
 	"github.com/go-kratos/kratos/v2"
@@ -35,7 +39,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, h *health.Health, gs *grpc.Server, hs *http.Server, hf *job.HelpfulFlusher, ar *job.AutoReplier, wd *job.WebhookDeliverer, rc *job.Reconciler, ex *job.Exporter) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,9 +49,15 @@ func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.S
 		kratos.Server(
 			gs,
 			hs,
+			hf,
+			ar,
+			wd,
+			rc,
+			ex,
+			h,
 		),
-		//服务注册
-		kratos.Registrar(r),
+		//服务注册，通过health未就绪时从consul摘除
+		kratos.Registrar(h),
 	)
 }
 
@@ -84,16 +94,39 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Elasticsearch, logger)
+	//在创建server和客户端之前设置，tracing中间件和ES客户端创建时取全局TracerProvider
+	shutdown, err := telemetry.SetTracerProvider(bc.Trace, telemetry.Service{Name: Name, Version: Version, InstanceID: id})
 	if err != nil {
 		panic(err)
 	}
-	defer cleanup()
+	defer shutdown()
+
+	//租约有效性作为关键依赖注册到health，租约失效期间从consul摘除
+	var holder snowflake.Holder
+	if bc.Snowflake.GetLease() {
+		rdb, err := data.NewRedisClient(bc.Data)
+		if err != nil {
+			panic(err)
+		}
+		lease, err := snowflake.LeaseMachineID(rdb, bc.Snowflake.GetStartTime(), bc.Snowflake.GetLeaseTtl().AsDuration(), logger)
+		if err != nil {
+			panic(err)
+		}
+		//app退出后再释放机器ID
+		defer lease.Stop(context.Background())
+		holder = lease
+	} else {
+		err = snowflake.Init(bc.Snowflake.GetStartTime(), bc.Snowflake.GetMachineId())
+		if err != nil {
+			panic(err)
+		}
+	}
 
-	err = snowflake.Init(bc.Snowflake.GetStartTime(), bc.Snowflake.GetMachineId())
+	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Elasticsearch, bc.Anonymous, bc.User, bc.Job, bc.Report, bc.Webhook, bc.Health, bc.Export, bc.Auth, holder, logger)
 	if err != nil {
 		panic(err)
 	}
+	defer cleanup()
 
 	// start and wait for stop signal
 	if err := app.Run(); err != nil {
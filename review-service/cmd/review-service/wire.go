@@ -9,8 +9,10 @@ import (
 	"review-service/internal/biz"
 	"review-service/internal/conf"
 	"review-service/internal/data"
+	"review-service/internal/job"
 	"review-service/internal/server"
 	"review-service/internal/service"
+	"review-service/pkg/snowflake"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -18,11 +20,12 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Registry, *conf.Data, *conf.ElasticSearch, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Registry, *conf.Data, *conf.ElasticSearch, *conf.Anonymous, *conf.User, *conf.Job, *conf.Report, *conf.Webhook, *conf.Health, *conf.Export, *conf.Auth, snowflake.Holder, log.Logger) (*kratos.App, func(), error) {
 	//consul服务发现与注册在server.ProviderSet中
 	panic(wire.Build(server.ProviderSet,
 		data.ProviderSet,
 		biz.ProviderSet,
 		service.ProviderSet,
+		job.ProviderSet,
 		newApp))
 }
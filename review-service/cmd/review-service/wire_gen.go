@@ -12,8 +12,10 @@ import (
 	"review-service/internal/biz"
 	"review-service/internal/conf"
 	"review-service/internal/data"
+	"review-service/internal/job"
 	"review-service/internal/server"
 	"review-service/internal/service"
+	"review-service/pkg/snowflake"
 )
 
 import (
@@ -23,8 +25,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, elasticSearch *conf.ElasticSearch, logger log.Logger) (*kratos.App, func(), error) {
-	registrar := server.NewRegister(registry)
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, elasticSearch *conf.ElasticSearch, anonymous *conf.Anonymous, user *conf.User, confJob *conf.Job, report *conf.Report, webhook *conf.Webhook, health *conf.Health, export *conf.Export, auth *conf.Auth, holder snowflake.Holder, logger log.Logger) (*kratos.App, func(), error) {
 	db, err := data.NewDB(confData)
 	if err != nil {
 		return nil, nil, err
@@ -33,16 +34,42 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Da
 	if err != nil {
 		return nil, nil, err
 	}
-	dataData, cleanup, err := data.NewData(db, typedClient, logger)
+	client, err := data.NewRedisClient(confData)
+	if err != nil {
+		return nil, nil, err
+	}
+	probes := data.NewHealthProbes(db, typedClient, client, holder)
+	apiClient, err := server.NewConsulClient(registry)
+	if err != nil {
+		return nil, nil, err
+	}
+	registrar := server.NewRegister(apiClient)
+	healthHealth := server.NewHealth(health, probes, apiClient, registrar, logger)
+	dataData, cleanup, err := data.NewData(confData, elasticSearch, db, typedClient, client, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	reviewRepo := data.NewReviewRepo(dataData, logger)
-	reviewerUsecase := biz.NewReviewerUsecase(reviewRepo, logger)
-	reviewService := service.NewReviewService(reviewerUsecase)
-	grpcServer := server.NewGRPCServer(confServer, reviewService, logger)
-	httpServer := server.NewHTTPServer(confServer, reviewService, logger)
-	app := newApp(logger, registrar, grpcServer, httpServer)
+	userClient, err := data.NewUserClient(user, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	exportStorage, err := data.NewExportStorage(export)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	reviewerUsecase := biz.NewReviewerUsecase(reviewRepo, userClient, exportStorage, report, export, anonymous, logger)
+	reviewService := service.NewReviewService(reviewerUsecase, anonymous)
+	grpcServer := server.NewGRPCServer(confServer, auth, reviewService, healthHealth, logger)
+	httpServer := server.NewHTTPServer(confServer, reviewService, healthHealth, logger)
+	helpfulFlusher := job.NewHelpfulFlusher(confJob, reviewerUsecase, logger)
+	autoReplier := job.NewAutoReplier(confJob, reviewerUsecase, logger)
+	webhookDeliverer := job.NewWebhookDeliverer(webhook, reviewerUsecase, logger)
+	reconciler := job.NewReconciler(confJob, reviewerUsecase, logger)
+	exporter := job.NewExporter(export, reviewerUsecase, logger)
+	app := newApp(logger, healthHealth, grpcServer, httpServer, helpfulFlusher, autoReplier, webhookDeliverer, reconciler, exporter)
 	return app, func() {
 		cleanup()
 	}, nil
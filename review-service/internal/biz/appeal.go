@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"strconv"
+)
+
+// 申诉状态
+const (
+	AppealPending      = 10 //待审核
+	AppealApproved     = 20 //申诉通过
+	AppealRejected     = 30 //申诉驳回
+	AppealNeedMoreInfo = 40 //运营要求补充材料
+	AppealWithdrawn    = 50 //商家撤回
+)
+
+// 申诉沟通记录的发送方和动作
+const (
+	AppealSenderStore    = 1
+	AppealSenderOperator = 2
+
+	AppealActionSubmit      = 1
+	AppealActionSupplement  = 2
+	AppealActionRequestInfo = 3
+	AppealActionAudit       = 4
+	AppealActionWithdraw    = 5
+)
+
+// 同一条评价最多申诉的轮数
+const maxAppealRounds = 3
+
+// AppealActive 处理中的申诉可以补充材料、撤回和审核
+var AppealActive = []int32{AppealPending, AppealNeedMoreInfo}
+
+// AppealHistory 一条评价的全部申诉轮次和沟通记录
+type AppealHistory struct {
+	Appeals  []*model.ReviewAppealInfo
+	Messages []*model.ReviewAppealMessage
+}
+
+// nextAppealRound 根据最近一轮申诉决定能否再次申诉
+func nextAppealRound(latest *model.ReviewAppealInfo) (int32, error) {
+	if latest == nil {
+		return 1, nil
+	}
+	switch latest.Status {
+	case AppealPending, AppealNeedMoreInfo:
+		return 0, pb.ErrorReviewAppealed("该评价有处理中的申诉，可以补充材料或撤回后重新申诉")
+	case AppealApproved:
+		return 0, pb.ErrorAppealAudited("该评价的申诉已通过")
+	}
+	if latest.Round >= maxAppealRounds {
+		return 0, pb.ErrorAppealAudited("该评价的申诉次数已达上限")
+	}
+	return latest.Round + 1, nil
+}
+
+// SupplementAppeal B端按运营要求补充材料，申诉回到待审核
+func (uc *ReviewerUsecase) SupplementAppeal(ctx context.Context, param *SupplementAppealParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] SupplementAppeal, param:%v\n", param)
+	appeal, err := uc.GetAppeal(ctx, param.StoreID, param.AppealID)
+	if err != nil {
+		return err
+	}
+	messageID, err := genID()
+	if err != nil {
+		return err
+	}
+	return uc.repo.TransitAppeal(ctx, appeal.AppealID, AppealActive, AppealPending, &model.ReviewAppealMessage{
+		MessageID:  messageID,
+		AppealID:   appeal.AppealID,
+		ReviewID:   appeal.ReviewID,
+		SenderType: AppealSenderStore,
+		Sender:     strconv.FormatInt(param.StoreID, 10),
+		Action:     AppealActionSupplement,
+		Content:    param.Content,
+		PicInfo:    param.PicInfo,
+		VideoInfo:  param.VideoInfo,
+	})
+}
+
+// WithdrawAppeal B端撤回处理中的申诉，撤回后可以重新发起下一轮
+func (uc *ReviewerUsecase) WithdrawAppeal(ctx context.Context, storeID int64, appealID int64, reason string) error {
+	uc.log.WithContext(ctx).Debugf("[biz] WithdrawAppeal, storeID:%v appealID:%v\n", storeID, appealID)
+	appeal, err := uc.GetAppeal(ctx, storeID, appealID)
+	if err != nil {
+		return err
+	}
+	messageID, err := genID()
+	if err != nil {
+		return err
+	}
+	return uc.repo.TransitAppeal(ctx, appeal.AppealID, AppealActive, AppealWithdrawn, &model.ReviewAppealMessage{
+		MessageID:  messageID,
+		AppealID:   appeal.AppealID,
+		ReviewID:   appeal.ReviewID,
+		SenderType: AppealSenderStore,
+		Sender:     strconv.FormatInt(storeID, 10),
+		Action:     AppealActionWithdraw,
+		Content:    reason,
+	})
+}
+
+// RequestAppealInfo O端要求商家补充材料
+func (uc *ReviewerUsecase) RequestAppealInfo(ctx context.Context, appealID int64, opUser string, content string) error {
+	uc.log.WithContext(ctx).Debugf("[biz] RequestAppealInfo, appealID:%v opUser:%v\n", appealID, opUser)
+	return uc.AuditAppeal(ctx, &AuditAppealParam{
+		AppealID: appealID,
+		OpUser:   opUser,
+		OpReason: content,
+		Status:   AppealNeedMoreInfo,
+	})
+}
+
+// GetAppealHistory 查询评价的全部申诉轮次和沟通记录，storeID为0时不校验店铺(运营)
+func (uc *ReviewerUsecase) GetAppealHistory(ctx context.Context, storeID int64, reviewID int64) (*AppealHistory, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetAppealHistory, storeID:%v reviewID:%v\n", storeID, reviewID)
+	history, err := uc.repo.GetAppealHistory(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if storeID > 0 {
+		for _, a := range history.Appeals {
+			if a.StoreID != storeID {
+				return nil, pb.ErrorNotFound("没有这个申诉")
+			}
+		}
+	}
+	return history, nil
+}
@@ -0,0 +1,35 @@
+package biz
+
+import (
+	"github.com/smartystreets/goconvey/convey"
+	"review-service/internal/data/model"
+	"testing"
+)
+
+func TestNextAppealRound(t *testing.T) {
+	convey.Convey("first appeal starts at round 1", t, func() {
+		round, err := nextAppealRound(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(round, convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("rejected or withdrawn appeal can be appealed again", t, func() {
+		round, err := nextAppealRound(&model.ReviewAppealInfo{Round: 1, Status: AppealRejected})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(round, convey.ShouldEqual, 2)
+		round, err = nextAppealRound(&model.ReviewAppealInfo{Round: 2, Status: AppealWithdrawn})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(round, convey.ShouldEqual, 3)
+	})
+
+	convey.Convey("active, approved or last round cannot be appealed", t, func() {
+		_, err := nextAppealRound(&model.ReviewAppealInfo{Round: 1, Status: AppealPending})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = nextAppealRound(&model.ReviewAppealInfo{Round: 1, Status: AppealNeedMoreInfo})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = nextAppealRound(&model.ReviewAppealInfo{Round: 1, Status: AppealApproved})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = nextAppealRound(&model.ReviewAppealInfo{Round: maxAppealRounds, Status: AppealRejected})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
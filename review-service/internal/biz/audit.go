@@ -0,0 +1,34 @@
+package biz
+
+import "context"
+
+// AuditResult 批量审核中单条记录的处理结果
+type AuditResult struct {
+	ID  int64
+	Err error
+}
+
+// BatchAuditReviews O端批量审核评价
+// 每条评价独立走单条审核的逻辑和事务，一条失败不影响其他评价
+func (uc *ReviewerUsecase) BatchAuditReviews(ctx context.Context, ids []int64, param *AuditReviewParam) []*AuditResult {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchAuditReviews, ids:%v param:%v\n", ids, param)
+	results := make([]*AuditResult, 0, len(ids))
+	for _, id := range ids {
+		p := *param
+		p.ReviewID = id
+		results = append(results, &AuditResult{ID: id, Err: uc.AuditReview(ctx, &p)})
+	}
+	return results
+}
+
+// BatchAuditAppeals O端批量审核申诉，逐条处理
+func (uc *ReviewerUsecase) BatchAuditAppeals(ctx context.Context, ids []int64, param *AuditAppealParam) []*AuditResult {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchAuditAppeals, ids:%v param:%v\n", ids, param)
+	results := make([]*AuditResult, 0, len(ids))
+	for _, id := range ids {
+		p := *param
+		p.AppealID = id
+		results = append(results, &AuditResult{ID: id, Err: uc.AuditAppeal(ctx, &p)})
+	}
+	return results
+}
@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"context"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"time"
+)
+
+// 每条规则每轮最多回复的评价数，积压的评价下一轮继续处理
+const autoReplyBatch = 100
+
+// CreateAutoReplyRule B端新建自动回复规则
+func (uc *ReviewerUsecase) CreateAutoReplyRule(ctx context.Context, param *AutoReplyRuleParam) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateAutoReplyRule, param:%v\n", param)
+	rule := toAutoReplyRule(param)
+	ruleID, err := genID()
+	if err != nil {
+		return 0, err
+	}
+	rule.RuleID = ruleID
+	if err := uc.checkAutoReplyRule(ctx, rule); err != nil {
+		return 0, err
+	}
+	if err := uc.repo.SaveAutoReplyRule(ctx, rule); err != nil {
+		return 0, err
+	}
+	return rule.RuleID, nil
+}
+
+// UpdateAutoReplyRule B端修改自动回复规则，只能改本店的规则
+func (uc *ReviewerUsecase) UpdateAutoReplyRule(ctx context.Context, param *AutoReplyRuleParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateAutoReplyRule, param:%v\n", param)
+	rule := toAutoReplyRule(param)
+	if err := uc.checkAutoReplyRule(ctx, rule); err != nil {
+		return err
+	}
+	n, err := uc.repo.UpdateAutoReplyRule(ctx, rule)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return pb.ErrorNotFound("没有这个规则")
+	}
+	return nil
+}
+
+func (uc *ReviewerUsecase) DeleteAutoReplyRule(ctx context.Context, storeID int64, ruleID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteAutoReplyRule, storeID:%v ruleID:%v\n", storeID, ruleID)
+	n, err := uc.repo.DeleteAutoReplyRule(ctx, storeID, ruleID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return pb.ErrorNotFound("没有这个规则")
+	}
+	return nil
+}
+
+func (uc *ReviewerUsecase) ListAutoReplyRules(ctx context.Context, storeID int64) ([]*model.ReviewAutoReplyRule, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListAutoReplyRules, storeID:%v\n", storeID)
+	return uc.repo.ListAutoReplyRules(ctx, storeID)
+}
+
+// checkAutoReplyRule 评分区间合法，模板必须是本店的
+func (uc *ReviewerUsecase) checkAutoReplyRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error {
+	if rule.MinScore > rule.MaxScore {
+		return pb.ErrorInvalidParams("评分下限不能大于上限")
+	}
+	template, err := uc.repo.GetTemplate(ctx, rule.TemplateID)
+	if err != nil {
+		return err
+	}
+	if template.StoreID != rule.StoreID {
+		return pb.ErrorNotFound("没有这个模板")
+	}
+	return nil
+}
+
+func toAutoReplyRule(param *AutoReplyRuleParam) *model.ReviewAutoReplyRule {
+	rule := &model.ReviewAutoReplyRule{
+		RuleID:       param.RuleID,
+		StoreID:      param.StoreID,
+		TemplateID:   param.TemplateID,
+		MinScore:     param.MinScore,
+		MaxScore:     param.MaxScore,
+		DelaySeconds: param.DelaySeconds,
+	}
+	if param.OnlyEmpty {
+		rule.OnlyEmpty = 1
+	}
+	if param.Enabled {
+		rule.Enabled = 1
+	}
+	return rule
+}
+
+// RunAutoReply 执行一轮自动回复，返回回复的评价数
+// 回复走CreateReply，和商家手动回复一样在事务里写回复表和has_reply
+// 多实例部署时用锁保证同一时刻只有一个实例在跑
+func (uc *ReviewerUsecase) RunAutoReply(ctx context.Context, lease time.Duration) (int, error) {
+	token, err := uc.repo.LockAutoReply(ctx, lease)
+	if err != nil || token == "" {
+		return 0, err
+	}
+	defer uc.repo.UnlockAutoReply(context.Background(), token)
+
+	rules, err := uc.repo.ListEnabledAutoReplyRules(ctx)
+	if err != nil {
+		return 0, err
+	}
+	replied := 0
+	now := time.Now()
+	for _, rule := range rules {
+		template, err := uc.repo.GetTemplate(ctx, rule.TemplateID)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("[biz] auto reply get template failed, rule:%v err:%v", rule.RuleID, err)
+			continue
+		}
+		before := now.Add(-time.Duration(rule.DelaySeconds) * time.Second)
+		reviews, err := uc.repo.ListAutoReplyCandidates(ctx, rule, before, autoReplyBatch)
+		if err != nil {
+			return replied, err
+		}
+		ids := make([]int64, 0, len(reviews))
+		for _, r := range reviews {
+			if r.Anonymous == 0 {
+				ids = append(ids, r.UserID)
+			}
+		}
+		users := uc.GetUsers(ctx, ids)
+		for _, r := range reviews {
+			nickname := defaultNickname
+			if u, ok := users[r.UserID]; ok && r.Anonymous == 0 {
+				nickname = u.Nickname
+			}
+			_, err := uc.CreateReply(ctx, &ReplyParam{
+				ReviewID: r.ReviewID,
+				StoreID:  r.StoreID,
+				Content:  RenderTemplate(template.Content, nickname, productName(r.GoodsSnapshoot)),
+			})
+			//商家刚好手动回复了
+			if pb.IsReviewReplied(err) {
+				continue
+			}
+			if err != nil {
+				uc.log.WithContext(ctx).Errorf("[biz] auto reply failed, rule:%v review:%v err:%v", rule.RuleID, r.ReviewID, err)
+				continue
+			}
+			replied++
+		}
+	}
+	return replied, nil
+}
@@ -1,6 +1,19 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"github.com/google/wire"
+	pb "review-service/api/review/v1"
+	"review-service/pkg/snowflake"
+)
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(NewReviewerUsecase)
+
+// genID 生成业务ID，机器ID租约丢失或时钟回拨时返回503，调用方可以重试到其他实例
+func genID() (int64, error) {
+	id, err := snowflake.GenID()
+	if err != nil {
+		return 0, pb.ErrorIdUnavailable("生成ID失败:%v", err)
+	}
+	return id, nil
+}
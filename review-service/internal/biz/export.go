@@ -0,0 +1,369 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	pb "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/sheet"
+	"strconv"
+	"time"
+)
+
+// 导出任务状态
+const (
+	ExportPending = 10
+	ExportRunning = 20
+	ExportDone    = 30
+	ExportFailed  = 40
+	//文件超过保留时间已删除
+	ExportExpired = 50
+)
+
+const (
+	defaultExportMaxRows    = 100000
+	defaultExportMaxActive  = 3
+	defaultExportTTL        = 72 * time.Hour
+	defaultExportStaleAfter = 5 * time.Minute
+	//每批从ES读取的评价数，也是进度更新的粒度
+	exportBatch = 500
+	//每轮最多清理的过期文件数
+	exportCleanBatch = 100
+)
+
+var errExportTooLarge = errors.New("too many rows")
+
+// ExportStorage 导出文件的存储，本地目录或对象存储
+type ExportStorage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	//URL 对象存储返回预签名的下载地址，本地存储返回空，文件经由DownloadReviewExport读取
+	URL(ctx context.Context, key string, fileName string) (string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type ExportParam struct {
+	Format string
+	Filter *StoreReviewParam
+}
+
+// ReviewExport 导出任务及下载信息
+type ReviewExport struct {
+	*model.ReviewExportTask
+	FileName string
+	//任务完成且使用对象存储时才有
+	DownloadURL string
+}
+
+// exportOption 导出相关配置，不配置时使用默认值
+type exportOption struct {
+	tmpDir     string
+	maxRows    int32
+	maxActive  int64
+	ttl        time.Duration
+	staleAfter time.Duration
+	salt       string
+}
+
+func newExportOption(c *conf.Export, ac *conf.Anonymous) *exportOption {
+	opt := &exportOption{
+		tmpDir:     c.GetDir(),
+		maxRows:    c.GetMaxRows(),
+		maxActive:  int64(c.GetMaxActive()),
+		ttl:        c.GetTtl().AsDuration(),
+		staleAfter: c.GetStaleAfter().AsDuration(),
+		salt:       ac.GetSalt(),
+	}
+	if opt.maxRows <= 0 {
+		opt.maxRows = defaultExportMaxRows
+	}
+	if opt.maxActive <= 0 {
+		opt.maxActive = defaultExportMaxActive
+	}
+	if opt.ttl <= 0 {
+		opt.ttl = defaultExportTTL
+	}
+	if opt.staleAfter <= 0 {
+		opt.staleAfter = defaultExportStaleAfter
+	}
+	return opt
+}
+
+// CreateReviewExport 创建导出任务，由后台任务异步生成文件
+func (uc *ReviewerUsecase) CreateReviewExport(ctx context.Context, param *ExportParam) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateReviewExport, param:%v\n", param)
+	storeID := param.Filter.StoreID
+	active, err := uc.repo.CountActiveExports(WithPrimary(ctx), storeID)
+	if err != nil {
+		return 0, err
+	}
+	if active >= uc.export.maxActive {
+		return 0, pb.ErrorTooManyExports("已有%d个导出任务在进行，请稍后再试", active)
+	}
+	filter, err := json.Marshal(param.Filter)
+	if err != nil {
+		return 0, err
+	}
+	exportID, err := genID()
+	if err != nil {
+		return 0, err
+	}
+	task := &model.ReviewExportTask{
+		ExportID: exportID,
+		StoreID:  storeID,
+		Format:   param.Format,
+		Filter:   string(filter),
+		Status:   ExportPending,
+	}
+	if err := uc.repo.SaveExport(ctx, task); err != nil {
+		return 0, err
+	}
+	return exportID, nil
+}
+
+// GetReviewExport 查询本店的导出任务，不是本店的按不存在处理
+func (uc *ReviewerUsecase) GetReviewExport(ctx context.Context, storeID int64, exportID int64) (*ReviewExport, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReviewExport, storeID:%v exportID:%v\n", storeID, exportID)
+	task, err := uc.repo.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if task.StoreID != storeID {
+		return nil, pb.ErrorNotFound("没有这个导出任务")
+	}
+	return uc.reviewExport(ctx, task), nil
+}
+
+func (uc *ReviewerUsecase) ListReviewExports(ctx context.Context, storeID int64, page int32, size int32) ([]*ReviewExport, int64, error) {
+	offset, limit := pageLimit(page, size)
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewExports, storeID:%v\n", storeID)
+	tasks, total, err := uc.repo.ListExports(ctx, storeID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*ReviewExport, 0, len(tasks))
+	for _, t := range tasks {
+		list = append(list, uc.reviewExport(ctx, t))
+	}
+	return list, total, nil
+}
+
+// OpenReviewExport 读取已完成的导出文件
+func (uc *ReviewerUsecase) OpenReviewExport(ctx context.Context, storeID int64, exportID int64) (*ReviewExport, io.ReadCloser, error) {
+	export, err := uc.GetReviewExport(ctx, storeID, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.Status != ExportDone {
+		return nil, nil, pb.ErrorInvalidParams("导出文件不可下载，状态:%d", export.Status)
+	}
+	body, err := uc.storage.Open(ctx, export.FileKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return export, body, nil
+}
+
+func (uc *ReviewerUsecase) reviewExport(ctx context.Context, task *model.ReviewExportTask) *ReviewExport {
+	export := &ReviewExport{
+		ReviewExportTask: task,
+		FileName:         fmt.Sprintf("reviews_%d_%s.%s", task.StoreID, task.CreateAt.Format("20060102150405"), task.Format),
+	}
+	if task.Status != ExportDone {
+		return export
+	}
+	url, err := uc.storage.URL(ctx, task.FileKey, export.FileName)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] sign export url failed, exportID:%v err:%v", task.ExportID, err)
+	}
+	export.DownloadURL = url
+	return export
+}
+
+// RunExports 清理过期文件，然后逐个领取待执行的任务直到没有任务
+// 领取靠条件更新状态，多个实例可以同时执行不同的任务
+func (uc *ReviewerUsecase) RunExports(ctx context.Context) {
+	uc.cleanExports(ctx)
+	for ctx.Err() == nil {
+		task, err := uc.repo.ClaimExport(WithPrimary(ctx), time.Now().Add(-uc.export.staleAfter))
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("[biz] claim export failed, err:%v", err)
+			return
+		}
+		if task == nil {
+			return
+		}
+		uc.runExport(ctx, task)
+	}
+}
+
+func (uc *ReviewerUsecase) runExport(ctx context.Context, task *model.ReviewExportTask) {
+	start := time.Now()
+	key, size, err := uc.writeExport(ctx, task)
+	now := time.Now()
+	task.FinishAt = &now
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] export %v failed, err:%v", task.ExportID, err)
+		task.Status = ExportFailed
+		task.LastError = exportError(err, uc.export.maxRows)
+	} else {
+		expire := now.Add(uc.export.ttl)
+		task.Status, task.FileKey, task.FileSize, task.ExpireAt = ExportDone, key, size, &expire
+		uc.log.WithContext(ctx).Infof("[biz] export %v done, rows:%v size:%v cost:%v", task.ExportID, task.RowCount, size, now.Sub(start))
+	}
+	outcome := "done"
+	if err != nil {
+		outcome = "failed"
+	}
+	metricExports.WithLabelValues(task.Format, outcome).Inc()
+	//ctx已取消时也要记下结果，否则任务要等stale_after之后才会被重新执行
+	if err := uc.repo.UpdateExport(context.WithoutCancel(ctx), task); err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] update export %v failed, err:%v", task.ExportID, err)
+	}
+}
+
+// writeExport 先写到本地临时文件，写完再上传，返回存储路径和文件大小
+func (uc *ReviewerUsecase) writeExport(ctx context.Context, task *model.ReviewExportTask) (string, int64, error) {
+	filter := &StoreReviewParam{}
+	if err := json.Unmarshal([]byte(task.Filter), filter); err != nil {
+		return "", 0, err
+	}
+	filter.StoreID = task.StoreID
+	f, err := os.CreateTemp(uc.export.tmpDir, "review-export-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+	w, err := sheet.NewWriter(task.Format, f)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := w.Write(exportHeader); err != nil {
+		return "", 0, err
+	}
+	task.RowCount = 0
+	err = uc.repo.ScanStoreReviews(ctx, filter, exportBatch, func(list []*MyReviewInfo) error {
+		if task.RowCount+int32(len(list)) > uc.export.maxRows {
+			return errExportTooLarge
+		}
+		ids := make([]int64, 0, len(list))
+		for _, r := range list {
+			if r.Anonymous != 1 {
+				ids = append(ids, r.UserID)
+			}
+		}
+		users := uc.GetUsers(ctx, ids)
+		for _, r := range list {
+			if err := w.Write(uc.exportRow(r, users)); err != nil {
+				return err
+			}
+		}
+		task.RowCount += int32(len(list))
+		metricExportRows.Add(float64(len(list)))
+		//更新进度，同时刷新update_at，表示任务还在执行
+		return uc.repo.UpdateExport(ctx, task)
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	if err := w.Close(); err != nil {
+		return "", 0, err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+	key := fmt.Sprintf("review-export/%d/%d.%s", task.StoreID, task.ExportID, task.Format)
+	if err := uc.storage.Put(ctx, key, f, size, sheet.ContentType(task.Format)); err != nil {
+		return "", 0, err
+	}
+	return key, size, nil
+}
+
+var exportHeader = []string{"评价ID", "订单ID", "SKU ID", "用户ID", "用户昵称", "评分", "服务评分", "物流评分", "评价内容", "图片", "视频", "状态", "匿名", "商家已回复", "有用数", "评价时间"}
+
+var exportStatus = map[int32]string{10: "待审核", 20: "审核通过", 30: "审核不通过", 40: "隐藏"}
+
+// exportRow 匿名评价按B端的规则脱敏：不给用户ID，昵称用化名
+func (uc *ReviewerUsecase) exportRow(r *MyReviewInfo, users map[int64]*UserProfile) []string {
+	itoa := func(n int64) string { return strconv.FormatInt(n, 10) }
+	yesNo := func(v int32) string {
+		if v == 1 {
+			return "是"
+		}
+		return "否"
+	}
+	userID, nickname := itoa(r.UserID), ""
+	if r.Anonymous == 1 {
+		userID, nickname = "", Pseudonym(uc.export.salt, r.UserID)
+	} else if u, ok := users[r.UserID]; ok {
+		nickname = u.Nickname
+	}
+	status, ok := exportStatus[r.Status]
+	if !ok {
+		status = strconv.Itoa(int(r.Status))
+	}
+	var content, picInfo, videoInfo string
+	if r.ReviewInfo != nil {
+		content, picInfo, videoInfo = r.Content, r.PicInfo, r.VideoInfo
+	}
+	return []string{
+		itoa(r.ReviewID),
+		itoa(r.OrderID),
+		itoa(r.SkuID),
+		userID,
+		nickname,
+		itoa(int64(r.Score)),
+		itoa(int64(r.ServiceScore)),
+		itoa(int64(r.ExpressScore)),
+		content,
+		picInfo,
+		videoInfo,
+		status,
+		yesNo(r.Anonymous),
+		yesNo(r.HasReply),
+		itoa(int64(r.HelpfulCount)),
+		time.Time(r.CreateAt).Format(time.DateTime),
+	}
+}
+
+func exportError(err error, maxRows int32) string {
+	msg := err.Error()
+	if errors.Is(err, errExportTooLarge) {
+		msg = fmt.Sprintf("超过%d行，请缩小时间范围后重新导出", maxRows)
+	}
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	return msg
+}
+
+// cleanExports 删除超过保留时间的文件，任务记录保留
+func (uc *ReviewerUsecase) cleanExports(ctx context.Context) {
+	tasks, err := uc.repo.ListExpiredExports(ctx, time.Now(), exportCleanBatch)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] list expired exports failed, err:%v", err)
+		return
+	}
+	for _, t := range tasks {
+		if err := uc.storage.Delete(ctx, t.FileKey); err != nil {
+			uc.log.WithContext(ctx).Errorf("[biz] delete export file %v failed, err:%v", t.FileKey, err)
+			continue
+		}
+		t.Status = ExportExpired
+		if err := uc.repo.UpdateExport(ctx, t); err != nil {
+			uc.log.WithContext(ctx).Errorf("[biz] expire export %v failed, err:%v", t.ExportID, err)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 导入文件格式
+const (
+	ImportJSONL = "jsonl"
+	ImportCSV   = "csv"
+)
+
+// 每行的导入结果
+const (
+	ImportImported = "imported"
+	//之前已经导入过，返回之前的评价ID
+	ImportSkipped = "skipped"
+	ImportFailed  = "failed"
+)
+
+const defaultImportBatch = 500
+
+type ImportOption struct {
+	//来源平台，和来源评价ID一起确定一条评价，重复导入时跳过
+	Source string
+	Batch  int
+}
+
+// ImportRow 导入文件中的一行，CSV的表头和JSON的key相同
+type ImportRow struct {
+	Line         int    `json:"-"`
+	SourceID     string `json:"source_id"`
+	UserID       int64  `json:"user_id"`
+	OrderID      int64  `json:"order_id"`
+	StoreID      int64  `json:"store_id"`
+	SkuID        int64  `json:"sku_id"`
+	SpuID        int64  `json:"spu_id"`
+	Score        int32  `json:"score"`
+	ServiceScore int32  `json:"service_score"`
+	ExpressScore int32  `json:"express_score"`
+	Content      string `json:"content"`
+	PicInfo      string `json:"pic_info"`
+	VideoInfo    string `json:"video_info"`
+	Anonymous    bool   `json:"anonymous"`
+	//不填按审核通过导入
+	Status int32 `json:"status"`
+	//原平台的评价时间，2006-01-02 15:04:05或RFC3339
+	CreateAt string `json:"create_at"`
+	UpdateAt string `json:"update_at"`
+}
+
+// ImportResult 一行的导入结果，写到导入报告里
+type ImportResult struct {
+	Line     int    `json:"line"`
+	SourceID string `json:"source_id"`
+	ReviewID int64  `json:"review_id,omitempty"`
+	Result   string `json:"result"`
+	Error    string `json:"error,omitempty"`
+}
+
+type ImportReport struct {
+	Total    int64
+	Imported int64
+	Skipped  int64
+	Failed   int64
+}
+
+func (r *ImportReport) add(ret *ImportResult) {
+	r.Total++
+	switch ret.Result {
+	case ImportImported:
+		r.Imported++
+	case ImportSkipped:
+		r.Skipped++
+	default:
+		r.Failed++
+	}
+}
+
+// ImportedReview 待写入的评价和它的来源评价ID
+type ImportedReview struct {
+	SourceID string
+	Review   *model.ReviewInfo
+}
+
+// ImportRepo 导入用到的存储操作，cmd/import直接连库使用，不经过ReviewRepo
+type ImportRepo interface {
+	//已经导入过的来源评价ID和对应的评价ID
+	FindImported(ctx context.Context, source string, sourceIDs []string) (map[string]int64, error)
+	//已经有评价的订单
+	FindReviewedOrders(ctx context.Context, orderIDs []int64) (map[int64]bool, error)
+	//在一个事务里写评价、索引表和来源映射
+	SaveImported(ctx context.Context, source string, reviews []*ImportedReview) error
+}
+
+// ImportReader 逐行读取JSONL或CSV，格式错误的行返回带行号的ImportRow和错误，可以继续读
+type ImportReader struct {
+	next func() (*ImportRow, error)
+}
+
+func NewImportReader(format string, r io.Reader) (*ImportReader, error) {
+	switch strings.ToLower(format) {
+	case ImportJSONL:
+		return newJSONLReader(r), nil
+	case ImportCSV:
+		return newCSVReader(r)
+	}
+	return nil, fmt.Errorf("unsupported import format: %s", format)
+}
+
+// Next 读完时返回io.EOF
+func (r *ImportReader) Next() (*ImportRow, error) {
+	return r.next()
+}
+
+func newJSONLReader(r io.Reader) *ImportReader {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64<<10), 1<<20)
+	line := 0
+	return &ImportReader{next: func() (*ImportRow, error) {
+		for sc.Scan() {
+			line++
+			text := bytes.TrimSpace(sc.Bytes())
+			if len(text) == 0 {
+				continue
+			}
+			row := &ImportRow{Line: line}
+			return row, json.Unmarshal(text, row)
+		}
+		if err := sc.Err(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line+1, err)
+		}
+		return nil, io.EOF
+	}}
+}
+
+func newCSVReader(r io.Reader) (*ImportReader, error) {
+	cr := csv.NewReader(r)
+	//缺少的列按空值处理
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	//Excel导出的CSV带BOM
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	return &ImportReader{next: func() (*ImportRow, error) {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if err != nil {
+			//引号不匹配等格式错误只影响这一行
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				return &ImportRow{Line: pe.StartLine}, err
+			}
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		row := &ImportRow{Line: line}
+		return row, row.setFields(header, record)
+	}}, nil
+}
+
+// setFields 按表头填充字段，不认识的列忽略
+func (row *ImportRow) setFields(header []string, record []string) error {
+	for i, name := range header {
+		if i >= len(record) {
+			break
+		}
+		v := strings.TrimSpace(record[i])
+		if v == "" {
+			continue
+		}
+		var err error
+		switch strings.TrimSpace(name) {
+		case "source_id":
+			row.SourceID = v
+		case "user_id":
+			row.UserID, err = strconv.ParseInt(v, 10, 64)
+		case "order_id":
+			row.OrderID, err = strconv.ParseInt(v, 10, 64)
+		case "store_id":
+			row.StoreID, err = strconv.ParseInt(v, 10, 64)
+		case "sku_id":
+			row.SkuID, err = strconv.ParseInt(v, 10, 64)
+		case "spu_id":
+			row.SpuID, err = strconv.ParseInt(v, 10, 64)
+		case "score":
+			row.Score, err = parseInt32(v)
+		case "service_score":
+			row.ServiceScore, err = parseInt32(v)
+		case "express_score":
+			row.ExpressScore, err = parseInt32(v)
+		case "content":
+			row.Content = record[i]
+		case "pic_info":
+			row.PicInfo = v
+		case "video_info":
+			row.VideoInfo = v
+		case "anonymous":
+			row.Anonymous, err = strconv.ParseBool(v)
+		case "status":
+			row.Status, err = parseInt32(v)
+		case "create_at":
+			row.CreateAt = v
+		case "update_at":
+			row.UpdateAt = v
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func parseInt32(s string) (int32, error) {
+	n, err := strconv.ParseInt(s, 10, 32)
+	return int32(n), err
+}
+
+var importStatus = map[int32]bool{10: true, 20: true, 30: true, 40: true}
+
+// toReview 按CreateReviewRequest的规则校验，保留原平台的时间
+func (row *ImportRow) toReview(source string) (*model.ReviewInfo, error) {
+	if row.SourceID == "" || len(row.SourceID) > 64 {
+		return nil, errors.New("source_id不能为空且不超过64个字符")
+	}
+	req := &pb.CreateReviewRequest{
+		UserID:       row.UserID,
+		OrderID:      row.OrderID,
+		StoreID:      row.StoreID,
+		Score:        row.Score,
+		ServiceScore: row.ServiceScore,
+		ExpressScore: row.ExpressScore,
+		Content:      row.Content,
+		PicInfo:      row.PicInfo,
+		VideoInfo:    row.VideoInfo,
+		Anonymous:    row.Anonymous,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	status := row.Status
+	if status == 0 {
+		status = 20
+	}
+	if !importStatus[status] {
+		return nil, fmt.Errorf("status:%d 不是有效的评价状态", row.Status)
+	}
+	createAt, err := parseImportTime(row.CreateAt)
+	if err != nil {
+		return nil, fmt.Errorf("create_at: %w", err)
+	}
+	updateAt := createAt
+	if row.UpdateAt != "" {
+		if updateAt, err = parseImportTime(row.UpdateAt); err != nil {
+			return nil, fmt.Errorf("update_at: %w", err)
+		}
+	}
+	review := &model.ReviewInfo{
+		CreateBy:     source,
+		UpdateBy:     source,
+		CreateAt:     createAt,
+		UpdateAt:     updateAt,
+		UserID:       row.UserID,
+		OrderID:      row.OrderID,
+		StoreID:      row.StoreID,
+		SkuID:        row.SkuID,
+		SpuID:        row.SpuID,
+		Score:        row.Score,
+		ServiceScore: row.ServiceScore,
+		ExpressScore: row.ExpressScore,
+		Content:      row.Content,
+		PicInfo:      row.PicInfo,
+		VideoInfo:    row.VideoInfo,
+		Status:       status,
+	}
+	if row.Anonymous {
+		review.Anonymous = 1
+	}
+	if row.PicInfo != "" || row.VideoInfo != "" {
+		review.HasMedia = 1
+	}
+	return review, nil
+}
+
+func parseImportTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errors.New("不能为空")
+	}
+	if t, err := time.ParseInLocation(time.DateTime, s, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// RunImport 分批导入评价，每行的结果交给out，返回汇总
+// 单行的问题只让这一行失败；写库或生成ID失败时中止，已提交的批次重跑时会被跳过
+func RunImport(ctx context.Context, repo ImportRepo, rd *ImportReader, opt *ImportOption, out func(*ImportResult)) (*ImportReport, error) {
+	if opt.Source == "" {
+		return nil, errors.New("import source is required")
+	}
+	size := opt.Batch
+	if size <= 0 {
+		size = defaultImportBatch
+	}
+	report := &ImportReport{}
+	emit := func(ret *ImportResult) {
+		report.add(ret)
+		out(ret)
+	}
+	batch := make([]*ImportRow, 0, size)
+	//整个文件里出现过的来源评价ID，重复的行不导入
+	seen := make(map[string]bool)
+	for {
+		row, err := rd.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if row == nil {
+				return report, err
+			}
+			emit(&ImportResult{Line: row.Line, SourceID: row.SourceID, Result: ImportFailed, Error: err.Error()})
+			continue
+		}
+		batch = append(batch, row)
+		if len(batch) < size {
+			continue
+		}
+		if err := importBatch(ctx, repo, opt.Source, batch, seen, emit); err != nil {
+			return report, err
+		}
+		batch = batch[:0]
+	}
+	if len(batch) > 0 {
+		if err := importBatch(ctx, repo, opt.Source, batch, seen, emit); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+func importBatch(ctx context.Context, repo ImportRepo, source string, rows []*ImportRow, seen map[string]bool, emit func(*ImportResult)) error {
+	type pending struct {
+		row    *ImportRow
+		review *model.ReviewInfo
+	}
+	valid := make([]*pending, 0, len(rows))
+	sourceIDs := make([]string, 0, len(rows))
+	orderIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		review, err := row.toReview(source)
+		if err == nil && seen[row.SourceID] {
+			err = errors.New("文件中source_id重复")
+		}
+		if err != nil {
+			emit(&ImportResult{Line: row.Line, SourceID: row.SourceID, Result: ImportFailed, Error: err.Error()})
+			continue
+		}
+		seen[row.SourceID] = true
+		valid = append(valid, &pending{row: row, review: review})
+		sourceIDs = append(sourceIDs, row.SourceID)
+		orderIDs = append(orderIDs, row.OrderID)
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+	imported, err := repo.FindImported(ctx, source, sourceIDs)
+	if err != nil {
+		return err
+	}
+	reviewed, err := repo.FindReviewedOrders(ctx, orderIDs)
+	if err != nil {
+		return err
+	}
+	saves := make([]*ImportedReview, 0, len(valid))
+	results := make([]*ImportResult, 0, len(valid))
+	for _, p := range valid {
+		ret := &ImportResult{Line: p.row.Line, SourceID: p.row.SourceID}
+		if reviewID, ok := imported[p.row.SourceID]; ok {
+			ret.ReviewID, ret.Result = reviewID, ImportSkipped
+			emit(ret)
+			continue
+		}
+		//和CreateReview一样，一个订单只能有一条评价
+		if reviewed[p.row.OrderID] {
+			ret.Result, ret.Error = ImportFailed, fmt.Sprintf("订单:%v 已评价过", p.row.OrderID)
+			emit(ret)
+			continue
+		}
+		reviewed[p.row.OrderID] = true
+		reviewID, err := genID()
+		if err != nil {
+			return err
+		}
+		p.review.ReviewID = reviewID
+		ret.ReviewID, ret.Result = reviewID, ImportImported
+		saves = append(saves, &ImportedReview{SourceID: p.row.SourceID, Review: p.review})
+		results = append(results, ret)
+	}
+	if len(saves) > 0 {
+		if err := repo.SaveImported(ctx, source, saves); err != nil {
+			return err
+		}
+	}
+	for _, ret := range results {
+		emit(ret)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// 业务指标，只统计成功的写操作，失败的请求看server_requests_code_total
+var (
+	metricReviewsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "review",
+		Name:      "reviews_created_total",
+		Help:      "Reviews created by users.",
+	})
+	metricReviewAudits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "review",
+		Name:      "review_audits_total",
+		Help:      "Review audits by outcome.",
+	}, []string{"outcome"})
+	metricAppealAudits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "review",
+		Name:      "appeal_audits_total",
+		Help:      "Appeal audits by outcome.",
+	}, []string{"outcome"})
+	metricRepliesCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "review",
+		Name:      "replies_created_total",
+		Help:      "Replies posted by stores, including auto replies.",
+	})
+	//最近一轮mysql和ES对账的结果
+	metricReconcileDocs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "review",
+		Subsystem: "reconcile",
+		Name:      "documents",
+		Help:      "Result of the last MySQL/Elasticsearch reconciliation by kind.",
+	}, []string{"kind"})
+	metricReconcileLastRun = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "review",
+		Subsystem: "reconcile",
+		Name:      "last_run_timestamp_seconds",
+		Help:      "Unix time the last reconciliation finished.",
+	})
+	metricExports = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "review",
+		Name:      "exports_total",
+		Help:      "Finished review export tasks by format and outcome.",
+	}, []string{"format", "outcome"})
+	metricExportRows = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "review",
+		Name:      "export_rows_total",
+		Help:      "Rows written to review export files.",
+	})
+)
+
+func observeReconcile(r *ReconcileReport) {
+	metricReconcileDocs.WithLabelValues("scanned").Set(float64(r.Scanned))
+	metricReconcileDocs.WithLabelValues("missing").Set(float64(r.Missing))
+	metricReconcileDocs.WithLabelValues("stale").Set(float64(r.Stale))
+	metricReconcileDocs.WithLabelValues("orphan").Set(float64(r.Orphan))
+	metricReconcileDocs.WithLabelValues("repaired").Set(float64(r.Repaired))
+	metricReconcileLastRun.SetToCurrentTime()
+}
+
+// reviewOutcome 评价审核结果 20审核通过 30审核不通过 40隐藏
+func reviewOutcome(status int32) string {
+	switch status {
+	case 20:
+		return "approved"
+	case 30:
+		return "rejected"
+	case 40:
+		return "hidden"
+	}
+	return "other"
+}
+
+func appealOutcome(status int32) string {
+	switch status {
+	case AppealApproved:
+		return "approved"
+	case AppealRejected:
+		return "rejected"
+	case AppealNeedMoreInfo:
+		return "need_more_info"
+	}
+	return "other"
+}
@@ -6,6 +6,22 @@ type GetAllReviewsParam struct {
 	UserID int64
 }
 
+// UserReviewParam c端我的评价的筛选条件，Status和HasReply为0时不限
+type UserReviewParam struct {
+	UserID    int64
+	Status    int32
+	HasReply  int32
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type ReviewDetailParam struct {
+	ReviewID int64
+	//不为0时只有评价属于该店铺才查申诉
+	StoreID    int64
+	WithAppeal bool
+}
+
 type ReplyParam struct {
 	ReviewID  int64
 	StoreID   int64
@@ -49,3 +65,72 @@ type AppealParam struct {
 	PicInfo   string
 	VideoInfo string
 }
+
+type VoteParam struct {
+	ReviewID int64
+	UserID   int64
+}
+
+type ReportParam struct {
+	ReviewID int64
+	UserID   int64
+	Reason   int32
+	Content  string
+}
+
+type PendingParam struct {
+	StoreID        int64
+	HasMedia       int32
+	MinReportCount int32
+	CreatedBefore  time.Time
+}
+
+type StoreReviewParam struct {
+	StoreID   int64
+	Unreplied bool
+	MaxScore  int32
+	HasMedia  int32
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type TemplateParam struct {
+	StoreID    int64
+	TemplateID int64
+	Name       string
+	Content    string
+}
+
+type AutoReplyRuleParam struct {
+	StoreID      int64
+	RuleID       int64
+	TemplateID   int64
+	MinScore     int32
+	MaxScore     int32
+	OnlyEmpty    bool
+	DelaySeconds int32
+	Enabled      bool
+}
+
+type SupplementAppealParam struct {
+	StoreID   int64
+	AppealID  int64
+	Content   string
+	PicInfo   string
+	VideoInfo string
+}
+
+type WebhookParam struct {
+	OwnerType int32
+	OwnerID   int64
+	URL       string
+	Events    []string
+}
+
+type ListReviewParam struct {
+	//按内容查询时为0
+	StoreID int64
+	Sort    string
+	//上一页降级返回的游标
+	Cursor string
+}
@@ -0,0 +1,45 @@
+package biz
+
+import (
+	"context"
+	"review-service/internal/data/model"
+	"time"
+)
+
+// PendingReviews 待审核评价的一页数据，Total和OldestCreateAt按全部符合条件的记录统计
+type PendingReviews struct {
+	List           []*model.ReviewInfo
+	Total          int64
+	OldestCreateAt time.Time
+}
+
+// PendingAppeals 待审核申诉的一页数据
+type PendingAppeals struct {
+	List           []*model.ReviewAppealInfo
+	Total          int64
+	OldestCreateAt time.Time
+}
+
+// ListPendingReviews O端按创建时间正序查询待审核评价，先进先审
+func (uc *ReviewerUsecase) ListPendingReviews(ctx context.Context, param *PendingParam, page int32, size int32) (*PendingReviews, error) {
+	offset, limit := pageLimit(page, size)
+	uc.log.WithContext(ctx).Debugf("[biz] ListPendingReviews param:%v page:%v size:%v\n", param, page, size)
+	return uc.repo.ListPendingReviews(ctx, param, offset, limit)
+}
+
+// ListPendingAppeals O端按创建时间正序查询待审核申诉
+func (uc *ReviewerUsecase) ListPendingAppeals(ctx context.Context, param *PendingParam, page int32, size int32) (*PendingAppeals, error) {
+	offset, limit := pageLimit(page, size)
+	uc.log.WithContext(ctx).Debugf("[biz] ListPendingAppeals param:%v page:%v size:%v\n", param, page, size)
+	return uc.repo.ListPendingAppeals(ctx, param, offset, limit)
+}
+
+func pageLimit(page int32, size int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 10
+	}
+	return (page - 1) * size, size
+}
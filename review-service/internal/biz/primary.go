@@ -0,0 +1,17 @@
+package biz
+
+import "context"
+
+type primaryKey struct{}
+
+// WithPrimary 标记本次请求的读操作走主库
+// 刚写完马上要读的场景用，避免主从延迟读到旧数据
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsePrimary 读操作是否要走主库
+func UsePrimary(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
+}
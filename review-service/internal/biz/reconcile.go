@@ -0,0 +1,76 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// 报告里每类最多记录的评价ID，便于排查
+const reconcileSampleSize = 20
+
+type ReconcileOption struct {
+	//只对账review_id在[FromID, ToID)内的评价，为0不限
+	FromID int64
+	ToID   int64
+	Batch  int
+	//缺失和过期的文档重新写入，多余的文档删除
+	Repair bool
+}
+
+// ReconcileReport 一轮对账的结果
+type ReconcileReport struct {
+	//扫描的mysql评价数和ES文档数
+	Scanned   int64
+	Documents int64
+	//ES里没有的评价
+	Missing int64
+	//update_at和mysql不一致的文档
+	Stale int64
+	//mysql里已经没有的文档
+	Orphan   int64
+	Repaired int64
+
+	MissingIDs []int64
+	StaleIDs   []int64
+	OrphanIDs  []int64
+}
+
+func (r *ReconcileReport) AddMissing(reviewID int64) {
+	r.Missing++
+	r.MissingIDs = appendSample(r.MissingIDs, reviewID)
+}
+
+func (r *ReconcileReport) AddStale(reviewID int64) {
+	r.Stale++
+	r.StaleIDs = appendSample(r.StaleIDs, reviewID)
+}
+
+func (r *ReconcileReport) AddOrphan(reviewID int64) {
+	r.Orphan++
+	r.OrphanIDs = appendSample(r.OrphanIDs, reviewID)
+}
+
+func appendSample(ids []int64, id int64) []int64 {
+	if len(ids) >= reconcileSampleSize {
+		return ids
+	}
+	return append(ids, id)
+}
+
+// RunReconcile 执行一轮mysql和ES对账，多实例部署时用锁保证同一时刻只有一个实例在跑
+// 没拿到锁时返回nil
+func (uc *ReviewerUsecase) RunReconcile(ctx context.Context, opt *ReconcileOption, lease time.Duration) (*ReconcileReport, error) {
+	token, err := uc.repo.LockReconcile(ctx, lease)
+	if err != nil || token == "" {
+		return nil, err
+	}
+	defer uc.repo.UnlockReconcile(context.Background(), token)
+
+	//从库有延迟，按从库的数据修复会把ES改回旧版本
+	report, err := uc.repo.ReconcileIndex(WithPrimary(ctx), opt)
+	if err != nil {
+		return nil, err
+	}
+	observeReconcile(report)
+	return report, nil
+}
@@ -0,0 +1,62 @@
+package biz
+
+import (
+	"context"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+)
+
+const defaultReportThreshold = 5
+
+// ReportedReview 被举报的评价及按原因聚合的待处理举报数
+type ReportedReview struct {
+	*model.ReviewInfo
+	Reasons map[int32]int64
+}
+
+// ReportReview c端举报评价，每个用户对一条评价只能举报一次
+func (uc *ReviewerUsecase) ReportReview(ctx context.Context, param *ReportParam) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ReportReview, param:%v\n", param)
+	review, err := uc.repo.GetReviewByReviewID(ctx, param.ReviewID)
+	if err != nil {
+		return 0, pb.ErrorDbFailed("查询数据库失败")
+	}
+	reportID, err := genID()
+	if err != nil {
+		return 0, err
+	}
+	report := &model.ReviewReportInfo{
+		ReportID: reportID,
+		ReviewID: param.ReviewID,
+		StoreID:  review.StoreID,
+		UserID:   param.UserID,
+		Reason:   param.Reason,
+		Content:  param.Content,
+		Status:   10,
+	}
+	threshold := uc.reportThreshold
+	if threshold <= 0 {
+		threshold = defaultReportThreshold
+	}
+	requeued, err := uc.repo.SaveReport(ctx, report, threshold)
+	if err != nil {
+		return 0, err
+	}
+	if requeued {
+		uc.log.WithContext(ctx).Infof("[biz] review:%v reported over threshold, back to audit", param.ReviewID)
+	}
+	return report.ReportID, nil
+}
+
+func (uc *ReviewerUsecase) ListReportedReviews(ctx context.Context, page int32, size int32) ([]*ReportedReview, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 10
+	}
+	offset := (page - 1) * size
+	limit := size
+	uc.log.WithContext(ctx).Debugf("[biz] ListReportedReviews page:%v size:%v\n", page, size)
+	return uc.repo.ListReportedReviews(ctx, offset, limit)
+}
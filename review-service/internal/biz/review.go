@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	pb "review-service/api/review/v1"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
-	"review-service/pkg/snowflake"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -19,25 +20,99 @@ type ReviewRepo interface {
 	UpdateReview(ctx context.Context, updatereview *model.ReviewInfo) (int64, error)
 	DeleteReview(ctx context.Context, deletereview *model.ReviewInfo) (int64, error)
 	GetReviewByUserID(ctx context.Context, id int64) ([]*model.ReviewInfo, error)
+	ListUserReviews(ctx context.Context, param *UserReviewParam, offset int32, limit int32) ([]*model.ReviewInfo, int64, error)
+	GetReviewDetail(ctx context.Context, reviewID int64) (*ReviewDetail, error)
+	BatchGetReviews(ctx context.Context, reviewIDs []int64) (map[int64]*model.ReviewInfo, error)
+	GetReviewsByOrderIDs(ctx context.Context, orderIDs []int64) (map[int64]*model.ReviewInfo, error)
 
 	SaveReply(ctx context.Context, reply *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error)
 	UpdateReply(ctx context.Context, reply *model.ReviewReplyInfo) (int64, error)
-	SaveAppeal(ctx context.Context, appeal *model.ReviewAppealInfo) (int64, error)
+	SaveAppeal(ctx context.Context, appeal *model.ReviewAppealInfo, msg *model.ReviewAppealMessage) (int64, error)
+	GetLatestAppeal(ctx context.Context, reviewID int64) (*model.ReviewAppealInfo, error)
+	TransitAppeal(ctx context.Context, appealID int64, from []int32, to int32, msg *model.ReviewAppealMessage) error
+	GetAppealHistory(ctx context.Context, reviewID int64) (*AppealHistory, error)
 
-	AuditAppeal(ctx context.Context, audit *model.ReviewAppealInfo) error
+	AuditAppeal(ctx context.Context, audit *model.ReviewAppealInfo, msg *model.ReviewAppealMessage) error
 	AuditReview(ctx context.Context, audit *model.ReviewInfo) error
 
-	ListReviewByStoreID(ctx context.Context, id int64, offset int32, limit int32) ([]*MyReviewInfo, error)
-	ListReviewByContent(ctx context.Context, offset int32, limit int32) ([]*MyReviewInfo, error)
+	ListReviewByStoreID(ctx context.Context, param *ListReviewParam, offset int32, limit int32) (*ReviewList, error)
+	ListReviewByContent(ctx context.Context, param *ListReviewParam, offset int32, limit int32) (*ReviewList, error)
+
+	SaveVote(ctx context.Context, vote *model.ReviewVoteInfo) error
+	DeleteVote(ctx context.Context, vote *model.ReviewVoteInfo) (int64, error)
+	FlushHelpfulVotes(ctx context.Context, lease time.Duration) (int, error)
+
+	SaveReport(ctx context.Context, report *model.ReviewReportInfo, threshold int32) (bool, error)
+	ListReportedReviews(ctx context.Context, offset int32, limit int32) ([]*ReportedReview, error)
+
+	ListPendingReviews(ctx context.Context, param *PendingParam, offset int32, limit int32) (*PendingReviews, error)
+	ListPendingAppeals(ctx context.Context, param *PendingParam, offset int32, limit int32) (*PendingAppeals, error)
+
+	ListStoreReviews(ctx context.Context, param *StoreReviewParam, offset int32, limit int32) ([]*model.ReviewInfo, int64, error)
+	ListStoreAppeals(ctx context.Context, storeID int64, status int32, offset int32, limit int32) ([]*model.ReviewAppealInfo, int64, error)
+	GetAppealByAppealID(ctx context.Context, appealID int64) (*model.ReviewAppealInfo, error)
+
+	SaveTemplate(ctx context.Context, template *model.ReviewReplyTemplate) error
+	UpdateTemplate(ctx context.Context, template *model.ReviewReplyTemplate) (int64, error)
+	DeleteTemplate(ctx context.Context, storeID int64, templateID int64) (int64, error)
+	GetTemplate(ctx context.Context, templateID int64) (*model.ReviewReplyTemplate, error)
+	ListTemplates(ctx context.Context, storeID int64) ([]*model.ReviewReplyTemplate, error)
+
+	SaveAutoReplyRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error
+	UpdateAutoReplyRule(ctx context.Context, rule *model.ReviewAutoReplyRule) (int64, error)
+	DeleteAutoReplyRule(ctx context.Context, storeID int64, ruleID int64) (int64, error)
+	ListAutoReplyRules(ctx context.Context, storeID int64) ([]*model.ReviewAutoReplyRule, error)
+	ListEnabledAutoReplyRules(ctx context.Context) ([]*model.ReviewAutoReplyRule, error)
+	CountRulesByTemplate(ctx context.Context, templateID int64) (int64, error)
+	ListAutoReplyCandidates(ctx context.Context, rule *model.ReviewAutoReplyRule, before time.Time, limit int) ([]*model.ReviewInfo, error)
+	LockAutoReply(ctx context.Context, lease time.Duration) (string, error)
+	UnlockAutoReply(ctx context.Context, token string)
+
+	SaveWebhook(ctx context.Context, sub *model.ReviewWebhookSubscription) error
+	DeleteWebhook(ctx context.Context, ownerType int32, ownerID int64, subscriptionID int64) (int64, error)
+	GetWebhook(ctx context.Context, subscriptionID int64) (*model.ReviewWebhookSubscription, error)
+	ListWebhooks(ctx context.Context, ownerType int32, ownerID int64) ([]*model.ReviewWebhookSubscription, error)
+	ListWebhooksForStore(ctx context.Context, storeID int64) ([]*model.ReviewWebhookSubscription, error)
+	SaveWebhookDeliveries(ctx context.Context, deliveries []*model.ReviewWebhookDelivery) error
+	ListWebhookDeliveries(ctx context.Context, subscriptionID int64, status int32, offset int32, limit int32) ([]*model.ReviewWebhookDelivery, int64, error)
+	ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.ReviewWebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.ReviewWebhookDelivery) error
+	LockWebhookDelivery(ctx context.Context, lease time.Duration) (string, error)
+	UnlockWebhookDelivery(ctx context.Context, token string)
+
+	SaveExport(ctx context.Context, task *model.ReviewExportTask) error
+	GetExport(ctx context.Context, exportID int64) (*model.ReviewExportTask, error)
+	ListExports(ctx context.Context, storeID int64, offset int32, limit int32) ([]*model.ReviewExportTask, int64, error)
+	CountActiveExports(ctx context.Context, storeID int64) (int64, error)
+	ClaimExport(ctx context.Context, staleBefore time.Time) (*model.ReviewExportTask, error)
+	UpdateExport(ctx context.Context, task *model.ReviewExportTask) error
+	ListExpiredExports(ctx context.Context, now time.Time, limit int) ([]*model.ReviewExportTask, error)
+	ScanStoreReviews(ctx context.Context, param *StoreReviewParam, batch int, fn func([]*MyReviewInfo) error) error
+
+	ReconcileIndex(ctx context.Context, opt *ReconcileOption) (*ReconcileReport, error)
+	LockReconcile(ctx context.Context, lease time.Duration) (string, error)
+	UnlockReconcile(ctx context.Context, token string)
 }
 
 type ReviewerUsecase struct {
 	repo ReviewRepo
+	user UserClient
 	log  *log.Helper
+
+	reportThreshold int32
+	storage         ExportStorage
+	export          *exportOption
 }
 
-func NewReviewerUsecase(repo ReviewRepo, logger log.Logger) *ReviewerUsecase {
-	return &ReviewerUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewReviewerUsecase(repo ReviewRepo, user UserClient, storage ExportStorage, rc *conf.Report, ec *conf.Export, ac *conf.Anonymous, logger log.Logger) *ReviewerUsecase {
+	return &ReviewerUsecase{
+		repo:            repo,
+		user:            user,
+		log:             log.NewHelper(logger),
+		reportThreshold: rc.GetThreshold(),
+		storage:         storage,
+		export:          newExportOption(ec, ac),
+	}
 }
 
 // c端
@@ -47,7 +122,8 @@ func (uc *ReviewerUsecase) CreateReview(ctx context.Context, review *model.Revie
 	//1.1参数基础校验
 	//validator review.proto->review.pb.validate.go
 	//1.2参数业务校验
-	reviews, err := uc.repo.GetReviewByOrderID(ctx, review.OrderID)
+	//重复评价校验走主库，从库延迟时同一订单可能评价两次
+	reviews, err := uc.repo.GetReviewByOrderID(WithPrimary(ctx), review.OrderID)
 	if err != nil {
 		return nil, pb.ErrorDbFailed("查询数据库失败")
 	}
@@ -58,11 +134,20 @@ func (uc *ReviewerUsecase) CreateReview(ctx context.Context, review *model.Revie
 	//2.生成reviewer ID
 	//snowflake or company service
 	//snowflake main里面init了snowflake.node用于生成
-	review.ReviewID = snowflake.GenID()
+	reviewID, err := genID()
+	if err != nil {
+		return nil, err
+	}
+	review.ReviewID = reviewID
 	//3.查询订单与商品快照信息
 	//实际业务场景下查询，rpc调用订单服务和商家服务
 	//4.拼装数据入库
-	return uc.repo.SaveReview(ctx, review)
+	review, err = uc.repo.SaveReview(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	metricReviewsCreated.Inc()
+	return review, nil
 }
 
 func (uc *ReviewerUsecase) GetReview(ctx context.Context, m *model.ReviewInfo) (*pb.GetReviewReply, error) {
@@ -81,11 +166,37 @@ func (uc *ReviewerUsecase) GetReview(ctx context.Context, m *model.ReviewInfo) (
 		PicInfo:      review.PicInfo,
 		VideoInfo:    review.VideoInfo,
 		Status:       review.Status,
+		Anonymous:    review.Anonymous == 1,
 	}, nil
 }
 
+// ReviewDetail 评价详情，没有回复或申诉时对应字段为nil
+type ReviewDetail struct {
+	Review *model.ReviewInfo
+	Reply  *model.ReviewReplyInfo
+	Appeal *model.ReviewAppealInfo
+}
+
+// GetReviewDetail 查询评价及商家回复，需要时带上最近一轮申诉
+func (uc *ReviewerUsecase) GetReviewDetail(ctx context.Context, param *ReviewDetailParam) (*ReviewDetail, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReviewDetail, param:%v\n", param)
+	detail, err := uc.repo.GetReviewDetail(ctx, param.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if param.WithAppeal && (param.StoreID == 0 || param.StoreID == detail.Review.StoreID) {
+		if detail.Appeal, err = uc.repo.GetLatestAppeal(ctx, param.ReviewID); err != nil {
+			return nil, err
+		}
+	}
+	return detail, nil
+}
+
+// GetAllReviews 不分页返回用户的全部评价，没有评价时返回错误，行为和原来一致
+//
+// Deprecated: 使用ListUserReviews，新调用方不要再接这个接口
 func (uc *ReviewerUsecase) GetAllReviews(ctx context.Context, param *GetAllReviewsParam) ([]*model.ReviewInfo, error) {
-	uc.log.WithContext(ctx).Debugf("[biz] GetAllReviews, req:%v", param)
+	uc.log.WithContext(ctx).Warnf("[biz] deprecated GetAllReviews called, req:%v", param)
 	//1.数据校验
 	//1.1参数基础校验
 	//validator review.proto->review.pb.validate.go
@@ -102,11 +213,89 @@ func (uc *ReviewerUsecase) GetAllReviews(ctx context.Context, param *GetAllRevie
 	return reviews, err
 }
 
+// ListUserReviews c端我的评价，按创建时间倒序分页
+func (uc *ReviewerUsecase) ListUserReviews(ctx context.Context, param *UserReviewParam, page int32, size int32) ([]*model.ReviewInfo, int64, error) {
+	offset, limit := pageLimit(page, size)
+	uc.log.WithContext(ctx).Debugf("[biz] ListUserReviews param:%v page:%v size:%v\n", param, page, size)
+	return uc.repo.ListUserReviews(ctx, param, offset, limit)
+}
+
+// maxBatchGet 批量查询一次最多的ID数，和proto里的max_items一致
+const maxBatchGet = 50
+
+// BatchReviews 批量查询结果，List按请求中的顺序，查不到的ID放在NotFound里
+type BatchReviews struct {
+	List     []*model.ReviewInfo
+	NotFound []int64
+}
+
+// BatchGetReviews 按评价ID批量查询，重复的ID只返回一次
+func (uc *ReviewerUsecase) BatchGetReviews(ctx context.Context, reviewIDs []int64) (*BatchReviews, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchGetReviews, reviewIDs:%v\n", reviewIDs)
+	ids, err := distinctIDs(reviewIDs)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := uc.repo.BatchGetReviews(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return batchOf(ids, reviews), nil
+}
+
+// GetReviewsByOrderIDs 按订单ID批量查询，没有评价的订单放在NotFound里
+func (uc *ReviewerUsecase) GetReviewsByOrderIDs(ctx context.Context, orderIDs []int64) (*BatchReviews, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReviewsByOrderIDs, orderIDs:%v\n", orderIDs)
+	ids, err := distinctIDs(orderIDs)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := uc.repo.GetReviewsByOrderIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return batchOf(ids, reviews), nil
+}
+
+// distinctIDs 去重并保持顺序，去重后仍超过上限时报参数错误
+func distinctIDs(ids []int64) ([]int64, error) {
+	seen := make(map[int64]bool, len(ids))
+	ret := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, pb.ErrorInvalidParams("ID必须大于0")
+		}
+		if !seen[id] {
+			seen[id] = true
+			ret = append(ret, id)
+		}
+	}
+	if len(ret) == 0 {
+		return nil, pb.ErrorInvalidParams("ID不能为空")
+	}
+	if len(ret) > maxBatchGet {
+		return nil, pb.ErrorInvalidParams("一次最多查询%d个ID", maxBatchGet)
+	}
+	return ret, nil
+}
+
+func batchOf(ids []int64, reviews map[int64]*model.ReviewInfo) *BatchReviews {
+	ret := &BatchReviews{List: make([]*model.ReviewInfo, 0, len(reviews))}
+	for _, id := range ids {
+		if review, ok := reviews[id]; ok {
+			ret.List = append(ret.List, review)
+		} else {
+			ret.NotFound = append(ret.NotFound, id)
+		}
+	}
+	return ret
+}
+
 func (uc *ReviewerUsecase) UpdateReview(ctx context.Context, updatereview *model.ReviewInfo) (int64, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] UpdateReview, reviewID:%v\n", updatereview.ReviewID)
 	//1.数据校验
 	//查询是否有符合reviewid的review记录
-	review, err := uc.repo.GetReviewByReviewID(ctx, updatereview.ReviewID)
+	review, err := uc.repo.GetReviewByReviewID(WithPrimary(ctx), updatereview.ReviewID)
 	if err != nil {
 		return 0, pb.ErrorDbFailed("查询数据库失败")
 	}
@@ -123,7 +312,7 @@ func (uc *ReviewerUsecase) DeleteReview(ctx context.Context, deletereview *model
 	uc.log.WithContext(ctx).Debugf("[biz] DeleteReview, reviewID:%v\n", deletereview.ReviewID)
 	//1.数据校验
 	//1.1查询是否有符合reviewid的review记录
-	review, err := uc.repo.GetReviewByReviewID(ctx, deletereview.ReviewID)
+	review, err := uc.repo.GetReviewByReviewID(WithPrimary(ctx), deletereview.ReviewID)
 	if err != nil {
 		return 0, pb.ErrorDbFailed("查询数据库失败")
 	}
@@ -140,15 +329,34 @@ func (uc *ReviewerUsecase) DeleteReview(ctx context.Context, deletereview *model
 func (uc *ReviewerUsecase) CreateReply(ctx context.Context, param *ReplyParam) (*model.ReviewReplyInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] CreateReply, reviewID:%v\n", param)
 	//业务校验放data层了
+	replyID, err := genID()
+	if err != nil {
+		return nil, err
+	}
 	reply := &model.ReviewReplyInfo{
-		ReplyID:   snowflake.GenID(),
+		ReplyID:   replyID,
 		ReviewID:  param.ReviewID,
 		StoreID:   param.StoreID,
 		Content:   param.Content,
 		PicInfo:   param.PicInfo,
 		VideoInfo: param.VideoInfo,
 	}
-	return uc.repo.SaveReply(ctx, reply)
+	reply, err = uc.repo.SaveReply(ctx, reply)
+	if err != nil {
+		return nil, err
+	}
+	metricRepliesCreated.Inc()
+	//通知用户商家回复了
+	if review, err := uc.repo.GetReviewByReviewID(WithPrimary(ctx), reply.ReviewID); err == nil {
+		uc.notify(ctx, &WebhookEvent{
+			Event:    EventReplyPosted,
+			StoreID:  reply.StoreID,
+			ReviewID: reply.ReviewID,
+			UserID:   review.UserID,
+			ReplyID:  reply.ReplyID,
+		})
+	}
+	return reply, nil
 }
 
 func (uc *ReviewerUsecase) UpdateReply(ctx context.Context, param *ReplyUpdateParam) (int64, error) {
@@ -178,18 +386,47 @@ func (uc *ReviewerUsecase) AppealReview(ctx context.Context, param *AppealParam)
 	if review.StoreID != param.StoreID {
 		return 0, pb.ErrorInvalidParams("参数有误，StoreID不匹配")
 	}
-	//2.创建appealID
+	//2.驳回或撤回后可以发起下一轮申诉，每轮单独一条记录
+	latest, err := uc.repo.GetLatestAppeal(WithPrimary(ctx), param.ReviewID)
+	if err != nil {
+		return 0, pb.ErrorDbFailed("查询数据库失败")
+	}
+	round, err := nextAppealRound(latest)
+	if err != nil {
+		return 0, err
+	}
+	appealID, err := genID()
+	if err != nil {
+		return 0, err
+	}
+	messageID, err := genID()
+	if err != nil {
+		return 0, err
+	}
 	appeal := &model.ReviewAppealInfo{
+		AppealID:  appealID,
 		ReviewID:  param.ReviewID,
 		StoreID:   param.StoreID,
+		Round:     round,
+		Status:    AppealPending,
 		Reason:    param.Reason,
-		Content:   param.Reason,
+		Content:   param.Content,
 		PicInfo:   param.PicInfo,
 		VideoInfo: param.VideoInfo,
 	}
-	//2.创建appeal,校验appeal表中有无对于该review的申诉
+	msg := &model.ReviewAppealMessage{
+		MessageID:  messageID,
+		AppealID:   appeal.AppealID,
+		ReviewID:   appeal.ReviewID,
+		SenderType: AppealSenderStore,
+		Sender:     strconv.FormatInt(param.StoreID, 10),
+		Action:     AppealActionSubmit,
+		Content:    param.Content,
+		PicInfo:    param.PicInfo,
+		VideoInfo:  param.VideoInfo,
+	}
 	//3.返回(申诉的appealID)
-	return uc.repo.SaveAppeal(ctx, appeal)
+	return uc.repo.SaveAppeal(ctx, appeal, msg)
 }
 
 func (uc *ReviewerUsecase) AuditAppeal(ctx context.Context, param *AuditAppealParam) error {
@@ -198,11 +435,40 @@ func (uc *ReviewerUsecase) AuditAppeal(ctx context.Context, param *AuditAppealPa
 	audit := &model.ReviewAppealInfo{
 		AppealID:  param.AppealID,
 		OpUser:    param.OpUser,
-		Reason:    param.OpReason,
 		OpRemarks: param.OpRemarks,
 		Status:    param.Status,
 	}
-	return uc.repo.AuditAppeal(ctx, audit)
+	//审核意见记在沟通记录里，不覆盖商家填写的申诉原因
+	action := int32(AppealActionAudit)
+	if param.Status == AppealNeedMoreInfo {
+		action = AppealActionRequestInfo
+	}
+	messageID, err := genID()
+	if err != nil {
+		return err
+	}
+	msg := &model.ReviewAppealMessage{
+		MessageID:  messageID,
+		AppealID:   param.AppealID,
+		SenderType: AppealSenderOperator,
+		Sender:     param.OpUser,
+		Action:     action,
+		Content:    param.OpReason,
+	}
+	if err := uc.repo.AuditAppeal(ctx, audit, msg); err != nil {
+		return err
+	}
+	metricAppealAudits.WithLabelValues(appealOutcome(audit.Status)).Inc()
+	if audit.Status == AppealApproved || audit.Status == AppealRejected {
+		uc.notify(ctx, &WebhookEvent{
+			Event:    EventAppealResolved,
+			StoreID:  audit.StoreID,
+			ReviewID: audit.ReviewID,
+			AppealID: audit.AppealID,
+			Status:   audit.Status,
+		})
+	}
+	return nil
 }
 
 func (uc *ReviewerUsecase) AuditReview(ctx context.Context, param *AuditReviewParam) error {
@@ -215,10 +481,15 @@ func (uc *ReviewerUsecase) AuditReview(ctx context.Context, param *AuditReviewPa
 		OpRemarks: param.OpRemarks,
 		Status:    param.Status,
 	}
-	return uc.repo.AuditReview(ctx, audit)
+	if err := uc.repo.AuditReview(ctx, audit); err != nil {
+		return err
+	}
+	metricReviewAudits.WithLabelValues(reviewOutcome(audit.Status)).Inc()
+	uc.notifyReviewAudited(ctx, audit.ReviewID, audit.Status)
+	return nil
 }
 
-func (uc *ReviewerUsecase) ListReviewByStoreID(ctx context.Context, id int64, page int32, size int32) ([]*MyReviewInfo, error) {
+func (uc *ReviewerUsecase) ListReviewByStoreID(ctx context.Context, param *ListReviewParam, page int32, size int32) (*ReviewList, error) {
 	if page <= 0 {
 		page = 1
 	}
@@ -227,11 +498,11 @@ func (uc *ReviewerUsecase) ListReviewByStoreID(ctx context.Context, id int64, pa
 	}
 	offset := (page - 1) * size
 	limit := size
-	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreID userID:%v\n", id)
-	return uc.repo.ListReviewByStoreID(ctx, id, offset, limit)
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreID userID:%v\n", param.StoreID)
+	return uc.repo.ListReviewByStoreID(ctx, param, offset, limit)
 }
 
-func (uc *ReviewerUsecase) ListReviewByContent(ctx context.Context, page int32, size int32) ([]*MyReviewInfo, error) {
+func (uc *ReviewerUsecase) ListReviewByContent(ctx context.Context, param *ListReviewParam, page int32, size int32) (*ReviewList, error) {
 	if page <= 0 {
 		page = 1
 	}
@@ -241,7 +512,15 @@ func (uc *ReviewerUsecase) ListReviewByContent(ctx context.Context, page int32,
 	offset := (page - 1) * size
 	limit := size
 	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByContent userID:%v\n")
-	return uc.repo.ListReviewByContent(ctx, offset, limit)
+	return uc.repo.ListReviewByContent(ctx, param, offset, limit)
+}
+
+// ReviewList 列表查询的结果，ES不可用时从mysql查询并标记降级
+type ReviewList struct {
+	List     []*MyReviewInfo
+	Degraded bool
+	//降级时下一页的游标，没有更多时为空
+	NextCursor string
 }
 
 type MyReviewInfo struct {
@@ -264,6 +543,7 @@ type MyReviewInfo struct {
 	Status       int32  `json:"status,string"`
 	IsDefault    int32  `json:"is_default,string"`
 	HasReply     int32  `json:"has_reply,string"`
+	HelpfulCount int32  `json:"helpful_count"`
 }
 
 type MyTime time.Time
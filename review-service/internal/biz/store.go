@@ -0,0 +1,34 @@
+package biz
+
+import (
+	"context"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+)
+
+// ListStoreReviews B端查询本店的评价，按创建时间倒序
+func (uc *ReviewerUsecase) ListStoreReviews(ctx context.Context, param *StoreReviewParam, page int32, size int32) ([]*model.ReviewInfo, int64, error) {
+	offset, limit := pageLimit(page, size)
+	uc.log.WithContext(ctx).Debugf("[biz] ListStoreReviews param:%v page:%v size:%v\n", param, page, size)
+	return uc.repo.ListStoreReviews(ctx, param, offset, limit)
+}
+
+// ListStoreAppeals B端查询本店的申诉，status为0时不限状态
+func (uc *ReviewerUsecase) ListStoreAppeals(ctx context.Context, storeID int64, status int32, page int32, size int32) ([]*model.ReviewAppealInfo, int64, error) {
+	offset, limit := pageLimit(page, size)
+	uc.log.WithContext(ctx).Debugf("[biz] ListStoreAppeals storeID:%v status:%v\n", storeID, status)
+	return uc.repo.ListStoreAppeals(ctx, storeID, status, offset, limit)
+}
+
+// GetAppeal B端查询一条申诉，不是本店的申诉按不存在处理
+func (uc *ReviewerUsecase) GetAppeal(ctx context.Context, storeID int64, appealID int64) (*model.ReviewAppealInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetAppeal storeID:%v appealID:%v\n", storeID, appealID)
+	appeal, err := uc.repo.GetAppealByAppealID(ctx, appealID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.StoreID != storeID {
+		return nil, pb.ErrorNotFound("没有这个申诉")
+	}
+	return appeal, nil
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"strings"
+)
+
+// 回复模板支持的占位符
+const (
+	PlaceholderNickname = "{nickname}"
+	PlaceholderProduct  = "{product}"
+)
+
+// 匿名评价或查不到用户时的称呼，不能把匿名用户的昵称回复出去
+const defaultNickname = "亲"
+
+// CreateTemplate B端新建回复模板
+func (uc *ReviewerUsecase) CreateTemplate(ctx context.Context, param *TemplateParam) (int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateTemplate, param:%v\n", param)
+	templateID, err := genID()
+	if err != nil {
+		return 0, err
+	}
+	template := &model.ReviewReplyTemplate{
+		TemplateID: templateID,
+		StoreID:    param.StoreID,
+		Name:       param.Name,
+		Content:    param.Content,
+	}
+	if err := uc.repo.SaveTemplate(ctx, template); err != nil {
+		return 0, err
+	}
+	return template.TemplateID, nil
+}
+
+// UpdateTemplate B端修改回复模板，只能改本店的模板
+func (uc *ReviewerUsecase) UpdateTemplate(ctx context.Context, param *TemplateParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateTemplate, param:%v\n", param)
+	n, err := uc.repo.UpdateTemplate(ctx, &model.ReviewReplyTemplate{
+		TemplateID: param.TemplateID,
+		StoreID:    param.StoreID,
+		Name:       param.Name,
+		Content:    param.Content,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return pb.ErrorNotFound("没有这个模板")
+	}
+	return nil
+}
+
+// DeleteTemplate B端删除回复模板，还有自动回复规则在用时不能删
+func (uc *ReviewerUsecase) DeleteTemplate(ctx context.Context, storeID int64, templateID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteTemplate, storeID:%v templateID:%v\n", storeID, templateID)
+	cnt, err := uc.repo.CountRulesByTemplate(ctx, templateID)
+	if err != nil {
+		return pb.ErrorDbFailed("查询数据库失败")
+	}
+	if cnt > 0 {
+		return pb.ErrorInvalidParams("模板正在被%d条自动回复规则使用", cnt)
+	}
+	n, err := uc.repo.DeleteTemplate(ctx, storeID, templateID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return pb.ErrorNotFound("没有这个模板")
+	}
+	return nil
+}
+
+func (uc *ReviewerUsecase) ListTemplates(ctx context.Context, storeID int64) ([]*model.ReviewReplyTemplate, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListTemplates, storeID:%v\n", storeID)
+	return uc.repo.ListTemplates(ctx, storeID)
+}
+
+// RenderTemplate 替换模板中的占位符
+func RenderTemplate(content string, nickname string, product string) string {
+	return strings.NewReplacer(
+		PlaceholderNickname, nickname,
+		PlaceholderProduct, product,
+	).Replace(content)
+}
+
+// productName 商品快照是JSON，取其中的商品名称
+func productName(snapshot string) string {
+	var goods struct {
+		Name string `json:"name"`
+	}
+	if snapshot == "" || json.Unmarshal([]byte(snapshot), &goods) != nil {
+		return ""
+	}
+	return goods.Name
+}
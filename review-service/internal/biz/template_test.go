@@ -0,0 +1,19 @@
+package biz
+
+import (
+	"github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	convey.Convey("placeholders are replaced", t, func() {
+		content := RenderTemplate("感谢{nickname}购买{product}，{nickname}再来哦", "小明", "咖啡")
+		convey.So(content, convey.ShouldEqual, "感谢小明购买咖啡，小明再来哦")
+	})
+
+	convey.Convey("product name comes from goods snapshot", t, func() {
+		convey.So(productName(`{"name":"咖啡","price":10}`), convey.ShouldEqual, "咖啡")
+		convey.So(productName("not json"), convey.ShouldEqual, "")
+		convey.So(productName(""), convey.ShouldEqual, "")
+	})
+}
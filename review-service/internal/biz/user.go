@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"review-service/pkg/md5"
+)
+
+// 匿名评价的默认展示名前缀
+const anonymousPrefix = "匿名用户"
+
+// UserProfile c端用户的展示信息
+type UserProfile struct {
+	UserID   int64
+	Nickname string
+	Avatar   string
+	Level    int32 //会员等级
+}
+
+// UserClient 用户服务客户端，列表页按批查询用户信息，避免前端逐个调用
+type UserClient interface {
+	BatchGetUsers(ctx context.Context, ids []int64) (map[int64]*UserProfile, error)
+}
+
+// GetUserProfiles 批量查询评价列表中的用户信息
+func (uc *ReviewerUsecase) GetUserProfiles(ctx context.Context, reviews []*MyReviewInfo) map[int64]*UserProfile {
+	ids := make([]int64, 0, len(reviews))
+	for _, r := range reviews {
+		ids = append(ids, r.UserID)
+	}
+	return uc.GetUsers(ctx, ids)
+}
+
+// GetUsers 按userID批量查询用户信息，自动去重
+// 用户服务异常时只记录日志，评价列表照常返回
+func (uc *ReviewerUsecase) GetUsers(ctx context.Context, userIDs []int64) map[int64]*UserProfile {
+	ids := make([]int64, 0, len(userIDs))
+	seen := make(map[int64]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := seen[id]; ok || id <= 0 {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	users, err := uc.user.BatchGetUsers(ctx, ids)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] BatchGetUsers failed, ids:%v err:%v", ids, err)
+		return nil
+	}
+	return users
+}
+
+// Pseudonym 匿名评价对非运营方展示的化名，同一用户固定，加盐防止通过枚举userID反推
+func Pseudonym(salt string, userID int64) string {
+	return anonymousPrefix + md5.Sum([]byte(fmt.Sprintf("%s:%d", salt, userID)))[:8]
+}
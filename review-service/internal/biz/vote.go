@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"context"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"time"
+)
+
+// 商家评价列表排序方式
+const (
+	SortHelpful = "helpful"
+	SortNewest  = "newest"
+	SortScore   = "score"
+)
+
+// VoteReview c端给评价点有用，每个用户对一条评价只能投一票
+func (uc *ReviewerUsecase) VoteReview(ctx context.Context, param *VoteParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] VoteReview, param:%v\n", param)
+	review, err := uc.repo.GetReviewByReviewID(ctx, param.ReviewID)
+	if err != nil {
+		return pb.ErrorDbFailed("查询数据库失败")
+	}
+	//只有审核通过的评价才对外展示
+	if review.Status != 20 {
+		return pb.ErrorInvalidParams("评价未通过审核，不能投票")
+	}
+	return uc.repo.SaveVote(ctx, &model.ReviewVoteInfo{
+		ReviewID: param.ReviewID,
+		UserID:   param.UserID,
+	})
+}
+
+// UnvoteReview c端取消有用，没投过票时直接返回
+func (uc *ReviewerUsecase) UnvoteReview(ctx context.Context, param *VoteParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UnvoteReview, param:%v\n", param)
+	_, err := uc.repo.DeleteVote(ctx, &model.ReviewVoteInfo{
+		ReviewID: param.ReviewID,
+		UserID:   param.UserID,
+	})
+	return err
+}
+
+// FlushHelpfulVotes 把redis中的有用数增量刷回mysql
+func (uc *ReviewerUsecase) FlushHelpfulVotes(ctx context.Context, lease time.Duration) (int, error) {
+	return uc.repo.FlushHelpfulVotes(ctx, lease)
+}
@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"review-service/pkg/webhook"
+	"strings"
+	"time"
+)
+
+// 通知事件
+const (
+	EventReviewApproved = "review.approved" //评价审核通过
+	EventReviewRejected = "review.rejected" //评价审核不通过
+	EventReviewNegative = "review.negative" //差评审核通过
+	EventReplyPosted    = "reply.posted"    //商家回复了评价
+	EventAppealResolved = "appeal.resolved" //申诉审核完成
+)
+
+var webhookEvents = map[string]bool{
+	EventReviewApproved: true,
+	EventReviewRejected: true,
+	EventReviewNegative: true,
+	EventReplyPosted:    true,
+	EventAppealResolved: true,
+}
+
+// 订阅方：店铺只收到本店的事件，应用(C端app等)收到全部事件
+const (
+	WebhookOwnerStore = 1
+	WebhookOwnerApp   = 2
+)
+
+// 投递状态
+const (
+	DeliveryPending   = 10
+	DeliverySucceeded = 20
+	DeliveryFailed    = 30
+)
+
+// 评分不高于这个分数算差评
+const negativeScore = 2
+
+// WebhookBatch 每轮最多投递的记录数
+const WebhookBatch = 100
+
+// WebhookEvent 回调请求体
+type WebhookEvent struct {
+	Event      string `json:"event"`
+	OccurredAt int64  `json:"occurredAt"`
+	StoreID    int64  `json:"storeID"`
+	ReviewID   int64  `json:"reviewID"`
+	UserID     int64  `json:"userID,omitempty"`
+	Score      int32  `json:"score,omitempty"`
+	ReplyID    int64  `json:"replyID,omitempty"`
+	AppealID   int64  `json:"appealID,omitempty"`
+	Status     int32  `json:"status,omitempty"`
+}
+
+// WebhookSender 投递一次回调，返回响应码
+type WebhookSender interface {
+	Send(ctx context.Context, url string, secret string, event string, deliveryID int64, payload []byte) (int, error)
+}
+
+// RetryPolicy 投递失败的重试策略
+type RetryPolicy struct {
+	MaxAttempts int32
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// CreateWebhook 新建订阅，签名密钥只在创建时返回
+func (uc *ReviewerUsecase) CreateWebhook(ctx context.Context, param *WebhookParam) (*model.ReviewWebhookSubscription, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateWebhook, param:%v\n", param)
+	u, err := url.Parse(param.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, pb.ErrorInvalidParams("回调地址不合法")
+	}
+	if err := webhook.CheckHost(u.Hostname()); err != nil {
+		return nil, pb.ErrorInvalidParams("回调地址不能是内网或本机地址")
+	}
+	for _, e := range param.Events {
+		if !webhookEvents[e] {
+			return nil, pb.ErrorInvalidParams("不支持的事件:%s", e)
+		}
+	}
+	subscriptionID, err := genID()
+	if err != nil {
+		return nil, err
+	}
+	sub := &model.ReviewWebhookSubscription{
+		SubscriptionID: subscriptionID,
+		OwnerType:      param.OwnerType,
+		OwnerID:        param.OwnerID,
+		URL:            param.URL,
+		Secret:         webhook.NewSecret(),
+		Events:         strings.Join(param.Events, ","),
+	}
+	if err := uc.repo.SaveWebhook(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (uc *ReviewerUsecase) DeleteWebhook(ctx context.Context, ownerType int32, ownerID int64, subscriptionID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteWebhook, owner:%v/%v subscriptionID:%v\n", ownerType, ownerID, subscriptionID)
+	n, err := uc.repo.DeleteWebhook(ctx, ownerType, ownerID, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return pb.ErrorNotFound("没有这个订阅")
+	}
+	return nil
+}
+
+func (uc *ReviewerUsecase) ListWebhooks(ctx context.Context, ownerType int32, ownerID int64) ([]*model.ReviewWebhookSubscription, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListWebhooks, owner:%v/%v\n", ownerType, ownerID)
+	return uc.repo.ListWebhooks(ctx, ownerType, ownerID)
+}
+
+// ListWebhookDeliveries 查询订阅的投递记录，只能查自己的订阅
+func (uc *ReviewerUsecase) ListWebhookDeliveries(ctx context.Context, ownerType int32, ownerID int64, subscriptionID int64, status int32, page int32, size int32) ([]*model.ReviewWebhookDelivery, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListWebhookDeliveries, owner:%v/%v subscriptionID:%v\n", ownerType, ownerID, subscriptionID)
+	sub, err := uc.repo.GetWebhook(ctx, subscriptionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if sub.OwnerType != ownerType || sub.OwnerID != ownerID {
+		return nil, 0, pb.ErrorNotFound("没有这个订阅")
+	}
+	offset, limit := pageLimit(page, size)
+	return uc.repo.ListWebhookDeliveries(ctx, subscriptionID, status, offset, limit)
+}
+
+// notify 给订阅了事件的店铺和应用各生成一条待投递记录，由定时任务投递
+// 通知失败不影响已经完成的业务操作，只记日志
+func (uc *ReviewerUsecase) notify(ctx context.Context, ev *WebhookEvent) {
+	ev.OccurredAt = time.Now().Unix()
+	subs, err := uc.repo.ListWebhooksForStore(ctx, ev.StoreID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] notify list webhooks failed, event:%v err:%v", ev.Event, err)
+		return
+	}
+	deliveries := make([]*model.ReviewWebhookDelivery, 0, len(subs))
+	for _, sub := range subs {
+		if !subscribed(sub, ev.Event) {
+			continue
+		}
+		e := *ev
+		//商家不需要知道是哪个用户
+		if sub.OwnerType == WebhookOwnerStore {
+			e.UserID = 0
+		}
+		payload, _ := json.Marshal(&e)
+		deliveryID, err := genID()
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("[biz] notify gen delivery id failed, event:%v err:%v", ev.Event, err)
+			return
+		}
+		deliveries = append(deliveries, &model.ReviewWebhookDelivery{
+			DeliveryID:     deliveryID,
+			SubscriptionID: sub.SubscriptionID,
+			Event:          ev.Event,
+			Payload:        string(payload),
+			Status:         DeliveryPending,
+			NextRetryAt:    time.Now(),
+		})
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+	if err := uc.repo.SaveWebhookDeliveries(ctx, deliveries); err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] notify save deliveries failed, event:%v err:%v", ev.Event, err)
+	}
+}
+
+func subscribed(sub *model.ReviewWebhookSubscription, event string) bool {
+	for _, e := range strings.Split(sub.Events, ",") {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyReviewAudited 评价审核通过/不通过后通知，差评额外通知一次
+func (uc *ReviewerUsecase) notifyReviewAudited(ctx context.Context, reviewID int64, status int32) {
+	if status != 20 && status != 30 {
+		return
+	}
+	review, err := uc.repo.GetReviewByReviewID(ctx, reviewID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[biz] notify get review failed, reviewID:%v err:%v", reviewID, err)
+		return
+	}
+	ev := &WebhookEvent{
+		StoreID:  review.StoreID,
+		ReviewID: review.ReviewID,
+		UserID:   review.UserID,
+		Score:    review.Score,
+		Status:   status,
+	}
+	if status == 30 {
+		ev.Event = EventReviewRejected
+		uc.notify(ctx, ev)
+		return
+	}
+	ev.Event = EventReviewApproved
+	uc.notify(ctx, ev)
+	if review.Score <= negativeScore {
+		negative := *ev
+		negative.Event = EventReviewNegative
+		uc.notify(ctx, &negative)
+	}
+}
+
+// RunWebhookDelivery 投递一轮到期的回调，返回投递成功的条数
+// 失败按指数退避重试，超过最大次数记为投递失败
+func (uc *ReviewerUsecase) RunWebhookDelivery(ctx context.Context, sender WebhookSender, policy *RetryPolicy, lease time.Duration) (int, error) {
+	token, err := uc.repo.LockWebhookDelivery(ctx, lease)
+	if err != nil || token == "" {
+		return 0, err
+	}
+	defer uc.repo.UnlockWebhookDelivery(context.Background(), token)
+
+	deliveries, err := uc.repo.ListDueWebhookDeliveries(ctx, time.Now(), WebhookBatch)
+	if err != nil {
+		return 0, err
+	}
+	subs := make(map[int64]*model.ReviewWebhookSubscription)
+	sent := 0
+	for _, d := range deliveries {
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			sub, err = uc.repo.GetWebhook(ctx, d.SubscriptionID)
+			if err != nil && !pb.IsNotFound(err) {
+				return sent, err
+			}
+			subs[d.SubscriptionID] = sub
+		}
+		d.Attempts++
+		if sub == nil {
+			//订阅已删除，不再投递
+			d.Status = DeliveryFailed
+			d.LastError = "订阅已删除"
+		} else {
+			code, err := sender.Send(ctx, sub.URL, sub.Secret, d.Event, d.DeliveryID, []byte(d.Payload))
+			d.ResponseCode = int32(code)
+			if err == nil {
+				d.Status = DeliverySucceeded
+				d.LastError = ""
+				sent++
+			} else {
+				d.LastError = truncate(err.Error(), 255)
+				if d.Attempts >= policy.MaxAttempts {
+					d.Status = DeliveryFailed
+				} else {
+					d.NextRetryAt = time.Now().Add(webhook.Backoff(policy.BaseBackoff, policy.MaxBackoff, d.Attempts))
+				}
+			}
+		}
+		if err := uc.repo.UpdateWebhookDelivery(ctx, d); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/snowflake"
+	"testing"
+)
+
+func TestBatchAudit(t *testing.T) {
+	convey.Convey("each item of a batch audit succeeds or fails on its own", t, func() {
+		convey.So(snowflake.Init("2024-03-01", 1), convey.ShouldBeNil)
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		repo := NewReviewRepo(&Data{
+			query: query.Use(db),
+			log:   log.NewHelper(log.DefaultLogger),
+			rdb:   redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		}, log.DefaultLogger)
+		uc := biz.NewReviewerUsecase(repo, nil, nil, &conf.Report{}, &conf.Export{}, &conf.Anonymous{}, log.DefaultLogger)
+		ctx := context.Background()
+		for i := int64(1); i <= 2; i++ {
+			_, err := repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: i, OrderID: 100 + i, UserID: 10, StoreID: 20, Content: "评价内容", Score: 5, Status: 10})
+			convey.So(err, convey.ShouldBeNil)
+		}
+		//2被举报过，审核后举报一并结案
+		_, err = repo.SaveReport(ctx, &model.ReviewReportInfo{ReportID: 1, ReviewID: 2, StoreID: 20, UserID: 30, Reason: 1, Status: 10}, 5)
+		convey.So(err, convey.ShouldBeNil)
+
+		results := uc.BatchAuditReviews(ctx, []int64{2, 99, 1}, &biz.AuditReviewParam{OpUser: "op", OpReason: "通过", Status: 20})
+		convey.So(len(results), convey.ShouldEqual, 3)
+		convey.So(results[0].ID, convey.ShouldEqual, 2)
+		convey.So(results[0].Err, convey.ShouldBeNil)
+		convey.So(results[1].ID, convey.ShouldEqual, 99)
+		convey.So(pb.IsDbFailed(results[1].Err), convey.ShouldBeTrue)
+		convey.So(results[2].ID, convey.ShouldEqual, 1)
+		convey.So(results[2].Err, convey.ShouldBeNil)
+		for _, id := range []int64{1, 2} {
+			review, err := repo.GetReviewByReviewID(ctx, id)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(review.Status, convey.ShouldEqual, 20)
+			convey.So(review.OpUser, convey.ShouldEqual, "op")
+			convey.So(review.ReportCount, convey.ShouldEqual, 0)
+		}
+		report, err := query.Use(db).ReviewReportInfo.WithContext(ctx).Where(query.Use(db).ReviewReportInfo.ReportID.Eq(1)).First()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(report.Status, convey.ShouldEqual, 20)
+
+		//已处理的申诉不能重复审核，不影响同批其他申诉
+		for i := int64(1); i <= 2; i++ {
+			convey.So(query.Use(db).ReviewAppealInfo.WithContext(ctx).Create(&model.ReviewAppealInfo{AppealID: 100 + i, ReviewID: i, StoreID: 20, Reason: "原因", Content: "申诉内容"}), convey.ShouldBeNil)
+		}
+		results = uc.BatchAuditAppeals(ctx, []int64{101}, &biz.AuditAppealParam{OpUser: "op", OpReason: "驳回", Status: biz.AppealRejected})
+		convey.So(results[0].Err, convey.ShouldBeNil)
+		results = uc.BatchAuditAppeals(ctx, []int64{101, 102}, &biz.AuditAppealParam{OpUser: "op", OpReason: "通过", Status: biz.AppealApproved})
+		convey.So(pb.IsAppealAudited(results[0].Err), convey.ShouldBeTrue)
+		convey.So(results[1].Err, convey.ShouldBeNil)
+		appeal, err := repo.GetLatestAppeal(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeal.Status, convey.ShouldEqual, biz.AppealRejected)
+	})
+
+	convey.Convey("a batch holds at most 100 distinct ids", t, func() {
+		ids := make([]int64, 0, 101)
+		for i := int64(1); i <= 101; i++ {
+			ids = append(ids, i)
+		}
+		reviews := &pb.BatchAuditReviewsRequest{ReviewIDs: ids[:100], Status: 20, OpUser: "op", OpReason: "通过"}
+		convey.So(reviews.Validate(), convey.ShouldBeNil)
+		reviews.ReviewIDs = ids
+		convey.So(reviews.Validate(), convey.ShouldNotBeNil)
+		reviews.ReviewIDs = []int64{1, 1}
+		convey.So(reviews.Validate(), convey.ShouldNotBeNil)
+		appeals := &pb.BatchAuditAppealsRequest{AppealIDs: ids[:100], Status: 20, OpUser: "op", OpReason: "通过"}
+		convey.So(appeals.Validate(), convey.ShouldBeNil)
+		appeals.AppealIDs = ids
+		convey.So(appeals.Validate(), convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/snowflake"
+	"testing"
+	"time"
+)
+
+type stubUsers map[int64]*biz.UserProfile
+
+func (s stubUsers) BatchGetUsers(_ context.Context, ids []int64) (map[int64]*biz.UserProfile, error) {
+	ret := make(map[int64]*biz.UserProfile, len(ids))
+	for _, id := range ids {
+		if u, ok := s[id]; ok {
+			ret[id] = u
+		}
+	}
+	return ret, nil
+}
+
+func TestRunAutoReply(t *testing.T) {
+	convey.Convey("auto replies go through CreateReply and skip reviews outside the rule", t, func() {
+		convey.So(snowflake.Init("2024-03-01", 1), convey.ShouldBeNil)
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		repo := NewReviewRepo(&Data{
+			query: query.Use(db),
+			log:   log.NewHelper(log.DefaultLogger),
+			rdb:   redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		}, log.DefaultLogger)
+		users := stubUsers{10: {UserID: 10, Nickname: "小明"}}
+		uc := biz.NewReviewerUsecase(repo, users, nil, &conf.Report{}, &conf.Export{}, &conf.Anonymous{}, log.DefaultLogger)
+		ctx := context.Background()
+
+		for _, template := range []*model.ReviewReplyTemplate{
+			{TemplateID: 1, StoreID: 31, Name: "好评", Content: "感谢{nickname}购买{product}"},
+			{TemplateID: 2, StoreID: 32, Name: "好评", Content: "感谢支持"},
+		} {
+			convey.So(repo.SaveTemplate(ctx, template), convey.ShouldBeNil)
+		}
+		convey.So(repo.SaveAutoReplyRule(ctx, &model.ReviewAutoReplyRule{RuleID: 1, StoreID: 31, TemplateID: 1, MinScore: 5, MaxScore: 5, OnlyEmpty: 1, DelaySeconds: 3600, Enabled: 1}), convey.ShouldBeNil)
+		convey.So(repo.SaveAutoReplyRule(ctx, &model.ReviewAutoReplyRule{RuleID: 2, StoreID: 32, TemplateID: 2, MinScore: 1, MaxScore: 5, Enabled: 0}), convey.ShouldBeNil)
+
+		old := time.Now().Add(-2 * time.Hour)
+		snapshot := `{"name":"保温杯"}`
+		reviews := []*model.ReviewInfo{
+			{ReviewID: 1, StoreID: 31, UserID: 10, Score: 5, Status: 20, GoodsSnapshoot: snapshot, CreateAt: old},
+			{ReviewID: 2, StoreID: 31, UserID: 10, Score: 5, Status: 20, Anonymous: 1, CreateAt: old},
+			//有文字内容、评分不符、还没到延迟时间、没审核通过、商家已经回复过
+			{ReviewID: 3, StoreID: 31, UserID: 10, Score: 5, Status: 20, Content: "很好", CreateAt: old},
+			{ReviewID: 4, StoreID: 31, UserID: 10, Score: 4, Status: 20, CreateAt: old},
+			{ReviewID: 5, StoreID: 31, UserID: 10, Score: 5, Status: 20, CreateAt: time.Now().Add(-10 * time.Minute)},
+			{ReviewID: 6, StoreID: 31, UserID: 10, Score: 5, Status: 10, CreateAt: old},
+			{ReviewID: 7, StoreID: 31, UserID: 10, Score: 5, Status: 20, CreateAt: old},
+			//规则没启用
+			{ReviewID: 8, StoreID: 32, UserID: 10, Score: 5, Status: 20, CreateAt: old},
+		}
+		for _, r := range reviews {
+			r.OrderID = 100 + r.ReviewID
+			_, err := repo.SaveReview(ctx, r)
+			convey.So(err, convey.ShouldBeNil)
+		}
+		_, err = uc.CreateReply(ctx, &biz.ReplyParam{ReviewID: 7, StoreID: 31, Content: "手动回复"})
+		convey.So(err, convey.ShouldBeNil)
+
+		replied, err := uc.RunAutoReply(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(replied, convey.ShouldEqual, 2)
+		q := query.Use(db).ReviewReplyInfo
+		replies, err := q.WithContext(ctx).Order(q.ReviewID).Find()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(replies), convey.ShouldEqual, 3)
+		convey.So(replies[0].ReviewID, convey.ShouldEqual, 1)
+		convey.So(replies[0].StoreID, convey.ShouldEqual, 31)
+		convey.So(replies[0].Content, convey.ShouldEqual, "感谢小明购买保温杯")
+		//匿名评价不查昵称
+		convey.So(replies[1].ReviewID, convey.ShouldEqual, 2)
+		convey.So(replies[1].Content, convey.ShouldEqual, "感谢亲购买")
+		convey.So(replies[2].Content, convey.ShouldEqual, "手动回复")
+		for _, id := range []int64{1, 2} {
+			review, err := repo.GetReviewByReviewID(ctx, id)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(review.HasReply, convey.ShouldEqual, 1)
+		}
+		convey.So(mr.Exists(autoReplyLockKey), convey.ShouldBeFalse)
+
+		//已回复的不再回复
+		replied, err = uc.RunAutoReply(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(replied, convey.ShouldEqual, 0)
+
+		//别的实例在跑时跳过
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 9, OrderID: 109, StoreID: 31, UserID: 10, Score: 5, Status: 20, CreateAt: old})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mr.Set(autoReplyLockKey, "other"), convey.ShouldBeNil)
+		replied, err = uc.RunAutoReply(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(replied, convey.ShouldEqual, 0)
+		v, _ := mr.Get(autoReplyLockKey)
+		convey.So(v, convey.ShouldEqual, "other")
+		mr.Del(autoReplyLockKey)
+		replied, err = uc.RunAutoReply(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(replied, convey.ShouldEqual, 1)
+	})
+}
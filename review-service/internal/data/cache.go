@@ -0,0 +1,225 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"strconv"
+	"time"
+)
+
+// 批量查询用的评价缓存，写评价的地方删缓存，查询未命中时回主库一次IN查询后回填
+// 查不到的评价和没有评价的订单缓存一个空值，防止批量查询穿透到mysql
+const (
+	reviewCacheKey            = "review:info:%d"
+	orderCacheKey             = "review:order:%d"
+	defaultReviewCacheTTL     = 10 * time.Minute
+	defaultReviewCacheMissTTL = time.Minute
+)
+
+// BatchGetReviews 按评价ID批量查询，返回评价ID到评价的映射，查不到的ID不在结果里
+func (r *reviewRepo) BatchGetReviews(ctx context.Context, reviewIDs []int64) (map[int64]*model.ReviewInfo, error) {
+	ret := make(map[int64]*model.ReviewInfo, len(reviewIDs))
+	missed := reviewIDs
+	if r.data.rdb != nil {
+		missed = missed[:0:0]
+		for i, v := range r.mget(ctx, reviewCacheKey, reviewIDs) {
+			s, ok := v.(string)
+			if !ok {
+				missed = append(missed, reviewIDs[i])
+				continue
+			}
+			if s == "" {
+				continue
+			}
+			review := new(model.ReviewInfo)
+			if err := json.Unmarshal([]byte(s), review); err != nil {
+				missed = append(missed, reviewIDs[i])
+				continue
+			}
+			ret[reviewIDs[i]] = review
+		}
+	}
+	if len(missed) == 0 {
+		return ret, nil
+	}
+	//回填缓存的数据走主库，避免删缓存后从库的旧数据又被写回缓存
+	reviews, err := r.findReviews(biz.WithPrimary(ctx), missed)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[int64]string, len(missed))
+	for _, id := range missed {
+		values[id] = ""
+	}
+	for _, review := range reviews {
+		ret[review.ReviewID] = review
+		b, err := json.Marshal(review)
+		if err != nil {
+			return nil, err
+		}
+		values[review.ReviewID] = string(b)
+	}
+	r.mset(ctx, reviewCacheKey, values)
+	return ret, nil
+}
+
+// GetReviewsByOrderIDs 按订单ID批量查询，返回订单ID到评价的映射，没有评价的订单不在结果里
+// 缓存里存订单到评价ID的映射，评价本身复用BatchGetReviews的缓存
+func (r *reviewRepo) GetReviewsByOrderIDs(ctx context.Context, orderIDs []int64) (map[int64]*model.ReviewInfo, error) {
+	reviewIDs := make(map[int64]int64, len(orderIDs))
+	missed := orderIDs
+	if r.data.rdb != nil {
+		missed = missed[:0:0]
+		for i, v := range r.mget(ctx, orderCacheKey, orderIDs) {
+			s, ok := v.(string)
+			if !ok {
+				missed = append(missed, orderIDs[i])
+				continue
+			}
+			reviewID, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				missed = append(missed, orderIDs[i])
+				continue
+			}
+			if reviewID > 0 {
+				reviewIDs[orderIDs[i]] = reviewID
+			}
+		}
+	}
+	if len(missed) > 0 {
+		found, err := r.findOrderReviewIDs(biz.WithPrimary(ctx), missed)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[int64]string, len(missed))
+		for _, id := range missed {
+			values[id] = "0"
+			if reviewID, ok := found[id]; ok {
+				reviewIDs[id] = reviewID
+				values[id] = strconv.FormatInt(reviewID, 10)
+			}
+		}
+		r.mset(ctx, orderCacheKey, values)
+	}
+	ret := make(map[int64]*model.ReviewInfo, len(reviewIDs))
+	if len(reviewIDs) == 0 {
+		return ret, nil
+	}
+	ids := make([]int64, 0, len(reviewIDs))
+	for _, id := range reviewIDs {
+		ids = append(ids, id)
+	}
+	reviews, err := r.BatchGetReviews(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	//评价删除后订单映射可能还没过期，查不到评价的订单按没有评价处理
+	for orderID, reviewID := range reviewIDs {
+		if review, ok := reviews[reviewID]; ok {
+			ret[orderID] = review
+		}
+	}
+	return ret, nil
+}
+
+// findReviews 按评价ID一次IN查询，分表后先查索引表拿店铺ID
+func (r *reviewRepo) findReviews(ctx context.Context, reviewIDs []int64) ([]*model.ReviewInfo, error) {
+	if r.data.reviewShards <= 1 {
+		q := r.data.query.ReviewInfo
+		return q.WithContext(ctx).Debug().Where(q.ReviewID.In(reviewIDs...)).Find()
+	}
+	q := r.data.query.ReviewInfoIndex
+	idx, err := q.WithContext(ctx).Debug().Where(q.ReviewID.In(reviewIDs...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	return r.findIndexed(ctx, idx)
+}
+
+// findOrderReviewIDs 查订单对应的评价ID，一个订单有多条评价时取最早的一条
+func (r *reviewRepo) findOrderReviewIDs(ctx context.Context, orderIDs []int64) (map[int64]int64, error) {
+	ret := make(map[int64]int64, len(orderIDs))
+	if r.data.reviewShards <= 1 {
+		q := r.data.query.ReviewInfo
+		list, err := q.WithContext(ctx).Debug().
+			Select(q.ReviewID, q.OrderID).
+			Where(q.OrderID.In(orderIDs...)).
+			Order(q.ReviewID).
+			Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range list {
+			if _, ok := ret[review.OrderID]; !ok {
+				ret[review.OrderID] = review.ReviewID
+			}
+		}
+		return ret, nil
+	}
+	q := r.data.query.ReviewInfoIndex
+	idx, err := q.WithContext(ctx).Debug().Where(q.OrderID.In(orderIDs...)).Order(q.ReviewID).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range idx {
+		if _, ok := ret[i.OrderID]; !ok {
+			ret[i.OrderID] = i.ReviewID
+		}
+	}
+	return ret, nil
+}
+
+// mget redis出错时当作全部未命中，查询退回mysql
+func (r *reviewRepo) mget(ctx context.Context, format string, ids []int64) []interface{} {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = fmt.Sprintf(format, id)
+	}
+	vals, err := r.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("get review cache failed, err:%v", err)
+		return make([]interface{}, len(ids))
+	}
+	return vals
+}
+
+// mset 回填缓存，空值用较短的有效期
+func (r *reviewRepo) mset(ctx context.Context, format string, values map[int64]string) {
+	if r.data.rdb == nil {
+		return
+	}
+	_, err := r.data.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for id, v := range values {
+			ttl := r.data.reviewCacheTTL
+			if v == "" || v == "0" {
+				ttl = r.data.reviewCacheMissTTL
+			}
+			p.Set(ctx, fmt.Sprintf(format, id), v, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("set review cache failed, err:%v", err)
+	}
+}
+
+// evictReviewCache 写评价成功后删缓存，orderID为0时只删评价
+// 删除失败只记日志，缓存到期后自然更新
+func (r *reviewRepo) evictReviewCache(ctx context.Context, reviewID int64, orderID int64) {
+	if r.data.rdb == nil {
+		return
+	}
+	keys := []string{fmt.Sprintf(reviewCacheKey, reviewID)}
+	if orderID > 0 {
+		keys = append(keys, fmt.Sprintf(orderCacheKey, orderID))
+	}
+	//请求取消后也要删掉，否则缓存里一直是旧数据
+	ctx = context.WithoutCancel(ctx)
+	if err := r.data.rdb.Del(ctx, keys...).Err(); err != nil {
+		r.log.WithContext(ctx).Errorf("evict review cache failed, reviewID:%v err:%v", reviewID, err)
+	}
+}
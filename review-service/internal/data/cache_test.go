@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestBatchGetReviews(t *testing.T) {
+	convey.Convey("batch lookups fill the cache and writes evict it", t, func() {
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		repo := NewReviewRepo(&Data{
+			query:              query.Use(db),
+			log:                log.NewHelper(log.DefaultLogger),
+			rdb:                redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+			reviewCacheTTL:     time.Minute,
+			reviewCacheMissTTL: time.Second,
+		}, log.DefaultLogger)
+		ctx := context.Background()
+		for i := int64(1); i <= 2; i++ {
+			_, err := repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: i, OrderID: 100 + i, UserID: 10, StoreID: 20, Content: "评价内容", Score: 5})
+			convey.So(err, convey.ShouldBeNil)
+		}
+
+		reviews, err := repo.BatchGetReviews(ctx, []int64{1, 2, 3})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(reviews), convey.ShouldEqual, 2)
+		convey.So(reviews[2].OrderID, convey.ShouldEqual, 102)
+		convey.So(mr.Exists("review:info:1"), convey.ShouldBeTrue)
+		//查不到的ID缓存空值
+		miss, _ := mr.Get("review:info:3")
+		convey.So(miss, convey.ShouldEqual, "")
+		convey.So(mr.TTL("review:info:3"), convey.ShouldEqual, time.Second)
+
+		byOrder, err := repo.GetReviewsByOrderIDs(ctx, []int64{101, 103})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(byOrder), convey.ShouldEqual, 1)
+		convey.So(byOrder[101].ReviewID, convey.ShouldEqual, 1)
+		none, _ := mr.Get("review:order:103")
+		convey.So(none, convey.ShouldEqual, "0")
+
+		//命中缓存时不再查库
+		convey.So(mr.Set("review:info:1", `{"review_id":1,"content":"缓存里的"}`), convey.ShouldBeNil)
+		reviews, err = repo.BatchGetReviews(ctx, []int64{1})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(reviews[1].Content, convey.ShouldEqual, "缓存里的")
+
+		//更新后删缓存，新评价覆盖订单的空值
+		_, err = repo.UpdateReview(ctx, &model.ReviewInfo{ReviewID: 1, Content: "追加修改"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mr.Exists("review:info:1"), convey.ShouldBeFalse)
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 3, OrderID: 103, UserID: 10, StoreID: 20, Content: "评价内容", Score: 4})
+		convey.So(err, convey.ShouldBeNil)
+		byOrder, err = repo.GetReviewsByOrderIDs(ctx, []int64{101, 103})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(byOrder[101].Content, convey.ShouldEqual, "追加修改")
+		convey.So(byOrder[103].ReviewID, convey.ShouldEqual, 3)
+
+		//redis不可用时直接查库
+		mr.Close()
+		reviews, err = repo.BatchGetReviews(ctx, []int64{1, 2, 3})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(reviews), convey.ShouldEqual, 3)
+	})
+}
@@ -1,53 +1,149 @@
 package data
 
 import (
+	"context"
 	"errors"
 	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"review-service/internal/conf"
+	"review-service/internal/data/migrations"
 	"review-service/internal/data/query"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewEsclient)
+var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewEsclient, NewRedisClient, NewUserClient, NewHealthProbes, NewExportStorage)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
 	//db *gorm.DB
 	query *query.Query
-	log   *log.Helper
-	es    *elasticsearch.TypedClient
+	//review_info分表数
+	reviewShards int32
+	log          *log.Helper
+	es           *elasticsearch.TypedClient
+	//ES查询失败率过高时不再请求ES
+	esBreaker circuitbreaker.CircuitBreaker
+	//ES不可用时改查mysql的接口
+	esFallback map[string]bool
+	rdb        *redis.Client
+	//批量查询的评价缓存有效期
+	reviewCacheTTL     time.Duration
+	reviewCacheMissTTL time.Duration
 }
 
 // NewData .
-func NewData(db *gorm.DB, esClient *elasticsearch.TypedClient, logger log.Logger) (*Data, func(), error) {
+func NewData(c *conf.Data, ec *conf.ElasticSearch, db *gorm.DB, esClient *elasticsearch.TypedClient, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Errorf("close redis failed, err:%v", err)
+		}
 	}
 	//GEN生成query代码设置数据库对象
 	query.SetDefault(db)
-	return &Data{query: query.Q, log: log.NewHelper(logger), es: esClient}, cleanup, nil
+	esFallback := make(map[string]bool, len(ec.GetMysqlFallback()))
+	for _, rpc := range ec.GetMysqlFallback() {
+		esFallback[rpc] = true
+	}
+	cacheTTL := c.GetRedis().GetReviewCacheTtl().AsDuration()
+	if cacheTTL <= 0 {
+		cacheTTL = defaultReviewCacheTTL
+	}
+	missTTL := c.GetRedis().GetReviewCacheMissTtl().AsDuration()
+	if missTTL <= 0 {
+		missTTL = defaultReviewCacheMissTTL
+	}
+	return &Data{
+		query:        query.Q,
+		reviewShards: c.GetSharding().GetReviewShards(),
+		log:          log.NewHelper(logger),
+		es:           esClient,
+		esBreaker:    sre.NewBreaker(),
+		esFallback:   esFallback,
+		rdb:          rdb,
+
+		reviewCacheTTL:     cacheTTL,
+		reviewCacheMissTTL: missTTL,
+	}, cleanup, nil
 }
 
 func NewEsclient(config *conf.ElasticSearch) (*elasticsearch.TypedClient, error) {
 	// ES 配置
-	cfg := elasticsearch.Config{Addresses: config.Addresses}
+	cfg := elasticsearch.Config{
+		Addresses: config.Addresses,
+		//每次请求一个span，不记录查询体
+		Instrumentation: elasticsearch.NewOpenTelemetryInstrumentation(otel.GetTracerProvider(), false),
+	}
 
 	return elasticsearch.NewTypedClient(cfg)
 }
 func NewDB(cfg *conf.Data) (*gorm.DB, error) {
-	switch strings.ToLower(cfg.Database.GetDriver()) {
+	dialector, err := openDialector(cfg.Database.GetDriver(), cfg.Database.GetSource())
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector)
+	if err != nil {
+		return nil, err
+	}
+	if err := useTracing(db); err != nil {
+		return nil, err
+	}
+	if err := useMetrics(db); err != nil {
+		return nil, err
+	}
+	if cfg.Database.GetAutoMigrate() {
+		m, err := migrations.New(db, cfg.Database.GetDriver())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := m.Up(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+	//配置了分表时启动前建好分表，分表不在迁移文件里，分表数由配置决定
+	if err := EnsureReviewShards(db, cfg.Database.GetDriver(), cfg.GetSharding().GetReviewShards()); err != nil {
+		return nil, err
+	}
+	if err := useReplicas(db, cfg.Database); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func openDialector(driver, source string) (gorm.Dialector, error) {
+	switch strings.ToLower(driver) {
 	case "mysql":
-		return gorm.Open(mysql.Open(cfg.Database.GetSource()))
+		return mysql.Open(source), nil
 	case "sqlite":
-		return gorm.Open(sqlite.Open(cfg.Database.GetSource()))
+		return sqlite.Open(source), nil
 	}
 	return nil, errors.New("connect db fail: unsupported db driver")
 }
+
+func NewRedisClient(cfg *conf.Data) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Network:      cfg.Redis.GetNetwork(),
+		Addr:         cfg.Redis.GetAddr(),
+		ReadTimeout:  cfg.Redis.GetReadTimeout().AsDuration(),
+		WriteTimeout: cfg.Redis.GetWriteTimeout().AsDuration(),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+	return rdb, nil
+}
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"strconv"
+	"time"
+)
+
+// PIT在两批之间保持的时间
+const exportKeepAlive = "2m"
+
+func (r *reviewRepo) SaveExport(ctx context.Context, task *model.ReviewExportTask) error {
+	return r.data.query.ReviewExportTask.WithContext(ctx).Debug().Create(task)
+}
+
+func (r *reviewRepo) GetExport(ctx context.Context, exportID int64) (*model.ReviewExportTask, error) {
+	q := r.data.query.ReviewExportTask
+	task, err := q.WithContext(ctx).Debug().Where(q.ExportID.Eq(exportID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pb.ErrorNotFound("没有这个导出任务")
+	}
+	return task, err
+}
+
+func (r *reviewRepo) ListExports(ctx context.Context, storeID int64, offset int32, limit int32) ([]*model.ReviewExportTask, int64, error) {
+	q := r.data.query.ReviewExportTask
+	return q.WithContext(ctx).Debug().Where(q.StoreID.Eq(storeID)).
+		Order(q.ID.Desc()).
+		FindByPage(int(offset), int(limit))
+}
+
+func (r *reviewRepo) CountActiveExports(ctx context.Context, storeID int64) (int64, error) {
+	q := r.data.query.ReviewExportTask
+	return q.WithContext(ctx).Debug().
+		Where(q.StoreID.Eq(storeID), q.Status.In(biz.ExportPending, biz.ExportRunning)).
+		Count()
+}
+
+// 领取任务时和其他实例冲突的最大重试次数
+const claimRetries = 3
+
+// ClaimExport 领取最早的待执行任务，执行中但超过staleBefore没有进度的任务也可以领取
+// 更新时再次带上领取条件，并发领取同一个任务时只有一个实例成功
+func (r *reviewRepo) ClaimExport(ctx context.Context, staleBefore time.Time) (*model.ReviewExportTask, error) {
+	q := r.data.query.ReviewExportTask
+	for i := 0; i < claimRetries; i++ {
+		task, err := q.WithContext(ctx).Debug().
+			Where(q.Status.Eq(biz.ExportPending)).
+			Or(q.Status.Eq(biz.ExportRunning), q.UpdateAt.Lt(staleBefore)).
+			Order(q.ID).
+			First()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		conds := []gen.Condition{q.ExportID.Eq(task.ExportID), q.Status.Eq(task.Status)}
+		if task.Status == biz.ExportRunning {
+			conds = append(conds, q.UpdateAt.Lt(staleBefore))
+		}
+		now := time.Now()
+		info, err := q.WithContext(ctx).Debug().Where(conds...).
+			UpdateSimple(q.Status.Value(biz.ExportRunning), q.UpdateAt.Value(now))
+		if err != nil {
+			return nil, err
+		}
+		if info.RowsAffected == 1 {
+			task.Status, task.UpdateAt = biz.ExportRunning, now
+			return task, nil
+		}
+		//被其他实例领走了，换下一个
+	}
+	return nil, nil
+}
+
+// UpdateExport 更新进度和结果，零值也要写入
+func (r *reviewRepo) UpdateExport(ctx context.Context, task *model.ReviewExportTask) error {
+	q := r.data.query.ReviewExportTask
+	task.UpdateAt = time.Now()
+	_, err := q.WithContext(ctx).Debug().Where(q.ExportID.Eq(task.ExportID)).
+		Select(q.Status, q.RowCount, q.FileKey, q.FileSize, q.LastError, q.FinishAt, q.ExpireAt, q.UpdateAt).
+		Updates(task)
+	return err
+}
+
+func (r *reviewRepo) ListExpiredExports(ctx context.Context, now time.Time, limit int) ([]*model.ReviewExportTask, error) {
+	q := r.data.query.ReviewExportTask
+	return q.WithContext(ctx).Debug().
+		Where(q.Status.Eq(biz.ExportDone), q.ExpireAt.Lt(now)).
+		Order(q.ID).
+		Limit(limit).
+		Find()
+}
+
+// ScanStoreReviews 用PIT和search_after按创建时间倒序读出店铺的全部评价，每批回调一次
+// 同一个PIT看到的是打开时的快照，导出过程中新写入的评价不会重复或错位
+func (r *reviewRepo) ScanStoreReviews(ctx context.Context, param *biz.StoreReviewParam, batch int, fn func([]*biz.MyReviewInfo) error) error {
+	start := time.Now()
+	pit, err := r.data.es.OpenPointInTime("review").KeepAlive(exportKeepAlive).Do(ctx)
+	observeES("open_pit", start, err)
+	if err != nil {
+		return err
+	}
+	pitID := pit.Id
+	defer func() {
+		if _, err := r.data.es.ClosePointInTime().Id(pitID).Do(context.Background()); err != nil {
+			r.log.Errorf("close pit failed, err:%v", err)
+		}
+	}()
+	query := storeReviewQuery(param)
+	//_shard_doc是PIT下唯一的排序字段，创建时间相同的评价也不会漏
+	sorts := []types.SortCombinations{
+		types.SortOptions{SortOptions: map[string]types.FieldSort{"create_at.keyword": {Order: &sortorder.Desc}}},
+		types.SortOptions{SortOptions: map[string]types.FieldSort{"_shard_doc": {Order: &sortorder.Asc}}},
+	}
+	var after []types.FieldValue
+	for {
+		req := r.data.es.Search().Size(batch).Query(query).Sort(sorts...).TrackTotalHits(false).
+			Pit(&types.PointInTimeReference{Id: pitID, KeepAlive: exportKeepAlive})
+		if after != nil {
+			req = req.SearchAfter(after...)
+		}
+		start := time.Now()
+		resp, err := req.Do(ctx)
+		observeES("export_scan", start, err)
+		if err != nil {
+			return err
+		}
+		if resp.PitId != nil {
+			pitID = *resp.PitId
+		}
+		hits := resp.Hits.Hits
+		if len(hits) == 0 {
+			return nil
+		}
+		list := make([]*biz.MyReviewInfo, 0, len(hits))
+		for _, hit := range hits {
+			tmp := &biz.MyReviewInfo{}
+			if err := json.Unmarshal(hit.Source_, tmp); err != nil {
+				r.log.Errorf("json.unmarshal(hit.source_) failed, err:%v\n", err)
+				continue
+			}
+			list = append(list, tmp)
+		}
+		if err := fn(list); err != nil {
+			return err
+		}
+		if len(hits) < batch {
+			return nil
+		}
+		after = hits[len(hits)-1].Sort
+	}
+}
+
+// storeReviewQuery 和ListStoreReviews相同的筛选条件，ES里的字段都是字符串，精确匹配用keyword
+func storeReviewQuery(param *biz.StoreReviewParam) *types.Query {
+	term := func(field string, value interface{}) types.Query {
+		return types.Query{Term: map[string]types.TermQuery{field: {Value: value}}}
+	}
+	filter := []types.Query{term("store_id", param.StoreID)}
+	if param.Unreplied {
+		filter = append(filter, term("has_reply.keyword", "0"))
+	}
+	if param.MaxScore > 0 {
+		scores := make([]string, 0, param.MaxScore)
+		for i := int32(1); i <= param.MaxScore; i++ {
+			scores = append(scores, strconv.Itoa(int(i)))
+		}
+		filter = append(filter, types.Query{Terms: &types.TermsQuery{TermsQuery: map[string]types.TermsQueryField{"score.keyword": scores}}})
+	}
+	switch param.HasMedia {
+	case 1:
+		filter = append(filter, term("has_media.keyword", "1"))
+	case 2:
+		filter = append(filter, term("has_media.keyword", "0"))
+	}
+	//create_at是固定格式的字符串，按字典序比较就是按时间比较
+	if !param.StartTime.IsZero() || !param.EndTime.IsZero() {
+		created := types.DateRangeQuery{}
+		if !param.StartTime.IsZero() {
+			gte := param.StartTime.Format(time.DateTime)
+			created.Gte = &gte
+		}
+		if !param.EndTime.IsZero() {
+			lt := param.EndTime.Format(time.DateTime)
+			created.Lt = &lt
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"create_at.keyword": created}})
+	}
+	return &types.Query{Bool: &types.BoolQuery{
+		Filter:  filter,
+		MustNot: []types.Query{{Exists: &types.ExistsQuery{Field: "delete_at"}}},
+	}}
+}
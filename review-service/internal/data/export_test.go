@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestExportTasks(t *testing.T) {
+	convey.Convey("export tasks are claimed once and stale ones are taken over", t, func() {
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		query.SetDefault(db)
+		repo := NewReviewRepo(&Data{query: query.Q, log: log.NewHelper(log.DefaultLogger)}, log.DefaultLogger)
+		ctx := context.Background()
+
+		for i := int64(1); i <= 2; i++ {
+			err = repo.SaveExport(ctx, &model.ReviewExportTask{ExportID: i, StoreID: 31, Format: "csv", Filter: "{}", Status: biz.ExportPending})
+			convey.So(err, convey.ShouldBeNil)
+		}
+		active, err := repo.CountActiveExports(ctx, 31)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(active, convey.ShouldEqual, 2)
+
+		staleBefore := time.Now().Add(-time.Minute)
+		first, err := repo.ClaimExport(ctx, staleBefore)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(first.ExportID, convey.ShouldEqual, 1)
+		second, err := repo.ClaimExport(ctx, staleBefore)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(second.ExportID, convey.ShouldEqual, 2)
+		none, err := repo.ClaimExport(ctx, staleBefore)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(none, convey.ShouldBeNil)
+
+		//执行中的任务长时间没有进度，可以被重新领取
+		again, err := repo.ClaimExport(ctx, time.Now().Add(time.Minute))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(again.ExportID, convey.ShouldEqual, 1)
+
+		expire := time.Now().Add(-time.Second)
+		first.Status, first.RowCount, first.FileKey, first.ExpireAt = biz.ExportDone, 10, "review-export/31/1.csv", &expire
+		convey.So(repo.UpdateExport(ctx, first), convey.ShouldBeNil)
+		task, err := repo.GetExport(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(task.Status, convey.ShouldEqual, biz.ExportDone)
+		convey.So(task.RowCount, convey.ShouldEqual, 10)
+		expired, err := repo.ListExpiredExports(ctx, time.Now(), 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(expired, convey.ShouldHaveLength, 1)
+
+		list, total, err := repo.ListExports(ctx, 31, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 2)
+		convey.So(list[0].ExportID, convey.ShouldEqual, 2)
+		_, err = repo.GetExport(ctx, 404)
+		convey.So(pb.IsNotFound(err), convey.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	kcb "github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"sort"
+	"time"
+)
+
+var metricESFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "review",
+	Subsystem: "es",
+	Name:      "fallbacks_total",
+	Help:      "Listings served from mysql instead of elasticsearch by rpc and reason.",
+}, []string{"rpc", "reason"})
+
+// searchOrFallback ES出错或熔断打开时，配置了降级的接口改查mysql
+// 带游标的请求来自降级返回的下一页，继续查mysql
+func (r *reviewRepo) searchOrFallback(ctx context.Context, rpc string, cursor string,
+	search func() ([]*biz.MyReviewInfo, error), fallback func() (*biz.ReviewList, error)) (*biz.ReviewList, error) {
+	enabled := r.data.esFallback[rpc]
+	if enabled && cursor != "" {
+		metricESFallbacks.WithLabelValues(rpc, "cursor").Inc()
+		return fallback()
+	}
+	b := r.data.esBreaker
+	if b != nil {
+		if err := b.Allow(); err != nil {
+			b.MarkFailed()
+			if !enabled {
+				return nil, kcb.ErrNotAllowed
+			}
+			metricESFallbacks.WithLabelValues(rpc, "breaker_open").Inc()
+			return fallback()
+		}
+	}
+	list, err := search()
+	if err == nil {
+		if b != nil {
+			b.MarkSuccess()
+		}
+		return &biz.ReviewList{List: list}, nil
+	}
+	//调用方取消或超时不算ES故障
+	if ctx.Err() != nil {
+		return nil, err
+	}
+	if b != nil {
+		b.MarkFailed()
+	}
+	if !enabled {
+		return nil, err
+	}
+	r.log.WithContext(ctx).Warnf("es search for %s failed, fallback to mysql, err:%v", rpc, err)
+	metricESFallbacks.WithLabelValues(rpc, "es_error").Inc()
+	return fallback()
+}
+
+// listStoreReviewsFromDB 店铺的评价都在一张分表里，按store_id索引查询
+// 排序和ES一致，没有指定排序时按时间倒序；带游标时从游标之后取，否则按offset
+func (r *reviewRepo) listStoreReviewsFromDB(ctx context.Context, param *biz.ListReviewParam, offset int32, limit int32) (*biz.ReviewList, error) {
+	q := r.data.query.ReviewInfo.Table(r.reviewTable(param.StoreID))
+	conds := []gen.Condition{q.StoreID.Eq(param.StoreID), q.DeleteAt.IsNull()}
+	if param.Cursor != "" {
+		c, err := decodeCursor(param.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		byTime := field.Or(q.CreateAt.Lt(c.CreateAt), field.And(q.CreateAt.Eq(c.CreateAt), q.ReviewID.Lt(c.ReviewID)))
+		switch param.Sort {
+		case biz.SortHelpful:
+			conds = append(conds, field.Or(q.HelpfulCount.Lt(c.HelpfulCount), field.And(q.HelpfulCount.Eq(c.HelpfulCount), byTime)))
+		case biz.SortScore:
+			conds = append(conds, field.Or(q.Score.Lt(c.Score), field.And(q.Score.Eq(c.Score), byTime)))
+		default:
+			conds = append(conds, byTime)
+		}
+		offset = 0
+	}
+	orders := []field.Expr{q.CreateAt.Desc(), q.ReviewID.Desc()}
+	switch param.Sort {
+	case biz.SortHelpful:
+		orders = append([]field.Expr{q.HelpfulCount.Desc()}, orders...)
+	case biz.SortScore:
+		orders = append([]field.Expr{q.Score.Desc()}, orders...)
+	}
+	list, err := q.WithContext(ctx).Debug().Where(conds...).
+		Order(orders...).
+		Offset(int(offset)).Limit(int(limit)).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	return degradedList(list, param.Sort, limit), nil
+}
+
+// listContentReviewsFromDB 按评价ID倒序(雪花ID随时间递增)，逐个分表查询后合并
+func (r *reviewRepo) listContentReviewsFromDB(ctx context.Context, param *biz.ListReviewParam, offset int32, limit int32) (*biz.ReviewList, error) {
+	var after int64
+	if param.Cursor != "" {
+		c, err := decodeCursor(param.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after, offset = c.ReviewID, 0
+	}
+	var list []*model.ReviewInfo
+	for _, table := range ReviewTableNames(r.data.reviewShards) {
+		q := r.data.query.ReviewInfo.Table(table)
+		conds := []gen.Condition{q.Content.Neq(""), q.DeleteAt.IsNull()}
+		if after > 0 {
+			conds = append(conds, q.ReviewID.Lt(after))
+		}
+		rows, err := q.WithContext(ctx).Debug().Where(conds...).
+			Order(q.ReviewID.Desc()).
+			Limit(int(offset + limit)).
+			Find()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rows...)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ReviewID > list[j].ReviewID })
+	return degradedList(pageOf(list, offset, limit), "", limit), nil
+}
+
+// listCursor 降级查询的游标，记录上一页最后一条的排序值
+type listCursor struct {
+	HelpfulCount int32     `json:"h,omitempty"`
+	Score        int32     `json:"s,omitempty"`
+	CreateAt     time.Time `json:"t"`
+	ReviewID     int64     `json:"id"`
+}
+
+func encodeCursor(sort string, last *model.ReviewInfo) string {
+	c := listCursor{CreateAt: last.CreateAt, ReviewID: last.ReviewID}
+	switch sort {
+	case biz.SortHelpful:
+		c.HelpfulCount = last.HelpfulCount
+	case biz.SortScore:
+		c.Score = last.Score
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*listCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, pb.ErrorInvalidParams("无效的游标")
+	}
+	c := &listCursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, pb.ErrorInvalidParams("无效的游标")
+	}
+	return c, nil
+}
+
+// degradedList 取满一页时返回下一页的游标
+func degradedList(rows []*model.ReviewInfo, sort string, limit int32) *biz.ReviewList {
+	ret := &biz.ReviewList{List: make([]*biz.MyReviewInfo, 0, len(rows)), Degraded: true}
+	for _, row := range rows {
+		ret.List = append(ret.List, myReviewInfo(row))
+	}
+	if len(rows) > 0 && len(rows) == int(limit) {
+		ret.NextCursor = encodeCursor(sort, rows[len(rows)-1])
+	}
+	return ret
+}
+
+// myReviewInfo MyReviewInfo为了解析ES文档覆盖了一批字段，从mysql查出来时要逐个填上
+func myReviewInfo(m *model.ReviewInfo) *biz.MyReviewInfo {
+	return &biz.MyReviewInfo{
+		ReviewInfo:   m,
+		CreateAt:     biz.MyTime(m.CreateAt),
+		UpdateAt:     biz.MyTime(m.UpdateAt),
+		ID:           m.ID,
+		Version:      m.Version,
+		ReviewID:     m.ReviewID,
+		Score:        m.Score,
+		ServiceScore: m.ServiceScore,
+		ExpressScore: m.ExpressScore,
+		HasMedia:     m.HasMedia,
+		OrderID:      m.OrderID,
+		SkuID:        m.SkuID,
+		SpuID:        m.SpuID,
+		StoreID:      m.StoreID,
+		UserID:       m.UserID,
+		Anonymous:    m.Anonymous,
+		Status:       m.Status,
+		IsDefault:    m.IsDefault,
+		HasReply:     m.HasReply,
+		HelpfulCount: m.HelpfulCount,
+	}
+}
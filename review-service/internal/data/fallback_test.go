@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestESFallback(t *testing.T) {
+	db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+		Driver:      "sqlite",
+		Source:      filepath.Join(t.TempDir(), "review.db"),
+		AutoMigrate: true,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query.SetDefault(db)
+	data := &Data{
+		query:      query.Q,
+		log:        log.NewHelper(log.DefaultLogger),
+		esBreaker:  sre.NewBreaker(),
+		esFallback: map[string]bool{"ListReviewByStoreID": true},
+	}
+	repo := NewReviewRepo(data, log.DefaultLogger).(*reviewRepo)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	for i := int64(1); i <= 5; i++ {
+		_, err := repo.SaveReview(ctx, &model.ReviewInfo{
+			ReviewID: i, OrderID: 10 + i, UserID: 20 + i, StoreID: 31,
+			Content: "好评好评好评", HelpfulCount: int32(i % 2), CreateAt: now.Add(time.Duration(i) * time.Minute),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	esDown := func() ([]*biz.MyReviewInfo, error) { return nil, errors.New("index_not_found_exception") }
+
+	convey.Convey("es error falls back to mysql and pages by cursor", t, func() {
+		param := &biz.ListReviewParam{StoreID: 31, Sort: biz.SortNewest}
+		fallback := func() (*biz.ReviewList, error) { return repo.listStoreReviewsFromDB(ctx, param, 0, 2) }
+		page, err := repo.searchOrFallback(ctx, "ListReviewByStoreID", "", esDown, fallback)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.Degraded, convey.ShouldBeTrue)
+		convey.So(page.List[0].ReviewID, convey.ShouldEqual, 5)
+		convey.So(page.List[0].OrderID, convey.ShouldEqual, 15)
+
+		var ids []int64
+		for page.NextCursor != "" {
+			param.Cursor = page.NextCursor
+			page, err = repo.searchOrFallback(ctx, "ListReviewByStoreID", param.Cursor, esDown, fallback)
+			convey.So(err, convey.ShouldBeNil)
+			for _, r := range page.List {
+				ids = append(ids, r.ReviewID)
+			}
+		}
+		convey.So(ids, convey.ShouldResemble, []int64{3, 2, 1})
+	})
+
+	convey.Convey("helpful sort keeps ties ordered by time across pages", t, func() {
+		param := &biz.ListReviewParam{StoreID: 31, Sort: biz.SortHelpful}
+		var ids []int64
+		for {
+			page, err := repo.listStoreReviewsFromDB(ctx, param, 0, 2)
+			convey.So(err, convey.ShouldBeNil)
+			for _, r := range page.List {
+				ids = append(ids, r.ReviewID)
+			}
+			if page.NextCursor == "" {
+				break
+			}
+			param.Cursor = page.NextCursor
+		}
+		convey.So(ids, convey.ShouldResemble, []int64{5, 3, 1, 4, 2})
+	})
+
+	convey.Convey("rpc without fallback returns the es error", t, func() {
+		_, err := repo.searchOrFallback(ctx, "ListReviewByContent", "", esDown, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
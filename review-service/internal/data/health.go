@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"net/http"
+	"review-common/health"
+	"review-service/pkg/snowflake"
+)
+
+// NewHealthProbes 数据层依赖的检查
+// mysql不可用时服务未就绪；ES只影响列表查询，redis只影响点赞和ID租约续期，不可用时算降级
+// 机器ID租约失效后不能生成ID，所有创建都会失败，和mysql一样算未就绪；holder为nil时使用固定机器ID，不检查
+func NewHealthProbes(db *gorm.DB, es *elasticsearch.TypedClient, rdb *redis.Client, holder snowflake.Holder) health.Probes {
+	probes := health.Probes{
+		{Name: "mysql", Critical: true, Check: func(ctx context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		}},
+		{Name: "elasticsearch", Check: func(ctx context.Context) error {
+			return pingES(ctx, es)
+		}},
+		{Name: "redis", Check: func(ctx context.Context) error {
+			return rdb.Ping(ctx).Err()
+		}},
+	}
+	if holder != nil {
+		probes = append(probes, health.Probe{Name: "snowflake", Critical: true, Check: func(context.Context) error {
+			if !holder.Valid() {
+				return snowflake.LeaseLostErr
+			}
+			return nil
+		}})
+	}
+	return probes
+}
+
+// pingES 用底层Perform请求根路径，typed api每次调用都会产生一个span
+func pingES(ctx context.Context, es *elasticsearch.TypedClient) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, "/", nil)
+	if err != nil {
+		return err
+	}
+	res, err := es.Perform(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("elasticsearch status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	"review-service/pkg/snowflake"
+	"testing"
+)
+
+type fakeHolder struct {
+	valid bool
+}
+
+func (h *fakeHolder) Valid() bool {
+	return h.valid
+}
+
+func TestHealthProbes(t *testing.T) {
+	convey.Convey("a leased machine id is a critical dependency", t, func() {
+		convey.So(len(NewHealthProbes(nil, nil, nil, nil)), convey.ShouldEqual, 3)
+
+		holder := &fakeHolder{valid: true}
+		probes := NewHealthProbes(nil, nil, nil, holder)
+		convey.So(len(probes), convey.ShouldEqual, 4)
+		p := probes[3]
+		convey.So(p.Name, convey.ShouldEqual, "snowflake")
+		convey.So(p.Critical, convey.ShouldBeTrue)
+		convey.So(p.Check(context.Background()), convey.ShouldBeNil)
+		holder.valid = false
+		convey.So(p.Check(context.Background()), convey.ShouldEqual, snowflake.LeaseLostErr)
+	})
+}
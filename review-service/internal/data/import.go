@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+)
+
+// Importer 历史评价导入的存储操作，cmd/import使用
+type Importer struct {
+	query  *query.Query
+	rdb    *redis.Client
+	shards int32
+	log    *log.Helper
+}
+
+var _ biz.ImportRepo = (*Importer)(nil)
+
+func NewImporter(c *conf.Data, db *gorm.DB, rdb *redis.Client, logger log.Logger) *Importer {
+	return &Importer{
+		query:  query.Use(db),
+		rdb:    rdb,
+		shards: c.GetSharding().GetReviewShards(),
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (im *Importer) FindImported(ctx context.Context, source string, sourceIDs []string) (map[string]int64, error) {
+	q := im.query.ReviewImportSource
+	list, err := q.WithContext(ctx).Debug().
+		Where(q.Source.Eq(source), q.SourceID.In(sourceIDs...)).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]int64, len(list))
+	for _, s := range list {
+		ret[s.SourceID] = s.ReviewID
+	}
+	return ret, nil
+}
+
+// FindReviewedOrders 单表时也写索引表，索引表建立前的评价由迁移补齐，直接按索引表判断
+func (im *Importer) FindReviewedOrders(ctx context.Context, orderIDs []int64) (map[int64]bool, error) {
+	q := im.query.ReviewInfoIndex
+	list, err := q.WithContext(ctx).Debug().Where(q.OrderID.In(orderIDs...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[int64]bool, len(list))
+	for _, i := range list {
+		ret[i.OrderID] = true
+	}
+	return ret, nil
+}
+
+// SaveImported 一批评价在一个事务里写入，来源映射的唯一键保证并发导入同一条评价时只有一个成功
+func (im *Importer) SaveImported(ctx context.Context, source string, reviews []*biz.ImportedReview) error {
+	tables := make(map[string][]*model.ReviewInfo)
+	idx := make([]*model.ReviewInfoIndex, 0, len(reviews))
+	sources := make([]*model.ReviewImportSource, 0, len(reviews))
+	for _, r := range reviews {
+		table := ReviewTableName(im.shards, r.Review.StoreID)
+		tables[table] = append(tables[table], r.Review)
+		idx = append(idx, &model.ReviewInfoIndex{
+			ReviewID: r.Review.ReviewID,
+			OrderID:  r.Review.OrderID,
+			UserID:   r.Review.UserID,
+			StoreID:  r.Review.StoreID,
+		})
+		sources = append(sources, &model.ReviewImportSource{
+			Source:   source,
+			SourceID: r.SourceID,
+			ReviewID: r.Review.ReviewID,
+		})
+	}
+	err := im.query.Transaction(func(tx *query.Query) error {
+		for table, list := range tables {
+			if err := tx.ReviewInfo.Table(table).WithContext(ctx).CreateInBatches(list, len(list)); err != nil {
+				return err
+			}
+		}
+		if err := tx.ReviewInfoIndex.WithContext(ctx).CreateInBatches(idx, len(idx)); err != nil {
+			return err
+		}
+		return tx.ReviewImportSource.WithContext(ctx).CreateInBatches(sources, len(sources))
+	})
+	if err == nil {
+		im.evictCache(ctx, reviews)
+	}
+	return err
+}
+
+// evictCache 和CreateReview一样，订单和评价之前可能缓存了没有评价的空值
+func (im *Importer) evictCache(ctx context.Context, reviews []*biz.ImportedReview) {
+	if im.rdb == nil || len(reviews) == 0 {
+		return
+	}
+	keys := make([]string, 0, 2*len(reviews))
+	for _, r := range reviews {
+		keys = append(keys, fmt.Sprintf(reviewCacheKey, r.Review.ReviewID), fmt.Sprintf(orderCacheKey, r.Review.OrderID))
+	}
+	if err := im.rdb.Del(context.WithoutCancel(ctx), keys...).Err(); err != nil {
+		im.log.WithContext(ctx).Errorf("evict imported review cache failed, reviews:%v err:%v", len(reviews), err)
+	}
+}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/pkg/snowflake"
+	"strings"
+	"testing"
+	"time"
+)
+
+const importJSONL = `{"source_id":"a1","user_id":21,"order_id":101,"store_id":31,"score":5,"service_score":5,"express_score":4,"content":"东西很好用","create_at":"2021-06-01 10:00:00"}
+{"source_id":"a2","user_id":22,"order_id":102,"store_id":31,"score":6,"service_score":5,"express_score":4,"content":"评分超出范围","create_at":"2021-06-01 10:00:00"}
+
+{"source_id":"a3","user_id":23,"order_id":101,"store_id":32,"score":3,"service_score":3,"express_score":3,"content":"同一个订单又评价","create_at":"2021-06-02T10:00:00+08:00"}
+{"source_id":"a1","user_id":21,"order_id":103,"store_id":31,"score":5,"service_score":5,"express_score":5,"content":"来源ID重复了","create_at":"2021-06-01 10:00:00"}
+not json
+`
+
+func TestImportReviews(t *testing.T) {
+	convey.Convey("imports are validated per row and idempotent by source id", t, func() {
+		convey.So(snowflake.Init("2024-03-01", 1), convey.ShouldBeNil)
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		im := NewImporter(&conf.Data{}, db, rdb, log.DefaultLogger)
+		ctx := context.Background()
+		q := NewReviewRepo(&Data{query: im.query, log: im.log, rdb: rdb, reviewCacheTTL: time.Minute, reviewCacheMissTTL: time.Minute}, log.DefaultLogger)
+		//导入前查过的订单缓存了没有评价
+		cached, err := q.GetReviewsByOrderIDs(ctx, []int64{101})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cached, convey.ShouldBeEmpty)
+		convey.So(mr.Exists("review:order:101"), convey.ShouldBeTrue)
+		opt := &biz.ImportOption{Source: "legacy", Batch: 2}
+		run := func(format, input string) (*biz.ImportReport, map[int]*biz.ImportResult) {
+			rd, err := biz.NewImportReader(format, strings.NewReader(input))
+			convey.So(err, convey.ShouldBeNil)
+			rows := make(map[int]*biz.ImportResult)
+			report, err := biz.RunImport(ctx, im, rd, opt, func(r *biz.ImportResult) { rows[r.Line] = r })
+			convey.So(err, convey.ShouldBeNil)
+			return report, rows
+		}
+
+		report, rows := run(biz.ImportJSONL, importJSONL)
+		convey.So(*report, convey.ShouldResemble, biz.ImportReport{Total: 5, Imported: 1, Failed: 4})
+		convey.So(rows[1].Result, convey.ShouldEqual, biz.ImportImported)
+		convey.So(rows[2].Error, convey.ShouldContainSubstring, "Score")
+		convey.So(rows[4].Error, convey.ShouldContainSubstring, "已评价过")
+		convey.So(rows[5].Error, convey.ShouldContainSubstring, "重复")
+		convey.So(rows[6].Result, convey.ShouldEqual, biz.ImportFailed)
+
+		review, err := q.GetReviewByReviewID(ctx, rows[1].ReviewID)
+		convey.So(err, convey.ShouldBeNil)
+		cached, err = q.GetReviewsByOrderIDs(ctx, []int64{101})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cached[101].ReviewID, convey.ShouldEqual, rows[1].ReviewID)
+		convey.So(review.CreateAt.Format("2006-01-02 15:04:05"), convey.ShouldEqual, "2021-06-01 10:00:00")
+		convey.So(review.Status, convey.ShouldEqual, 20)
+
+		//再导入一次，已导入的行跳过并返回原来的评价ID
+		report, again := run(biz.ImportJSONL, importJSONL)
+		convey.So(report.Imported, convey.ShouldEqual, 0)
+		convey.So(report.Skipped, convey.ShouldEqual, 1)
+		convey.So(again[1].ReviewID, convey.ShouldEqual, rows[1].ReviewID)
+
+		csv := "\ufeffsource_id,user_id,order_id,store_id,score,service_score,express_score,content,anonymous,pic_info,create_at\n" +
+			"c1,24,104,33,4,4,4,\"还不错, 会回购\",true,a.png,2022-01-01 08:00:00\n" +
+			"c2,25,105,33,4,4,4,太短,false,,2022-01-01 08:00:00\n"
+		report, rows = run(biz.ImportCSV, csv)
+		convey.So(*report, convey.ShouldResemble, biz.ImportReport{Total: 2, Imported: 1, Failed: 1})
+		review, err = q.GetReviewByReviewID(ctx, rows[2].ReviewID)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.Content, convey.ShouldEqual, "还不错, 会回购")
+		convey.So(review.Anonymous, convey.ShouldEqual, 1)
+		convey.So(review.HasMedia, convey.ShouldEqual, 1)
+		convey.So(rows[3].Error, convey.ShouldContainSubstring, "Content")
+	})
+}
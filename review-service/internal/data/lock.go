@@ -0,0 +1,36 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// unlockScript 只删除自己持有的锁，执行超过租期后锁可能已经被其他实例拿到
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// tryLock 用随机token加锁，没拿到锁时返回空字符串
+func (d *Data) tryLock(ctx context.Context, key string, lease time.Duration) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	ok, err := d.rdb.SetNX(ctx, key, token, lease).Result()
+	if err != nil || !ok {
+		return "", err
+	}
+	return token, nil
+}
+
+// unlock 释放tryLock拿到的锁，锁已过期或被其他实例持有时不做任何事
+func (d *Data) unlock(ctx context.Context, key string, token string) error {
+	return unlockScript.Run(ctx, d.rdb, []string{key}, token).Err()
+}
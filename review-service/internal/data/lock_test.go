@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestRedisLock(t *testing.T) {
+	convey.Convey("unlock only releases the caller's own lock", t, func() {
+		mr := miniredis.RunT(t)
+		d := &Data{rdb: redis.NewClient(&redis.Options{Addr: mr.Addr()})}
+		ctx := context.Background()
+
+		token, err := d.tryLock(ctx, "lock", time.Second)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(token, convey.ShouldNotBeEmpty)
+		other, err := d.tryLock(ctx, "lock", time.Second)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(other, convey.ShouldBeEmpty)
+
+		//租期过后被其他实例拿到锁，原持有者释放时不能删掉别人的锁
+		mr.FastForward(2 * time.Second)
+		other, err = d.tryLock(ctx, "lock", time.Second)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(other, convey.ShouldNotBeEmpty)
+		convey.So(d.unlock(ctx, "lock", token), convey.ShouldBeNil)
+		held, _ := mr.Get("lock")
+		convey.So(held, convey.ShouldEqual, other)
+
+		convey.So(d.unlock(ctx, "lock", other), convey.ShouldBeNil)
+		convey.So(mr.Exists("lock"), convey.ShouldBeFalse)
+	})
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+	"time"
+)
+
+const (
+	metricsStartKey  = "review:metrics_start"
+	metricsStartName = "review:metrics_start"
+	metricsEndName   = "review:metrics_end"
+)
+
+var (
+	metricDBSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "review",
+		Subsystem: "db",
+		Name:      "query_seconds",
+		Help:      "Duration of gorm statements by operation and table.",
+		Buckets:   []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1},
+	}, []string{"operation", "table", "result"})
+	metricESSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "review",
+		Subsystem: "es",
+		Name:      "request_seconds",
+		Help:      "Duration of elasticsearch requests by operation.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"operation", "result"})
+)
+
+// useMetrics 按操作和表统计SQL耗时，定时任务的SQL也统计
+func useMetrics(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register(metricsStartName, startTimer),
+		cb.Create().After("gorm:create").Register(metricsEndName, observeStatement("create")),
+		cb.Query().Before("gorm:query").Register(metricsStartName, startTimer),
+		cb.Query().After("gorm:query").Register(metricsEndName, observeStatement("query")),
+		cb.Update().Before("gorm:update").Register(metricsStartName, startTimer),
+		cb.Update().After("gorm:update").Register(metricsEndName, observeStatement("update")),
+		cb.Delete().Before("gorm:delete").Register(metricsStartName, startTimer),
+		cb.Delete().After("gorm:delete").Register(metricsEndName, observeStatement("delete")),
+		cb.Row().Before("gorm:row").Register(metricsStartName, startTimer),
+		cb.Row().After("gorm:row").Register(metricsEndName, observeStatement("row")),
+		cb.Raw().Before("gorm:raw").Register(metricsStartName, startTimer),
+		cb.Raw().After("gorm:raw").Register(metricsEndName, observeStatement("raw")),
+	)
+}
+
+func startTimer(db *gorm.DB) {
+	db.InstanceSet(metricsStartKey, time.Now())
+}
+
+func observeStatement(op string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(metricsStartKey)
+		if !ok {
+			return
+		}
+		result := "ok"
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			result = "error"
+		}
+		metricDBSeconds.WithLabelValues(op, db.Statement.Table, result).Observe(time.Since(v.(time.Time)).Seconds())
+	}
+}
+
+// observeES 在ES请求返回后调用
+func observeES(op string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	metricESSeconds.WithLabelValues(op, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,230 @@
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//表结构以这里的迁移文件为准，每种数据库一个目录，文件名 版本号_名称.up.sql/.down.sql
+//版本号只增不改，已上线的迁移文件不要再修改，改表结构请新增一个版本
+
+//go:embed mysql/*.sql sqlite/*.sql
+var files embed.FS
+
+// TableName 记录已执行的迁移版本
+const TableName = "schema_migrations"
+
+// Migration 一个版本的迁移
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// State 迁移执行状态
+type State struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator 按版本顺序执行迁移
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+}
+
+// New 按数据库类型加载内嵌的迁移文件，driver为mysql或sqlite
+func New(db *gorm.DB, driver string) (*Migrator, error) {
+	migrations, err := load(files, strings.ToLower(driver))
+	if err != nil {
+		return nil, err
+	}
+	//迁移读写都走主库
+	return &Migrator{db: db.Clauses(dbresolver.Write), migrations: migrations}, nil
+}
+
+func load(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("migrations: unsupported driver %q", dir)
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		name := e.Name()
+		base, up := strings.CutSuffix(name, ".up.sql")
+		if !up {
+			var down bool
+			if base, down = strings.CutSuffix(name, ".down.sql"); !down {
+				continue
+			}
+		}
+		version, title, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migrations: bad file name %s", name)
+		}
+		v, err := strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrations: bad version in %s", name)
+		}
+		content, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		m := byVersion[v]
+		if m == nil {
+			m = &Migration{Version: v, Name: title}
+			byVersion[v] = m
+		}
+		if up {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+	list := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migrations: version %d has no up file", m.Version)
+		}
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.db.WithContext(ctx).Exec("CREATE TABLE IF NOT EXISTS " + TableName + ` (
+    version BIGINT NOT NULL PRIMARY KEY,
+    name VARCHAR(128) NOT NULL DEFAULT '',
+    applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`).Error
+}
+
+type appliedRow struct {
+	Version   int64
+	AppliedAt time.Time
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	var rows []appliedRow
+	if err := m.db.WithContext(ctx).Table(TableName).Select("version", "applied_at").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	ret := make(map[int64]time.Time, len(rows))
+	for _, r := range rows {
+		ret[r.Version] = r.AppliedAt
+	}
+	return ret, nil
+}
+
+// Status 所有迁移及是否已执行
+func (m *Migrator) Status(ctx context.Context) ([]*State, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*State, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		at, ok := applied[mg.Version]
+		list = append(list, &State{Migration: *mg, Applied: ok, AppliedAt: at})
+	}
+	return list, nil
+}
+
+// Up 执行所有未执行的迁移，返回本次执行的个数
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for _, mg := range m.migrations {
+		if _, ok := applied[mg.Version]; ok {
+			continue
+		}
+		if err := m.exec(ctx, mg.Up); err != nil {
+			return n, fmt.Errorf("migrations: up %d_%s: %w", mg.Version, mg.Name, err)
+		}
+		err := m.db.WithContext(ctx).Exec("INSERT INTO "+TableName+" (version, name, applied_at) VALUES (?, ?, ?)",
+			mg.Version, mg.Name, time.Now()).Error
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Down 从最新版本开始回滚steps个已执行的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for i := len(m.migrations) - 1; i >= 0 && n < steps; i-- {
+		mg := m.migrations[i]
+		if _, ok := applied[mg.Version]; !ok {
+			continue
+		}
+		if mg.Down == "" {
+			return n, fmt.Errorf("migrations: version %d has no down file", mg.Version)
+		}
+		if err := m.exec(ctx, mg.Down); err != nil {
+			return n, fmt.Errorf("migrations: down %d_%s: %w", mg.Version, mg.Name, err)
+		}
+		err := m.db.WithContext(ctx).Exec("DELETE FROM "+TableName+" WHERE version = ?", mg.Version).Error
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// exec mysql驱动默认不支持一次执行多条语句，按分号拆开逐条执行
+// mysql的DDL会隐式提交，这里不包事务，失败后需要人工处理再重跑
+func (m *Migrator) exec(ctx context.Context, script string) error {
+	for _, stmt := range split(script) {
+		if err := m.db.WithContext(ctx).Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// split 按行尾的分号拆分语句，忽略--开头的注释行
+func split(script string) []string {
+	var stmts []string
+	var b strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(b.String()), ";"))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
@@ -0,0 +1,90 @@
+package migrations
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrator(t *testing.T) {
+	convey.Convey("up, status and down on sqlite", t, func() {
+		db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "review.db")))
+		convey.So(err, convey.ShouldBeNil)
+		m, err := New(db, "sqlite")
+		convey.So(err, convey.ShouldBeNil)
+		ctx := context.Background()
+
+		n, err := m.Up(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, len(m.migrations))
+		convey.So(db.Migrator().HasTable("review_info"), convey.ShouldBeTrue)
+		//再执行一次没有新的迁移
+		n, err = m.Up(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 0)
+
+		list, err := m.Status(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		for _, s := range list {
+			convey.So(s.Applied, convey.ShouldBeTrue)
+		}
+
+		n, err = m.Down(ctx, len(m.migrations))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, len(m.migrations))
+		convey.So(db.Migrator().HasTable("review_info"), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("upgrade a database built from the old review.sql", t, func() {
+		db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "review.db")))
+		convey.So(err, convey.ShouldBeNil)
+		m, err := New(db, "sqlite")
+		convey.So(err, convey.ShouldBeNil)
+		ctx := context.Background()
+		//只执行基线版本，模拟按review.sql建的库
+		base := &Migrator{db: m.db, migrations: m.migrations[:1]}
+		n, err := base.Up(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 1)
+		convey.So(db.Migrator().HasColumn("review_appeal_info", "round"), convey.ShouldBeFalse)
+		convey.So(db.Exec("INSERT INTO review_appeal_info (appeal_id, review_id, store_id, status, reason, content) VALUES (1, 100, 20, 30, '不实', '驳回')").Error, convey.ShouldBeNil)
+		convey.So(db.Exec("INSERT INTO review_info (review_id, order_id, user_id, store_id, content) VALUES (100, 200, 10, 20, '老评价')").Error, convey.ShouldBeNil)
+
+		n, err = m.Up(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, len(m.migrations)-1)
+		var round int
+		convey.So(db.Raw("SELECT round FROM review_appeal_info WHERE appeal_id = 1").Scan(&round).Error, convey.ShouldBeNil)
+		convey.So(round, convey.ShouldEqual, 1)
+		//驳回后的第二轮申诉不能撞上旧的review_id唯一键
+		convey.So(db.Exec("INSERT INTO review_appeal_info (appeal_id, review_id, store_id, round, status, reason, content) VALUES (2, 100, 20, 2, 10, '不实', '补充材料')").Error, convey.ShouldBeNil)
+		convey.So(db.Exec("INSERT INTO review_appeal_info (appeal_id, review_id, store_id, round, status, reason, content) VALUES (3, 100, 20, 2, 10, '不实', '重复')").Error, convey.ShouldNotBeNil)
+		convey.So(db.Migrator().HasTable("review_appeal_message"), convey.ShouldBeTrue)
+		convey.So(db.Migrator().HasColumn("review_info", "helpful_count"), convey.ShouldBeTrue)
+		//迁移前的评价补进索引表，导入时按订单去重才能查到
+		var storeID int64
+		convey.So(db.Raw("SELECT store_id FROM review_info_index WHERE order_id = 200").Scan(&storeID).Error, convey.ShouldBeNil)
+		convey.So(storeID, convey.ShouldEqual, 20)
+	})
+
+	convey.Convey("mysql and sqlite have the same versions", t, func() {
+		my, err := load(files, "mysql")
+		convey.So(err, convey.ShouldBeNil)
+		lite, err := load(files, "sqlite")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(my), convey.ShouldEqual, len(lite))
+		for i := range my {
+			convey.So(my[i].Version, convey.ShouldEqual, lite[i].Version)
+			convey.So(my[i].Down, convey.ShouldNotBeEmpty)
+		}
+	})
+
+	convey.Convey("split statements by trailing semicolon", t, func() {
+		stmts := split("-- comment\nCREATE TABLE a (\n  id INT\n);\n\nDROP TABLE b;\n")
+		convey.So(stmts, convey.ShouldHaveLength, 2)
+		convey.So(stmts[1], convey.ShouldEqual, "DROP TABLE b")
+	})
+}
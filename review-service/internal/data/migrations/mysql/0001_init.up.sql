@@ -1,4 +1,7 @@
-CREATE TABLE review_info (
+-- 基线版本，和迁移机制引入前的review.sql一致，只有review_info、review_reply_info、review_appeal_info三张表
+-- 已经按review.sql建过表的库执行这个版本不会报错，只会补上版本记录，之后的表结构变更由后面的版本逐个执行
+
+CREATE TABLE IF NOT EXISTS review_info (
                              `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
                              `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
                              `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
@@ -38,7 +41,7 @@ CREATE TABLE review_info (
                              KEY `idx_user_id` (`user_id`) COMMENT '⽤户id索引'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价表';
 
-CREATE TABLE review_reply_info (
+CREATE TABLE IF NOT EXISTS review_reply_info (
                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
@@ -63,7 +66,7 @@ CREATE TABLE review_reply_info (
 )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家回复表';
 
 
-CREATE TABLE review_appeal_info (
+CREATE TABLE IF NOT EXISTS review_appeal_info (
                                     `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
                                     `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
                                     `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
@@ -89,4 +92,4 @@ CREATE TABLE review_appeal_info (
                                     KEY `idx_appeal_id` (`appeal_id`) COMMENT '申诉id索引',
                                     UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
                                     KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
-)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
\ No newline at end of file
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
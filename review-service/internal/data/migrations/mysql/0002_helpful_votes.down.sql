@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_vote_info;
+ALTER TABLE review_info DROP COLUMN `helpful_count`;
@@ -0,0 +1,17 @@
+-- 评价有用数和投票记录
+ALTER TABLE review_info
+    ADD COLUMN `helpful_count` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '有用数' AFTER `has_reply`;
+
+CREATE TABLE IF NOT EXISTS review_vote_info (
+                                  `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                  `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                  `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                  `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                  `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                      CURRENT_TIMESTAMP COMMENT '更新时间',
+                                  `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                                  `user_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '⽤户id',
+                                  PRIMARY KEY (`id`),
+                                  UNIQUE KEY `uk_review_user` (`review_id`,`user_id`) COMMENT '每个用户对一条评价只能投一票',
+                                  KEY `idx_user_id` (`user_id`) COMMENT '⽤户id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价有用投票表';
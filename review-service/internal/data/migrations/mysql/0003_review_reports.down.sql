@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_report_info;
+ALTER TABLE review_info DROP KEY `idx_report_count`, DROP COLUMN `report_count`;
@@ -0,0 +1,24 @@
+-- 评价举报，待处理举报数达到阈值时评价回到待审核
+ALTER TABLE review_info
+    ADD COLUMN `report_count` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '待处理举报数' AFTER `helpful_count`,
+    ADD KEY `idx_report_count` (`report_count`) COMMENT '举报数索引';
+
+CREATE TABLE IF NOT EXISTS review_report_info (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `report_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '举报id',
+                                    `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                                    `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+                                    `user_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '举报人id',
+                                    `reason` tinyint(4) NOT NULL DEFAULT '0' COMMENT '举报原因:1广告引流;2辱骂攻击;3色情低俗;4虚假评价;5其他',
+                                    `content` varchar(255) NOT NULL DEFAULT '' COMMENT '举报描述',
+                                    `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待处理;20已处理',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_report_id` (`report_id`) COMMENT '举报id索引',
+                                    UNIQUE KEY `uk_review_user` (`review_id`,`user_id`) COMMENT '每个用户对一条评价只能举报一次',
+                                    KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价举报表';
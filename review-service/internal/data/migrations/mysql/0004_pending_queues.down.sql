@@ -0,0 +1,2 @@
+ALTER TABLE review_appeal_info DROP KEY `idx_status_create_at`;
+ALTER TABLE review_info DROP KEY `idx_status_create_at`;
@@ -0,0 +1,3 @@
+-- 运营待审核队列按状态和创建时间取最早的记录
+ALTER TABLE review_info ADD KEY `idx_status_create_at` (`status`,`create_at`) COMMENT '待审核队列索引';
+ALTER TABLE review_appeal_info ADD KEY `idx_status_create_at` (`status`,`create_at`) COMMENT '待审核队列索引';
@@ -0,0 +1 @@
+ALTER TABLE review_info DROP KEY `idx_store_id_create_at`;
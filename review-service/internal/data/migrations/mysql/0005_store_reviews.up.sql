@@ -0,0 +1,2 @@
+-- 商家按店铺查评价列表
+ALTER TABLE review_info ADD KEY `idx_store_id_create_at` (`store_id`,`create_at`) COMMENT '店铺评价列表索引';
@@ -0,0 +1,36 @@
+-- 商家回复模板和自动回复规则
+CREATE TABLE IF NOT EXISTS review_reply_template (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `template_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '模板id',
+                                    `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+                                    `name` varchar(64) NOT NULL DEFAULT '' COMMENT '模板名称',
+                                    `content` varchar(512) NOT NULL DEFAULT '' COMMENT '回复内容,支持占位符{nickname}{product}',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_template_id` (`template_id`) COMMENT '模板id索引',
+                                    KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商家回复模板表';
+
+CREATE TABLE IF NOT EXISTS review_auto_reply_rule (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `rule_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '规则id',
+                                    `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+                                    `template_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '回复模板id',
+                                    `min_score` tinyint(4) NOT NULL DEFAULT '1' COMMENT '评分下限',
+                                    `max_score` tinyint(4) NOT NULL DEFAULT '5' COMMENT '评分上限',
+                                    `only_empty` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否只回复没有文字内容的评价',
+                                    `delay_seconds` int(11) NOT NULL DEFAULT '0' COMMENT '评价创建多久后自动回复(秒)',
+                                    `enabled` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否启用',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_rule_id` (`rule_id`) COMMENT '规则id索引',
+                                    KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商家自动回复规则表';
@@ -0,0 +1,7 @@
+-- 有多轮申诉的评价回滚时会因为唯一键冲突失败，需要先清理后面几轮的申诉
+DROP TABLE IF EXISTS review_appeal_message;
+ALTER TABLE review_appeal_info
+    DROP KEY `uk_review_round`,
+    ADD UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
+    MODIFY COLUMN `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20申诉通过；30申诉驳回',
+    DROP COLUMN `round`;
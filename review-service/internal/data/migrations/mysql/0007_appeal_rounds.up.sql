@@ -0,0 +1,28 @@
+-- 申诉轮次：驳回或撤回后可以再次申诉，唯一键从review_id改为(review_id, round)
+-- 已有的申诉都是第1轮
+ALTER TABLE review_appeal_info
+    ADD COLUMN `round` int(11) NOT NULL DEFAULT '1' COMMENT '申诉轮次,驳回或撤回后可以再次申诉' AFTER `store_id`,
+    MODIFY COLUMN `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20申诉通过；30申诉驳回；40待补充材料；50已撤回',
+    DROP KEY `uk_review_id`,
+    ADD UNIQUE KEY `uk_review_round` (`review_id`,`round`) COMMENT '每条评价每轮一个申诉';
+
+CREATE TABLE IF NOT EXISTS review_appeal_message (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `message_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '消息id',
+                                    `appeal_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '申诉id',
+                                    `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                                    `sender_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '发送方:1商家;2运营',
+                                    `sender` varchar(64) NOT NULL DEFAULT '' COMMENT '发送方标识:店铺id或运营者',
+                                    `action` tinyint(4) NOT NULL DEFAULT '0' COMMENT '动作:1提交申诉;2补充材料;3要求补充材料;4审核;5撤回',
+                                    `content` varchar(512) NOT NULL DEFAULT '' COMMENT '内容',
+                                    `pic_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：图⽚',
+                                    `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：视频',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_message_id` (`message_id`) COMMENT '消息id索引',
+                                    KEY `idx_review_id` (`review_id`) COMMENT '评价id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='申诉沟通记录表';
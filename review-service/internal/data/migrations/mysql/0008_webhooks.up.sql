@@ -0,0 +1,40 @@
+-- webhook订阅和投递记录
+CREATE TABLE IF NOT EXISTS review_webhook_subscription (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `subscription_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '订阅id',
+                                    `owner_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '订阅方:1店铺;2应用',
+                                    `owner_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id或应用id',
+                                    `url` varchar(512) NOT NULL DEFAULT '' COMMENT '回调地址',
+                                    `secret` varchar(64) NOT NULL DEFAULT '' COMMENT '签名密钥',
+                                    `events` varchar(255) NOT NULL DEFAULT '' COMMENT '订阅的事件,逗号分隔',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_subscription_id` (`subscription_id`) COMMENT '订阅id索引',
+                                    KEY `idx_owner` (`owner_type`,`owner_id`) COMMENT '订阅方索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='webhook订阅表';
+
+CREATE TABLE IF NOT EXISTS review_webhook_delivery (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `delivery_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '投递id',
+                                    `subscription_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '订阅id',
+                                    `event` varchar(32) NOT NULL DEFAULT '' COMMENT '事件',
+                                    `payload` text NOT NULL COMMENT '事件内容json',
+                                    `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待投递;20投递成功;30投递失败',
+                                    `attempts` int(11) NOT NULL DEFAULT '0' COMMENT '已投递次数',
+                                    `next_retry_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下次投递时间',
+                                    `response_code` int(11) NOT NULL DEFAULT '0' COMMENT '最近一次响应码',
+                                    `last_error` varchar(255) NOT NULL DEFAULT '' COMMENT '最近一次失败原因',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_delivery_id` (`delivery_id`) COMMENT '投递id索引',
+                                    KEY `idx_subscription_id` (`subscription_id`) COMMENT '订阅id索引',
+                                    KEY `idx_status_next_retry_at` (`status`,`next_retry_at`) COMMENT '待投递队列索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='webhook投递记录表';
@@ -0,0 +1,18 @@
+-- 按评价id、订单id、用户id查询时先查索引表拿到店铺id，再到review_info_{store_id%分表数}里查
+CREATE TABLE IF NOT EXISTS review_info_index (
+                             `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                             `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                             `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                             `order_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '订单id',
+                             `user_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '⽤户id',
+                             `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+                             PRIMARY KEY (`id`),
+                             UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
+                             KEY `idx_order_id` (`order_id`) COMMENT '订单id索引',
+                             KEY `idx_user_id` (`user_id`) COMMENT '⽤户id索引'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价分表索引表';
+
+-- 补上索引表建立之前的评价，导入去重和分表后的查询都只看索引表
+INSERT INTO review_info_index (review_id, order_id, user_id, store_id)
+SELECT r.review_id, r.order_id, r.user_id, r.store_id FROM review_info r
+WHERE NOT EXISTS (SELECT 1 FROM review_info_index i WHERE i.review_id = r.review_id);
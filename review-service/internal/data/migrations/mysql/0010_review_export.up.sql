@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS review_export_task (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+                                    `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                    `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+                                        CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    `export_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '导出任务id',
+                                    `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+                                    `format` varchar(8) NOT NULL DEFAULT '' COMMENT '文件格式:csv;xlsx',
+                                    `filter` varchar(512) NOT NULL DEFAULT '' COMMENT '筛选条件json',
+                                    `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10排队中;20导出中;30已完成;40失败;50已过期',
+                                    `row_count` int(11) NOT NULL DEFAULT '0' COMMENT '已导出行数',
+                                    `file_key` varchar(255) NOT NULL DEFAULT '' COMMENT '文件存储路径',
+                                    `file_size` bigint(32) NOT NULL DEFAULT '0' COMMENT '文件大小',
+                                    `last_error` varchar(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+                                    `finish_at` timestamp NULL DEFAULT NULL COMMENT '完成时间',
+                                    `expire_at` timestamp NULL DEFAULT NULL COMMENT '文件过期时间',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_export_id` (`export_id`) COMMENT '导出任务id索引',
+                                    KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引',
+                                    KEY `idx_status_update_at` (`status`,`update_at`) COMMENT '待执行队列索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价导出任务表';
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS review_import_source (
+                                    `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+                                    `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '导入时间',
+                                    `source` varchar(32) NOT NULL DEFAULT '' COMMENT '来源平台',
+                                    `source_id` varchar(64) NOT NULL DEFAULT '' COMMENT '来源平台的评价id',
+                                    `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+                                    PRIMARY KEY (`id`),
+                                    UNIQUE KEY `uk_source_id` (`source`,`source_id`) COMMENT '来源评价id索引',
+                                    KEY `idx_review_id` (`review_id`) COMMENT '评价id索引'
+)ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='导入评价来源映射表';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS review_appeal_info;
+DROP TABLE IF EXISTS review_reply_info;
+DROP TABLE IF EXISTS review_info;
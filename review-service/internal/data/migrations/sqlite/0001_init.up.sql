@@ -0,0 +1,87 @@
+-- 基线版本，和迁移机制引入前的review.sql一致
+
+CREATE TABLE IF NOT EXISTS review_info (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delete_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 0,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    content VARCHAR(512) NOT NULL,
+    score INTEGER NOT NULL DEFAULT 0,
+    service_score INTEGER NOT NULL DEFAULT 0,
+    express_score INTEGER NOT NULL DEFAULT 0,
+    has_media INTEGER NOT NULL DEFAULT 0,
+    order_id INTEGER NOT NULL DEFAULT 0,
+    sku_id INTEGER NOT NULL DEFAULT 0,
+    spu_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    user_id INTEGER NOT NULL DEFAULT 0,
+    anonymous INTEGER NOT NULL DEFAULT 0,
+    tags VARCHAR(1024) NOT NULL DEFAULT '',
+    pic_info VARCHAR(1024) NOT NULL DEFAULT '',
+    video_info VARCHAR(1024) NOT NULL DEFAULT '',
+    status INTEGER NOT NULL DEFAULT 10,
+    is_default INTEGER NOT NULL DEFAULT 0,
+    has_reply INTEGER NOT NULL DEFAULT 0,
+    op_reason VARCHAR(512) NOT NULL DEFAULT '',
+    op_remarks VARCHAR(512) NOT NULL DEFAULT '',
+    op_user VARCHAR(64) NOT NULL DEFAULT '',
+    goods_snapshoot VARCHAR(2048) NOT NULL DEFAULT '',
+    ext_json VARCHAR(1024) NOT NULL DEFAULT '',
+    ctrl_json VARCHAR(1024) NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS review_info_idx_delete_at ON review_info (delete_at);
+CREATE UNIQUE INDEX IF NOT EXISTS review_info_uk_review_id ON review_info (review_id);
+CREATE INDEX IF NOT EXISTS review_info_idx_order_id ON review_info (order_id);
+CREATE INDEX IF NOT EXISTS review_info_idx_user_id ON review_info (user_id);
+
+CREATE TABLE IF NOT EXISTS review_reply_info (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delete_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 0,
+    reply_id INTEGER NOT NULL DEFAULT 0,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    content VARCHAR(512) NOT NULL,
+    pic_info VARCHAR(1024) NOT NULL DEFAULT '',
+    video_info VARCHAR(1024) NOT NULL DEFAULT '',
+    ext_json VARCHAR(1024) NOT NULL DEFAULT '',
+    ctrl_json VARCHAR(1024) NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS review_reply_info_idx_delete_at ON review_reply_info (delete_at);
+CREATE UNIQUE INDEX IF NOT EXISTS review_reply_info_uk_reply_id ON review_reply_info (reply_id);
+CREATE INDEX IF NOT EXISTS review_reply_info_idx_review_id ON review_reply_info (review_id);
+CREATE INDEX IF NOT EXISTS review_reply_info_idx_store_id ON review_reply_info (store_id);
+
+CREATE TABLE IF NOT EXISTS review_appeal_info (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delete_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 0,
+    appeal_id INTEGER NOT NULL DEFAULT 0,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    status INTEGER NOT NULL DEFAULT 10,
+    reason VARCHAR(255) NOT NULL,
+    content VARCHAR(255) NOT NULL,
+    pic_info VARCHAR(1024) NOT NULL DEFAULT '',
+    video_info VARCHAR(1024) NOT NULL DEFAULT '',
+    op_remarks VARCHAR(512) NOT NULL DEFAULT '',
+    op_user VARCHAR(64) NOT NULL DEFAULT '',
+    ext_json VARCHAR(1024) NOT NULL DEFAULT '',
+    ctrl_json VARCHAR(1024) NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS review_appeal_info_idx_delete_at ON review_appeal_info (delete_at);
+CREATE INDEX IF NOT EXISTS review_appeal_info_idx_appeal_id ON review_appeal_info (appeal_id);
+CREATE UNIQUE INDEX IF NOT EXISTS review_appeal_info_uk_review_id ON review_appeal_info (review_id);
+CREATE INDEX IF NOT EXISTS review_appeal_info_idx_store_id ON review_appeal_info (store_id);
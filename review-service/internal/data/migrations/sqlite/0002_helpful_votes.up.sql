@@ -0,0 +1,14 @@
+-- 评价有用数和投票记录
+ALTER TABLE review_info ADD COLUMN helpful_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS review_vote_info (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    user_id INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_vote_info_uk_review_user ON review_vote_info (review_id,user_id);
+CREATE INDEX IF NOT EXISTS review_vote_info_idx_user_id ON review_vote_info (user_id);
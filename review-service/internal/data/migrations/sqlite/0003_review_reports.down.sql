@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS review_report_info;
+DROP INDEX IF EXISTS review_info_idx_report_count;
+ALTER TABLE review_info DROP COLUMN report_count;
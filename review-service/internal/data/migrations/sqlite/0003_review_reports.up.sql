@@ -0,0 +1,21 @@
+-- 评价举报，待处理举报数达到阈值时评价回到待审核
+ALTER TABLE review_info ADD COLUMN report_count INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS review_info_idx_report_count ON review_info (report_count);
+
+CREATE TABLE IF NOT EXISTS review_report_info (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    report_id INTEGER NOT NULL DEFAULT 0,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    user_id INTEGER NOT NULL DEFAULT 0,
+    reason INTEGER NOT NULL DEFAULT 0,
+    content VARCHAR(255) NOT NULL DEFAULT '',
+    status INTEGER NOT NULL DEFAULT 10
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_report_info_uk_report_id ON review_report_info (report_id);
+CREATE UNIQUE INDEX IF NOT EXISTS review_report_info_uk_review_user ON review_report_info (review_id,user_id);
+CREATE INDEX IF NOT EXISTS review_report_info_idx_store_id ON review_report_info (store_id);
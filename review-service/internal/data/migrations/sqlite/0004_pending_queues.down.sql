@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS review_appeal_info_idx_status_create_at;
+DROP INDEX IF EXISTS review_info_idx_status_create_at;
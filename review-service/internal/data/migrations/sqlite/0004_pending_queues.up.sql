@@ -0,0 +1,3 @@
+-- 运营待审核队列按状态和创建时间取最早的记录
+CREATE INDEX IF NOT EXISTS review_info_idx_status_create_at ON review_info (status,create_at);
+CREATE INDEX IF NOT EXISTS review_appeal_info_idx_status_create_at ON review_appeal_info (status,create_at);
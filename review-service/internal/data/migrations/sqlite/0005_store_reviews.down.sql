@@ -0,0 +1 @@
+DROP INDEX IF EXISTS review_info_idx_store_id_create_at;
@@ -0,0 +1,2 @@
+-- 商家按店铺查评价列表
+CREATE INDEX IF NOT EXISTS review_info_idx_store_id_create_at ON review_info (store_id,create_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_auto_reply_rule;
+DROP TABLE IF EXISTS review_reply_template;
@@ -0,0 +1,32 @@
+-- 商家回复模板和自动回复规则
+CREATE TABLE IF NOT EXISTS review_reply_template (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    template_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    name VARCHAR(64) NOT NULL DEFAULT '',
+    content VARCHAR(512) NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_reply_template_uk_template_id ON review_reply_template (template_id);
+CREATE INDEX IF NOT EXISTS review_reply_template_idx_store_id ON review_reply_template (store_id);
+
+CREATE TABLE IF NOT EXISTS review_auto_reply_rule (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rule_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    template_id INTEGER NOT NULL DEFAULT 0,
+    min_score INTEGER NOT NULL DEFAULT 1,
+    max_score INTEGER NOT NULL DEFAULT 5,
+    only_empty INTEGER NOT NULL DEFAULT 0,
+    delay_seconds INTEGER NOT NULL DEFAULT 0,
+    enabled INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_auto_reply_rule_uk_rule_id ON review_auto_reply_rule (rule_id);
+CREATE INDEX IF NOT EXISTS review_auto_reply_rule_idx_store_id ON review_auto_reply_rule (store_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS review_appeal_message;
+DROP INDEX IF EXISTS review_appeal_info_uk_review_round;
+CREATE UNIQUE INDEX IF NOT EXISTS review_appeal_info_uk_review_id ON review_appeal_info (review_id);
+ALTER TABLE review_appeal_info DROP COLUMN round;
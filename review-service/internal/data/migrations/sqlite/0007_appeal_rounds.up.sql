@@ -0,0 +1,23 @@
+-- 申诉轮次：驳回或撤回后可以再次申诉，唯一键从review_id改为(review_id, round)
+ALTER TABLE review_appeal_info ADD COLUMN round INTEGER NOT NULL DEFAULT 1;
+DROP INDEX IF EXISTS review_appeal_info_uk_review_id;
+CREATE UNIQUE INDEX IF NOT EXISTS review_appeal_info_uk_review_round ON review_appeal_info (review_id,round);
+
+CREATE TABLE IF NOT EXISTS review_appeal_message (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    message_id INTEGER NOT NULL DEFAULT 0,
+    appeal_id INTEGER NOT NULL DEFAULT 0,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    sender_type INTEGER NOT NULL DEFAULT 0,
+    sender VARCHAR(64) NOT NULL DEFAULT '',
+    action INTEGER NOT NULL DEFAULT 0,
+    content VARCHAR(512) NOT NULL DEFAULT '',
+    pic_info VARCHAR(1024) NOT NULL DEFAULT '',
+    video_info VARCHAR(1024) NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_appeal_message_uk_message_id ON review_appeal_message (message_id);
+CREATE INDEX IF NOT EXISTS review_appeal_message_idx_review_id ON review_appeal_message (review_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_webhook_delivery;
+DROP TABLE IF EXISTS review_webhook_subscription;
@@ -0,0 +1,36 @@
+-- webhook订阅和投递记录
+CREATE TABLE IF NOT EXISTS review_webhook_subscription (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    subscription_id INTEGER NOT NULL DEFAULT 0,
+    owner_type INTEGER NOT NULL DEFAULT 0,
+    owner_id INTEGER NOT NULL DEFAULT 0,
+    url VARCHAR(512) NOT NULL DEFAULT '',
+    secret VARCHAR(64) NOT NULL DEFAULT '',
+    events VARCHAR(255) NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_webhook_subscription_uk_subscription_id ON review_webhook_subscription (subscription_id);
+CREATE INDEX IF NOT EXISTS review_webhook_subscription_idx_owner ON review_webhook_subscription (owner_type,owner_id);
+
+CREATE TABLE IF NOT EXISTS review_webhook_delivery (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivery_id INTEGER NOT NULL DEFAULT 0,
+    subscription_id INTEGER NOT NULL DEFAULT 0,
+    event VARCHAR(32) NOT NULL DEFAULT '',
+    payload TEXT NOT NULL,
+    status INTEGER NOT NULL DEFAULT 10,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_retry_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    response_code INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR(255) NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_webhook_delivery_uk_delivery_id ON review_webhook_delivery (delivery_id);
+CREATE INDEX IF NOT EXISTS review_webhook_delivery_idx_subscription_id ON review_webhook_delivery (subscription_id);
+CREATE INDEX IF NOT EXISTS review_webhook_delivery_idx_status_next_retry_at ON review_webhook_delivery (status,next_retry_at);
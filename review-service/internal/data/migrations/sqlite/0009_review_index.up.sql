@@ -0,0 +1,17 @@
+-- 按评价id、订单id、用户id查询时先查索引表拿到店铺id，再到review_info_{store_id%分表数}里查
+CREATE TABLE IF NOT EXISTS review_info_index (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    review_id INTEGER NOT NULL DEFAULT 0,
+    order_id INTEGER NOT NULL DEFAULT 0,
+    user_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_info_index_uk_review_id ON review_info_index (review_id);
+CREATE INDEX IF NOT EXISTS review_info_index_idx_order_id ON review_info_index (order_id);
+CREATE INDEX IF NOT EXISTS review_info_index_idx_user_id ON review_info_index (user_id);
+
+-- 补上索引表建立之前的评价，导入去重和分表后的查询都只看索引表
+INSERT INTO review_info_index (review_id, order_id, user_id, store_id)
+SELECT r.review_id, r.order_id, r.user_id, r.store_id FROM review_info r
+WHERE NOT EXISTS (SELECT 1 FROM review_info_index i WHERE i.review_id = r.review_id);
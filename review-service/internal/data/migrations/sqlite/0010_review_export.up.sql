@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS review_export_task (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_by VARCHAR(48) NOT NULL DEFAULT '',
+    update_by VARCHAR(48) NOT NULL DEFAULT '',
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    export_id INTEGER NOT NULL DEFAULT 0,
+    store_id INTEGER NOT NULL DEFAULT 0,
+    format VARCHAR(8) NOT NULL DEFAULT '',
+    filter VARCHAR(512) NOT NULL DEFAULT '',
+    status INTEGER NOT NULL DEFAULT 10,
+    row_count INTEGER NOT NULL DEFAULT 0,
+    file_key VARCHAR(255) NOT NULL DEFAULT '',
+    file_size INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR(255) NOT NULL DEFAULT '',
+    finish_at TIMESTAMP NULL DEFAULT NULL,
+    expire_at TIMESTAMP NULL DEFAULT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_export_task_uk_export_id ON review_export_task (export_id);
+CREATE INDEX IF NOT EXISTS review_export_task_idx_store_id ON review_export_task (store_id);
+CREATE INDEX IF NOT EXISTS review_export_task_idx_status_update_at ON review_export_task (status,update_at);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS review_import_source (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    create_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    source VARCHAR(32) NOT NULL DEFAULT '',
+    source_id VARCHAR(64) NOT NULL DEFAULT '',
+    review_id INTEGER NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS review_import_source_uk_source_id ON review_import_source (source,source_id);
+CREATE INDEX IF NOT EXISTS review_import_source_idx_review_id ON review_import_source (review_id);
@@ -0,0 +1 @@
+ALTER TABLE review_info DROP COLUMN trace_ctx;
@@ -0,0 +1,3 @@
+-- 写评价的请求链路，canal同步到kafka后review-job用来把ES的span接到原请求上
+-- 以前借用ctrl_json存链路，会覆盖业务数据
+ALTER TABLE review_info ADD COLUMN trace_ctx VARCHAR(512) NOT NULL DEFAULT '';
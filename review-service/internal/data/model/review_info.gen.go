@@ -37,12 +37,15 @@ type ReviewInfo struct {
 	Status         int32      `gorm:"column:status;not null;default:10;comment:状态:10待审核；20审核通过；30审核不通过；40隐藏" json:"status"` // 状态:10待审核；20审核通过；30审核不通过；40隐藏
 	IsDefault      int32      `gorm:"column:is_default;not null;comment:是否默认评价" json:"is_default"`                          // 是否默认评价
 	HasReply       int32      `gorm:"column:has_reply;not null;comment:是否有商家回复:0⽆;1有" json:"has_reply"`                     // 是否有商家回复:0⽆;1有
+	HelpfulCount   int32      `gorm:"column:helpful_count;not null;comment:有用数" json:"helpful_count"`                       // 有用数
+	ReportCount    int32      `gorm:"column:report_count;not null;comment:待处理举报数" json:"report_count"`                      // 待处理举报数
 	OpReason       string     `gorm:"column:op_reason;not null;comment:运营审核拒绝原因" json:"op_reason"`                          // 运营审核拒绝原因
 	OpRemarks      string     `gorm:"column:op_remarks;not null;comment:运营备注" json:"op_remarks"`                            // 运营备注
 	OpUser         string     `gorm:"column:op_user;not null;comment:运营者标识" json:"op_user"`                                 // 运营者标识
 	GoodsSnapshoot string     `gorm:"column:goods_snapshoot;not null;comment:商品快照信息" json:"goods_snapshoot"`                // 商品快照信息
 	ExtJSON        string     `gorm:"column:ext_json;not null;comment:信息扩展" json:"ext_json"`                                // 信息扩展
 	CtrlJSON       string     `gorm:"column:ctrl_json;not null;comment:控制扩展" json:"ctrl_json"`                              // 控制扩展
+	TraceCtx       string     `gorm:"column:trace_ctx;not null;comment:请求链路,W3C trace context" json:"trace_ctx"`            // 请求链路,W3C trace context
 }
 
 // TableName ReviewInfo's table name
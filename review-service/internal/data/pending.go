@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"gorm.io/gen"
+	"review-service/internal/biz"
+	"sort"
+)
+
+// ListPendingReviews 待审核评价以mysql为准，ES同步有延迟不适合做审核队列
+// 指定店铺时只查店铺所在分表，否则逐个分表查询后合并
+func (r *reviewRepo) ListPendingReviews(ctx context.Context, param *biz.PendingParam, offset int32, limit int32) (*biz.PendingReviews, error) {
+	tables := ReviewTableNames(r.data.reviewShards)
+	if param.StoreID > 0 {
+		tables = []string{r.reviewTable(param.StoreID)}
+	}
+	ret := &biz.PendingReviews{}
+	for _, table := range tables {
+		q := r.data.query.ReviewInfo.Table(table)
+		conds := []gen.Condition{q.Status.Eq(10)}
+		if param.StoreID > 0 {
+			conds = append(conds, q.StoreID.Eq(param.StoreID))
+		}
+		switch param.HasMedia {
+		case 1:
+			conds = append(conds, q.HasMedia.Eq(1))
+		case 2:
+			conds = append(conds, q.HasMedia.Eq(0))
+		}
+		if param.MinReportCount > 0 {
+			conds = append(conds, q.ReportCount.Gte(param.MinReportCount))
+		}
+		if !param.CreatedBefore.IsZero() {
+			conds = append(conds, q.CreateAt.Lte(param.CreatedBefore))
+		}
+		do := q.WithContext(ctx).Debug().Where(conds...)
+		total, err := do.Count()
+		if err != nil {
+			return nil, err
+		}
+		if total == 0 {
+			continue
+		}
+		ret.Total += total
+		//每张表取前offset+limit条，第一条就是这张表最早的
+		list, err := do.Order(q.CreateAt, q.ID).Limit(int(offset + limit)).Find()
+		if err != nil {
+			return nil, err
+		}
+		if len(list) > 0 && (ret.OldestCreateAt.IsZero() || list[0].CreateAt.Before(ret.OldestCreateAt)) {
+			ret.OldestCreateAt = list[0].CreateAt
+		}
+		ret.List = append(ret.List, list...)
+	}
+	sort.SliceStable(ret.List, func(i, j int) bool {
+		return ret.List[i].CreateAt.Before(ret.List[j].CreateAt)
+	})
+	ret.List = pageOf(ret.List, offset, limit)
+	return ret, nil
+}
+
+func (r *reviewRepo) ListPendingAppeals(ctx context.Context, param *biz.PendingParam, offset int32, limit int32) (*biz.PendingAppeals, error) {
+	q := r.data.query.ReviewAppealInfo
+	conds := []gen.Condition{q.Status.Eq(10)}
+	if param.StoreID > 0 {
+		conds = append(conds, q.StoreID.Eq(param.StoreID))
+	}
+	if !param.CreatedBefore.IsZero() {
+		conds = append(conds, q.CreateAt.Lte(param.CreatedBefore))
+	}
+	do := q.WithContext(ctx).Debug().Where(conds...)
+	total, err := do.Count()
+	if err != nil {
+		return nil, err
+	}
+	ret := &biz.PendingAppeals{Total: total}
+	if total == 0 {
+		return ret, nil
+	}
+	oldest, err := do.Order(q.CreateAt, q.ID).First()
+	if err != nil {
+		return nil, err
+	}
+	ret.OldestCreateAt = oldest.CreateAt
+	ret.List, err = do.Order(q.CreateAt, q.ID).Offset(int(offset)).Limit(int(limit)).Find()
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
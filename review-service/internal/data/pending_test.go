@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestListPending(t *testing.T) {
+	convey.Convey("pending reviews and appeals are listed oldest first across shards", t, func() {
+		db, err := NewDB(&conf.Data{
+			Database: &conf.Data_Database{
+				Driver:      "sqlite",
+				Source:      filepath.Join(t.TempDir(), "review.db"),
+				AutoMigrate: true,
+			},
+			Sharding: &conf.Data_Sharding{ReviewShards: 4},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		repo := NewReviewRepo(&Data{query: query.Use(db), log: log.NewHelper(log.DefaultLogger), reviewShards: 4}, log.DefaultLogger)
+		ctx := context.Background()
+		start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+		//1-6待审核，分散在各分表，偶数有图，3被举报两次；7已审核通过
+		for i := int64(1); i <= 7; i++ {
+			review := &model.ReviewInfo{ReviewID: i, OrderID: 10 + i, UserID: 21, StoreID: 30 + i, Content: "评价内容", Status: 10, CreateAt: start.Add(time.Duration(i) * time.Hour)}
+			if i%2 == 0 {
+				review.HasMedia = 1
+			}
+			if i == 3 {
+				review.ReportCount = 2
+			}
+			if i == 7 {
+				review.Status = 20
+			}
+			_, err := repo.SaveReview(ctx, review)
+			convey.So(err, convey.ShouldBeNil)
+		}
+		ids := func(list []*model.ReviewInfo) []int64 {
+			ret := make([]int64, 0, len(list))
+			for _, r := range list {
+				ret = append(ret, r.ReviewID)
+			}
+			return ret
+		}
+
+		page, err := repo.ListPendingReviews(ctx, &biz.PendingParam{}, 2, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.Total, convey.ShouldEqual, 6)
+		convey.So(page.OldestCreateAt.Equal(start.Add(time.Hour)), convey.ShouldBeTrue)
+		convey.So(ids(page.List), convey.ShouldResemble, []int64{3, 4})
+
+		page, err = repo.ListPendingReviews(ctx, &biz.PendingParam{HasMedia: 2}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids(page.List), convey.ShouldResemble, []int64{1, 3, 5})
+		page, err = repo.ListPendingReviews(ctx, &biz.PendingParam{MinReportCount: 1}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids(page.List), convey.ShouldResemble, []int64{3})
+		page, err = repo.ListPendingReviews(ctx, &biz.PendingParam{StoreID: 34}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids(page.List), convey.ShouldResemble, []int64{4})
+		//只看等待时间够长的，总数和最早时间按筛选后的记录统计
+		page, err = repo.ListPendingReviews(ctx, &biz.PendingParam{CreatedBefore: start.Add(4 * time.Hour)}, 0, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.Total, convey.ShouldEqual, 4)
+		convey.So(ids(page.List), convey.ShouldResemble, []int64{1})
+		page, err = repo.ListPendingReviews(ctx, &biz.PendingParam{CreatedBefore: start}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.Total, convey.ShouldEqual, 0)
+		convey.So(page.OldestCreateAt.IsZero(), convey.ShouldBeTrue)
+
+		for i := int64(1); i <= 3; i++ {
+			appeal := &model.ReviewAppealInfo{AppealID: 100 + i, ReviewID: i, StoreID: 30 + i, Reason: "原因", Content: "申诉内容", CreateAt: start.Add(time.Duration(4-i) * time.Hour)}
+			if i == 1 {
+				appeal.Status = 20
+			}
+			convey.So(query.Use(db).ReviewAppealInfo.WithContext(ctx).Create(appeal), convey.ShouldBeNil)
+		}
+		appeals, err := repo.ListPendingAppeals(ctx, &biz.PendingParam{}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeals.Total, convey.ShouldEqual, 2)
+		convey.So(appeals.OldestCreateAt.Equal(start.Add(time.Hour)), convey.ShouldBeTrue)
+		convey.So(len(appeals.List), convey.ShouldEqual, 2)
+		convey.So(appeals.List[0].AppealID, convey.ShouldEqual, 103)
+		appeals, err = repo.ListPendingAppeals(ctx, &biz.PendingParam{StoreID: 32, CreatedBefore: start.Add(2 * time.Hour)}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeals.Total, convey.ShouldEqual, 1)
+		convey.So(appeals.List[0].AppealID, convey.ShouldEqual, 102)
+	})
+}
@@ -16,44 +16,94 @@ import (
 )
 
 var (
-	Q                = new(Query)
-	ReviewAppealInfo *reviewAppealInfo
-	ReviewInfo       *reviewInfo
-	ReviewReplyInfo  *reviewReplyInfo
+	Q                         = new(Query)
+	ReviewAppealInfo          *reviewAppealInfo
+	ReviewAppealMessage       *reviewAppealMessage
+	ReviewAutoReplyRule       *reviewAutoReplyRule
+	ReviewExportTask          *reviewExportTask
+	ReviewImportSource        *reviewImportSource
+	ReviewInfo                *reviewInfo
+	ReviewInfoIndex           *reviewInfoIndex
+	ReviewReplyInfo           *reviewReplyInfo
+	ReviewReplyTemplate       *reviewReplyTemplate
+	ReviewReportInfo          *reviewReportInfo
+	ReviewVoteInfo            *reviewVoteInfo
+	ReviewWebhookDelivery     *reviewWebhookDelivery
+	ReviewWebhookSubscription *reviewWebhookSubscription
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	ReviewAppealInfo = &Q.ReviewAppealInfo
+	ReviewAppealMessage = &Q.ReviewAppealMessage
+	ReviewAutoReplyRule = &Q.ReviewAutoReplyRule
+	ReviewExportTask = &Q.ReviewExportTask
+	ReviewImportSource = &Q.ReviewImportSource
 	ReviewInfo = &Q.ReviewInfo
+	ReviewInfoIndex = &Q.ReviewInfoIndex
 	ReviewReplyInfo = &Q.ReviewReplyInfo
+	ReviewReplyTemplate = &Q.ReviewReplyTemplate
+	ReviewReportInfo = &Q.ReviewReportInfo
+	ReviewVoteInfo = &Q.ReviewVoteInfo
+	ReviewWebhookDelivery = &Q.ReviewWebhookDelivery
+	ReviewWebhookSubscription = &Q.ReviewWebhookSubscription
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: newReviewAppealInfo(db, opts...),
-		ReviewInfo:       newReviewInfo(db, opts...),
-		ReviewReplyInfo:  newReviewReplyInfo(db, opts...),
+		db:                        db,
+		ReviewAppealInfo:          newReviewAppealInfo(db, opts...),
+		ReviewAppealMessage:       newReviewAppealMessage(db, opts...),
+		ReviewAutoReplyRule:       newReviewAutoReplyRule(db, opts...),
+		ReviewExportTask:          newReviewExportTask(db, opts...),
+		ReviewImportSource:        newReviewImportSource(db, opts...),
+		ReviewInfo:                newReviewInfo(db, opts...),
+		ReviewInfoIndex:           newReviewInfoIndex(db, opts...),
+		ReviewReplyInfo:           newReviewReplyInfo(db, opts...),
+		ReviewReplyTemplate:       newReviewReplyTemplate(db, opts...),
+		ReviewReportInfo:          newReviewReportInfo(db, opts...),
+		ReviewVoteInfo:            newReviewVoteInfo(db, opts...),
+		ReviewWebhookDelivery:     newReviewWebhookDelivery(db, opts...),
+		ReviewWebhookSubscription: newReviewWebhookSubscription(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ReviewAppealInfo reviewAppealInfo
-	ReviewInfo       reviewInfo
-	ReviewReplyInfo  reviewReplyInfo
+	ReviewAppealInfo          reviewAppealInfo
+	ReviewAppealMessage       reviewAppealMessage
+	ReviewAutoReplyRule       reviewAutoReplyRule
+	ReviewExportTask          reviewExportTask
+	ReviewImportSource        reviewImportSource
+	ReviewInfo                reviewInfo
+	ReviewInfoIndex           reviewInfoIndex
+	ReviewReplyInfo           reviewReplyInfo
+	ReviewReplyTemplate       reviewReplyTemplate
+	ReviewReportInfo          reviewReportInfo
+	ReviewVoteInfo            reviewVoteInfo
+	ReviewWebhookDelivery     reviewWebhookDelivery
+	ReviewWebhookSubscription reviewWebhookSubscription
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.clone(db),
-		ReviewInfo:       q.ReviewInfo.clone(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.clone(db),
+		db:                        db,
+		ReviewAppealInfo:          q.ReviewAppealInfo.clone(db),
+		ReviewAppealMessage:       q.ReviewAppealMessage.clone(db),
+		ReviewAutoReplyRule:       q.ReviewAutoReplyRule.clone(db),
+		ReviewExportTask:          q.ReviewExportTask.clone(db),
+		ReviewImportSource:        q.ReviewImportSource.clone(db),
+		ReviewInfo:                q.ReviewInfo.clone(db),
+		ReviewInfoIndex:           q.ReviewInfoIndex.clone(db),
+		ReviewReplyInfo:           q.ReviewReplyInfo.clone(db),
+		ReviewReplyTemplate:       q.ReviewReplyTemplate.clone(db),
+		ReviewReportInfo:          q.ReviewReportInfo.clone(db),
+		ReviewVoteInfo:            q.ReviewVoteInfo.clone(db),
+		ReviewWebhookDelivery:     q.ReviewWebhookDelivery.clone(db),
+		ReviewWebhookSubscription: q.ReviewWebhookSubscription.clone(db),
 	}
 }
 
@@ -67,24 +117,54 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.replaceDB(db),
-		ReviewInfo:       q.ReviewInfo.replaceDB(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.replaceDB(db),
+		db:                        db,
+		ReviewAppealInfo:          q.ReviewAppealInfo.replaceDB(db),
+		ReviewAppealMessage:       q.ReviewAppealMessage.replaceDB(db),
+		ReviewAutoReplyRule:       q.ReviewAutoReplyRule.replaceDB(db),
+		ReviewExportTask:          q.ReviewExportTask.replaceDB(db),
+		ReviewImportSource:        q.ReviewImportSource.replaceDB(db),
+		ReviewInfo:                q.ReviewInfo.replaceDB(db),
+		ReviewInfoIndex:           q.ReviewInfoIndex.replaceDB(db),
+		ReviewReplyInfo:           q.ReviewReplyInfo.replaceDB(db),
+		ReviewReplyTemplate:       q.ReviewReplyTemplate.replaceDB(db),
+		ReviewReportInfo:          q.ReviewReportInfo.replaceDB(db),
+		ReviewVoteInfo:            q.ReviewVoteInfo.replaceDB(db),
+		ReviewWebhookDelivery:     q.ReviewWebhookDelivery.replaceDB(db),
+		ReviewWebhookSubscription: q.ReviewWebhookSubscription.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ReviewAppealInfo IReviewAppealInfoDo
-	ReviewInfo       IReviewInfoDo
-	ReviewReplyInfo  IReviewReplyInfoDo
+	ReviewAppealInfo          IReviewAppealInfoDo
+	ReviewAppealMessage       IReviewAppealMessageDo
+	ReviewAutoReplyRule       IReviewAutoReplyRuleDo
+	ReviewExportTask          IReviewExportTaskDo
+	ReviewImportSource        IReviewImportSourceDo
+	ReviewInfo                IReviewInfoDo
+	ReviewInfoIndex           IReviewInfoIndexDo
+	ReviewReplyInfo           IReviewReplyInfoDo
+	ReviewReplyTemplate       IReviewReplyTemplateDo
+	ReviewReportInfo          IReviewReportInfoDo
+	ReviewVoteInfo            IReviewVoteInfoDo
+	ReviewWebhookDelivery     IReviewWebhookDeliveryDo
+	ReviewWebhookSubscription IReviewWebhookSubscriptionDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ReviewAppealInfo: q.ReviewAppealInfo.WithContext(ctx),
-		ReviewInfo:       q.ReviewInfo.WithContext(ctx),
-		ReviewReplyInfo:  q.ReviewReplyInfo.WithContext(ctx),
+		ReviewAppealInfo:          q.ReviewAppealInfo.WithContext(ctx),
+		ReviewAppealMessage:       q.ReviewAppealMessage.WithContext(ctx),
+		ReviewAutoReplyRule:       q.ReviewAutoReplyRule.WithContext(ctx),
+		ReviewExportTask:          q.ReviewExportTask.WithContext(ctx),
+		ReviewImportSource:        q.ReviewImportSource.WithContext(ctx),
+		ReviewInfo:                q.ReviewInfo.WithContext(ctx),
+		ReviewInfoIndex:           q.ReviewInfoIndex.WithContext(ctx),
+		ReviewReplyInfo:           q.ReviewReplyInfo.WithContext(ctx),
+		ReviewReplyTemplate:       q.ReviewReplyTemplate.WithContext(ctx),
+		ReviewReportInfo:          q.ReviewReportInfo.WithContext(ctx),
+		ReviewVoteInfo:            q.ReviewVoteInfo.WithContext(ctx),
+		ReviewWebhookDelivery:     q.ReviewWebhookDelivery.WithContext(ctx),
+		ReviewWebhookSubscription: q.ReviewWebhookSubscription.WithContext(ctx),
 	}
 }
 
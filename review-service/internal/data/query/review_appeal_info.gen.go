@@ -37,6 +37,7 @@ func newReviewAppealInfo(db *gorm.DB, opts ...gen.DOOption) reviewAppealInfo {
 	_reviewAppealInfo.AppealID = field.NewInt64(tableName, "appeal_id")
 	_reviewAppealInfo.ReviewID = field.NewInt64(tableName, "review_id")
 	_reviewAppealInfo.StoreID = field.NewInt64(tableName, "store_id")
+	_reviewAppealInfo.Round = field.NewInt32(tableName, "round")
 	_reviewAppealInfo.Status = field.NewInt32(tableName, "status")
 	_reviewAppealInfo.Reason = field.NewString(tableName, "reason")
 	_reviewAppealInfo.Content = field.NewString(tableName, "content")
@@ -67,7 +68,8 @@ type reviewAppealInfo struct {
 	AppealID  field.Int64  // 回复id
 	ReviewID  field.Int64  // 评价id
 	StoreID   field.Int64  // 店铺id
-	Status    field.Int32  // 状态:10待审核；20申诉通过；30申诉驳回
+	Round     field.Int32  // 申诉轮次,驳回或撤回后可以再次申诉
+	Status    field.Int32  // 状态:10待审核；20申诉通过；30申诉驳回；40待补充材料；50已撤回
 	Reason    field.String // 申诉原因类别
 	Content   field.String // 申诉内容描述
 	PicInfo   field.String // 媒体信息：图⽚
@@ -102,6 +104,7 @@ func (r *reviewAppealInfo) updateTableName(table string) *reviewAppealInfo {
 	r.AppealID = field.NewInt64(table, "appeal_id")
 	r.ReviewID = field.NewInt64(table, "review_id")
 	r.StoreID = field.NewInt64(table, "store_id")
+	r.Round = field.NewInt32(table, "round")
 	r.Status = field.NewInt32(table, "status")
 	r.Reason = field.NewString(table, "reason")
 	r.Content = field.NewString(table, "content")
@@ -139,7 +142,7 @@ func (r *reviewAppealInfo) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (r *reviewAppealInfo) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 19)
+	r.fieldMap = make(map[string]field.Expr, 20)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["create_by"] = r.CreateBy
 	r.fieldMap["update_by"] = r.UpdateBy
@@ -150,6 +153,7 @@ func (r *reviewAppealInfo) fillFieldMap() {
 	r.fieldMap["appeal_id"] = r.AppealID
 	r.fieldMap["review_id"] = r.ReviewID
 	r.fieldMap["store_id"] = r.StoreID
+	r.fieldMap["round"] = r.Round
 	r.fieldMap["status"] = r.Status
 	r.fieldMap["reason"] = r.Reason
 	r.fieldMap["content"] = r.Content
@@ -52,12 +52,15 @@ func newReviewInfo(db *gorm.DB, opts ...gen.DOOption) reviewInfo {
 	_reviewInfo.Status = field.NewInt32(tableName, "status")
 	_reviewInfo.IsDefault = field.NewInt32(tableName, "is_default")
 	_reviewInfo.HasReply = field.NewInt32(tableName, "has_reply")
+	_reviewInfo.HelpfulCount = field.NewInt32(tableName, "helpful_count")
+	_reviewInfo.ReportCount = field.NewInt32(tableName, "report_count")
 	_reviewInfo.OpReason = field.NewString(tableName, "op_reason")
 	_reviewInfo.OpRemarks = field.NewString(tableName, "op_remarks")
 	_reviewInfo.OpUser = field.NewString(tableName, "op_user")
 	_reviewInfo.GoodsSnapshoot = field.NewString(tableName, "goods_snapshoot")
 	_reviewInfo.ExtJSON = field.NewString(tableName, "ext_json")
 	_reviewInfo.CtrlJSON = field.NewString(tableName, "ctrl_json")
+	_reviewInfo.TraceCtx = field.NewString(tableName, "trace_ctx")
 
 	_reviewInfo.fillFieldMap()
 
@@ -94,12 +97,15 @@ type reviewInfo struct {
 	Status         field.Int32  // 状态:10待审核；20审核通过；30审核不通过；40隐藏
 	IsDefault      field.Int32  // 是否默认评价
 	HasReply       field.Int32  // 是否有商家回复:0⽆;1有
+	HelpfulCount   field.Int32  // 有用数
+	ReportCount    field.Int32  // 待处理举报数
 	OpReason       field.String // 运营审核拒绝原因
 	OpRemarks      field.String // 运营备注
 	OpUser         field.String // 运营者标识
 	GoodsSnapshoot field.String // 商品快照信息
 	ExtJSON        field.String // 信息扩展
 	CtrlJSON       field.String // 控制扩展
+	TraceCtx       field.String // 请求链路,W3C trace context
 
 	fieldMap map[string]field.Expr
 }
@@ -141,12 +147,15 @@ func (r *reviewInfo) updateTableName(table string) *reviewInfo {
 	r.Status = field.NewInt32(table, "status")
 	r.IsDefault = field.NewInt32(table, "is_default")
 	r.HasReply = field.NewInt32(table, "has_reply")
+	r.HelpfulCount = field.NewInt32(table, "helpful_count")
+	r.ReportCount = field.NewInt32(table, "report_count")
 	r.OpReason = field.NewString(table, "op_reason")
 	r.OpRemarks = field.NewString(table, "op_remarks")
 	r.OpUser = field.NewString(table, "op_user")
 	r.GoodsSnapshoot = field.NewString(table, "goods_snapshoot")
 	r.ExtJSON = field.NewString(table, "ext_json")
 	r.CtrlJSON = field.NewString(table, "ctrl_json")
+	r.TraceCtx = field.NewString(table, "trace_ctx")
 
 	r.fillFieldMap()
 
@@ -173,7 +182,7 @@ func (r *reviewInfo) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *reviewInfo) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 31)
+	r.fieldMap = make(map[string]field.Expr, 34)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["create_by"] = r.CreateBy
 	r.fieldMap["update_by"] = r.UpdateBy
@@ -199,12 +208,15 @@ func (r *reviewInfo) fillFieldMap() {
 	r.fieldMap["status"] = r.Status
 	r.fieldMap["is_default"] = r.IsDefault
 	r.fieldMap["has_reply"] = r.HasReply
+	r.fieldMap["helpful_count"] = r.HelpfulCount
+	r.fieldMap["report_count"] = r.ReportCount
 	r.fieldMap["op_reason"] = r.OpReason
 	r.fieldMap["op_remarks"] = r.OpRemarks
 	r.fieldMap["op_user"] = r.OpUser
 	r.fieldMap["goods_snapshoot"] = r.GoodsSnapshoot
 	r.fieldMap["ext_json"] = r.ExtJSON
 	r.fieldMap["ctrl_json"] = r.CtrlJSON
+	r.fieldMap["trace_ctx"] = r.TraceCtx
 }
 
 func (r reviewInfo) clone(db *gorm.DB) reviewInfo {
@@ -0,0 +1,351 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/operationtype"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"net/http"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"strconv"
+	"time"
+)
+
+const (
+	reconcileLockKey     = "review:reconcile:lock"
+	defaultReconcileSize = 500
+	reconcileScroll      = "2m"
+)
+
+// Reconciler mysql和ES对账，review-job写ES失败时会丢消息，索引会慢慢和review_info不一致
+// 服务内定时执行和cmd/reconcile手动执行共用
+type Reconciler struct {
+	query  *query.Query
+	es     *elasticsearch.TypedClient
+	shards int32
+	log    *log.Helper
+}
+
+func NewReconciler(c *conf.Data, db *gorm.DB, es *elasticsearch.TypedClient, logger log.Logger) *Reconciler {
+	return &Reconciler{
+		query:  query.Use(db),
+		es:     es,
+		shards: c.GetSharding().GetReviewShards(),
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *reviewRepo) ReconcileIndex(ctx context.Context, opt *biz.ReconcileOption) (*biz.ReconcileReport, error) {
+	rc := &Reconciler{query: r.data.query, es: r.data.es, shards: r.data.reviewShards, log: r.log}
+	return rc.Run(ctx, opt)
+}
+
+// LockReconcile 拿到锁时返回token，释放时用token校验是不是自己的锁
+func (r *reviewRepo) LockReconcile(ctx context.Context, lease time.Duration) (string, error) {
+	return r.data.tryLock(ctx, reconcileLockKey, lease)
+}
+
+func (r *reviewRepo) UnlockReconcile(ctx context.Context, token string) {
+	if err := r.data.unlock(ctx, reconcileLockKey, token); err != nil {
+		r.log.WithContext(ctx).Errorf("unlock reconcile failed, err:%v", err)
+	}
+}
+
+// Run 按review_id顺序逐个分表扫描mysql，批量取ES文档比较update_at，找出缺失和过期的文档
+// 再扫一遍ES，mysql里已经没有的评价算多余文档；修复时缺失和过期的重新写入，多余的删除
+func (rc *Reconciler) Run(ctx context.Context, opt *biz.ReconcileOption) (*biz.ReconcileReport, error) {
+	batch := opt.Batch
+	if batch <= 0 {
+		batch = defaultReconcileSize
+	}
+	report := &biz.ReconcileReport{}
+	for _, table := range ReviewTableNames(rc.shards) {
+		if err := rc.scanTable(ctx, table, opt, batch, report); err != nil {
+			return report, err
+		}
+	}
+	if err := rc.scanIndex(ctx, opt, batch, report); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func (rc *Reconciler) scanTable(ctx context.Context, table string, opt *biz.ReconcileOption, batch int, report *biz.ReconcileReport) error {
+	q := rc.query.ReviewInfo.Table(table)
+	after := opt.FromID - 1
+	for {
+		conds := []gen.Condition{q.ReviewID.Gt(after)}
+		if opt.ToID > 0 {
+			conds = append(conds, q.ReviewID.Lt(opt.ToID))
+		}
+		rows, err := q.WithContext(ctx).Where(conds...).Order(q.ReviewID).Limit(batch).Find()
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		after = rows[len(rows)-1].ReviewID
+		report.Scanned += int64(len(rows))
+
+		docs, err := rc.getDocuments(ctx, rows)
+		if err != nil {
+			return err
+		}
+		var repair []*model.ReviewInfo
+		for _, row := range rows {
+			doc, ok := docs[strconv.FormatInt(row.ReviewID, 10)]
+			switch {
+			case !ok:
+				report.AddMissing(row.ReviewID)
+			case doc.UpdateAt != row.UpdateAt.Format(time.DateTime):
+				report.AddStale(row.ReviewID)
+			default:
+				continue
+			}
+			repair = append(repair, row)
+		}
+		if opt.Repair && len(repair) > 0 {
+			n, err := rc.bulk(ctx, repair, nil)
+			if err != nil {
+				return err
+			}
+			report.Repaired += n
+		}
+		if len(rows) < batch {
+			return nil
+		}
+	}
+}
+
+// indexedVersion ES文档里用来判断是否过期的字段，canal同步过来是字符串
+// review_info.version没有在写入时递增，不能用来判断过期；update_at每次修改由mysql自动更新
+// update_at精确到秒，同一秒内的两次修改ES只落后一次时对账发现不了
+type indexedVersion struct {
+	UpdateAt string `json:"update_at"`
+}
+
+// getDocuments 批量取文档的update_at，索引不存在时当作全部缺失
+func (rc *Reconciler) getDocuments(ctx context.Context, rows []*model.ReviewInfo) (map[string]*indexedVersion, error) {
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, strconv.FormatInt(row.ReviewID, 10))
+	}
+	start := time.Now()
+	resp, err := rc.es.Mget().Index("review").Ids(ids...).SourceIncludes_("update_at").Do(ctx)
+	observeES("mget", start, err)
+	if isIndexNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	docs := make(map[string]*indexedVersion, len(resp.Docs))
+	for _, item := range resp.Docs {
+		doc, ok := item.(*types.GetResult)
+		if !ok || !doc.Found {
+			continue
+		}
+		v := &indexedVersion{}
+		if err := json.Unmarshal(doc.Source_, v); err != nil {
+			rc.log.Errorf("unmarshal document %s failed, err:%v", doc.Id_, err)
+		}
+		docs[doc.Id_] = v
+	}
+	return docs, nil
+}
+
+// scanIndex 滚动读出全部文档ID，找出mysql里已经没有的评价
+func (rc *Reconciler) scanIndex(ctx context.Context, opt *biz.ReconcileOption, batch int, report *biz.ReconcileReport) error {
+	start := time.Now()
+	resp, err := rc.es.Search().Index("review").Scroll(reconcileScroll).Size(batch).Source_(false).Do(ctx)
+	observeES("scroll", start, err)
+	if isIndexNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	scrollID := resp.ScrollId_
+	hits := resp.Hits.Hits
+	defer func() {
+		if scrollID != nil {
+			if _, err := rc.es.ClearScroll().ScrollId(*scrollID).Do(context.Background()); err != nil {
+				rc.log.Errorf("clear scroll failed, err:%v", err)
+			}
+		}
+	}()
+	for len(hits) > 0 {
+		ids := make([]int64, 0, len(hits))
+		for _, hit := range hits {
+			id, err := strconv.ParseInt(hit.Id_, 10, 64)
+			if err != nil {
+				rc.log.Errorf("unexpected document id %q", hit.Id_)
+				continue
+			}
+			if id < opt.FromID || (opt.ToID > 0 && id >= opt.ToID) {
+				continue
+			}
+			ids = append(ids, id)
+		}
+		report.Documents += int64(len(ids))
+		orphans, err := rc.orphans(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range orphans {
+			report.AddOrphan(id)
+		}
+		if opt.Repair && len(orphans) > 0 {
+			n, err := rc.bulk(ctx, nil, orphans)
+			if err != nil {
+				return err
+			}
+			report.Repaired += n
+		}
+		if scrollID == nil {
+			return nil
+		}
+		start := time.Now()
+		next, err := rc.es.Scroll().ScrollId(*scrollID).Scroll(reconcileScroll).Do(ctx)
+		observeES("scroll", start, err)
+		if err != nil {
+			return err
+		}
+		scrollID, hits = next.ScrollId_, next.Hits.Hits
+	}
+	return nil
+}
+
+// orphans mysql里找不到的评价ID，分表后查索引表，单表时直接查评价表
+func (rc *Reconciler) orphans(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var exists []int64
+	if rc.shards <= 1 {
+		q := rc.query.ReviewInfo
+		if err := q.WithContext(ctx).Where(q.ReviewID.In(ids...)).Pluck(q.ReviewID, &exists); err != nil {
+			return nil, err
+		}
+	} else {
+		q := rc.query.ReviewInfoIndex
+		if err := q.WithContext(ctx).Where(q.ReviewID.In(ids...)).Pluck(q.ReviewID, &exists); err != nil {
+			return nil, err
+		}
+	}
+	found := make(map[int64]bool, len(exists))
+	for _, id := range exists {
+		found[id] = true
+	}
+	var ret []int64
+	for _, id := range ids {
+		if !found[id] {
+			ret = append(ret, id)
+		}
+	}
+	return ret, nil
+}
+
+// bulk 缺失和过期的评价整条重新写入，多余的文档删除，返回成功的条数
+func (rc *Reconciler) bulk(ctx context.Context, upserts []*model.ReviewInfo, deletes []int64) (int64, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, row := range upserts {
+		id := strconv.FormatInt(row.ReviewID, 10)
+		_ = enc.Encode(map[string]interface{}{"index": map[string]string{"_index": "review", "_id": id}})
+		if err := enc.Encode(esDocument(row)); err != nil {
+			return 0, err
+		}
+	}
+	for _, reviewID := range deletes {
+		id := strconv.FormatInt(reviewID, 10)
+		_ = enc.Encode(map[string]interface{}{"delete": map[string]string{"_index": "review", "_id": id}})
+	}
+	start := time.Now()
+	resp, err := rc.es.Bulk().Raw(&buf).Do(ctx)
+	observeES("bulk", start, err)
+	if err != nil {
+		return 0, err
+	}
+	var ok int64
+	for _, item := range resp.Items {
+		for op, res := range item {
+			if res.Error == nil || (res.Status == http.StatusNotFound && op == operationtype.Delete) {
+				ok++
+				continue
+			}
+			rc.log.Errorf("repair %s %s failed, err:%v", op, res.Id_, errorReason(res.Error))
+		}
+	}
+	return ok, nil
+}
+
+// esDocument 和review-job写入的格式一致：canal把每列都转成字符串，helpful_count转成数值
+func esDocument(m *model.ReviewInfo) map[string]interface{} {
+	itoa := func(n int64) string { return strconv.FormatInt(n, 10) }
+	doc := map[string]interface{}{
+		"id":              itoa(m.ID),
+		"create_by":       m.CreateBy,
+		"update_by":       m.UpdateBy,
+		"create_at":       m.CreateAt.Format(time.DateTime),
+		"update_at":       m.UpdateAt.Format(time.DateTime),
+		"delete_at":       nil,
+		"version":         itoa(int64(m.Version)),
+		"review_id":       itoa(m.ReviewID),
+		"content":         m.Content,
+		"score":           itoa(int64(m.Score)),
+		"service_score":   itoa(int64(m.ServiceScore)),
+		"express_score":   itoa(int64(m.ExpressScore)),
+		"has_media":       itoa(int64(m.HasMedia)),
+		"order_id":        itoa(m.OrderID),
+		"sku_id":          itoa(m.SkuID),
+		"spu_id":          itoa(m.SpuID),
+		"store_id":        itoa(m.StoreID),
+		"user_id":         itoa(m.UserID),
+		"anonymous":       itoa(int64(m.Anonymous)),
+		"tags":            m.Tags,
+		"pic_info":        m.PicInfo,
+		"video_info":      m.VideoInfo,
+		"status":          itoa(int64(m.Status)),
+		"is_default":      itoa(int64(m.IsDefault)),
+		"has_reply":       itoa(int64(m.HasReply)),
+		"helpful_count":   int64(m.HelpfulCount),
+		"report_count":    itoa(int64(m.ReportCount)),
+		"op_reason":       m.OpReason,
+		"op_remarks":      m.OpRemarks,
+		"op_user":         m.OpUser,
+		"goods_snapshoot": m.GoodsSnapshoot,
+		"ext_json":        m.ExtJSON,
+		"ctrl_json":       m.CtrlJSON,
+	}
+	if m.DeleteAt != nil {
+		doc["delete_at"] = m.DeleteAt.Format(time.DateTime)
+	}
+	return doc
+}
+
+func isIndexNotFound(err error) bool {
+	var esErr *types.ElasticsearchError
+	return errors.As(err, &esErr) && esErr.Status == http.StatusNotFound
+}
+
+func errorReason(e *types.ErrorCause) string {
+	if e == nil {
+		return ""
+	}
+	if e.Reason != nil {
+		return fmt.Sprintf("%s: %s", e.Type, *e.Reason)
+	}
+	return e.Type
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestReconcile(t *testing.T) {
+	db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+		Driver:      "sqlite",
+		Source:      filepath.Join(t.TempDir(), "review.db"),
+		AutoMigrate: true,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := query.Use(db)
+	ctx := context.Background()
+	for i := int64(1); i <= 3; i++ {
+		if err := q.ReviewInfo.WithContext(ctx).Create(&model.ReviewInfo{ReviewID: i, OrderID: 10 + i, UserID: 20 + i, StoreID: 31}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rc := NewReconciler(&conf.Data{}, db, nil, log.DefaultLogger)
+
+	convey.Convey("documents without a review are orphans", t, func() {
+		ids, err := rc.orphans(ctx, []int64{1, 3, 4, 7})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids, convey.ShouldResemble, []int64{4, 7})
+	})
+
+	convey.Convey("repaired documents use the canal format", t, func() {
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+		doc := esDocument(&model.ReviewInfo{ReviewID: 9, Version: 2, HelpfulCount: 3, UpdateAt: now, DeleteAt: &now})
+		convey.So(doc["review_id"], convey.ShouldEqual, "9")
+		convey.So(doc["version"], convey.ShouldEqual, "2")
+		convey.So(doc["helpful_count"], convey.ShouldEqual, int64(3))
+		convey.So(doc["update_at"], convey.ShouldEqual, "2024-05-01 12:00:00")
+		convey.So(doc["delete_at"], convey.ShouldEqual, "2024-05-01 12:00:00")
+	})
+
+	convey.Convey("staleness follows update_at, not the version column", t, func() {
+		rows, err := q.ReviewInfo.WithContext(ctx).Order(q.ReviewInfo.ReviewID).Find()
+		convey.So(err, convey.ShouldBeNil)
+		updateAt := rows[0].UpdateAt.Format(time.DateTime)
+		//1和mysql一致但version不同，2的update_at落后，3不在ES里
+		docs := map[string]map[string]interface{}{
+			"1": {"version": "5", "update_at": updateAt},
+			"2": {"version": "0", "update_at": "2000-01-01 00:00:00"},
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				IDs []string `json:"ids"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			var items []map[string]interface{}
+			for _, id := range body.IDs {
+				item := map[string]interface{}{"_index": "review", "_id": id, "found": false}
+				if src, ok := docs[id]; ok {
+					item["found"], item["_source"] = true, src
+				}
+				items = append(items, item)
+			}
+			w.Header().Set("X-Elastic-Product", "Elasticsearch")
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"docs": items})
+		}))
+		defer srv.Close()
+		es, err := elasticsearch.NewTypedClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+		convey.So(err, convey.ShouldBeNil)
+		rc := NewReconciler(&conf.Data{}, db, es, log.DefaultLogger)
+
+		report := &biz.ReconcileReport{}
+		convey.So(rc.scanTable(ctx, "review_info", &biz.ReconcileOption{}, 10, report), convey.ShouldBeNil)
+		convey.So(report.Scanned, convey.ShouldEqual, 3)
+		convey.So(report.StaleIDs, convey.ShouldResemble, []int64{2})
+		convey.So(report.MissingIDs, convey.ShouldResemble, []int64{3})
+	})
+}
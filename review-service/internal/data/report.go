@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"sort"
+)
+
+// SaveReport 保存举报并累加评价的待处理举报数
+// 举报数达到阈值且评价已审核通过时，评价回到待审核状态，返回true
+func (r *reviewRepo) SaveReport(ctx context.Context, report *model.ReviewReportInfo, threshold int32) (bool, error) {
+	//重复举报校验走主库
+	ctx = biz.WithPrimary(ctx)
+	_, err := r.data.query.ReviewReportInfo.WithContext(ctx).
+		Where(r.data.query.ReviewReportInfo.ReviewID.Eq(report.ReviewID), r.data.query.ReviewReportInfo.UserID.Eq(report.UserID)).
+		First()
+	if err == nil {
+		return false, pb.ErrorReviewReported("已经举报过该评价")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	table, err := r.locateReview(ctx, report.ReviewID)
+	if err != nil {
+		return false, err
+	}
+	requeued := false
+	err = r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewReportInfo.WithContext(ctx).Debug().Create(report); err != nil {
+			return err
+		}
+		t := tx.ReviewInfo.Table(table)
+		if _, err := t.WithContext(ctx).Debug().
+			Where(t.ReviewID.Eq(report.ReviewID)).
+			UpdateSimple(t.ReportCount.Add(1)); err != nil {
+			return err
+		}
+		//累加后的举报数达到阈值，已通过的评价撤回重新审核
+		info, err := t.WithContext(ctx).Debug().
+			Where(t.ReviewID.Eq(report.ReviewID),
+				t.Status.Eq(20),
+				t.ReportCount.Gte(threshold)).
+			Updates(map[string]interface{}{
+				"status":     10,
+				"op_remarks": "举报数达到阈值，重新审核",
+			})
+		if err != nil {
+			return err
+		}
+		requeued = info.RowsAffected > 0
+		return nil
+	})
+	if err == nil {
+		r.evictReviewCache(ctx, report.ReviewID, 0)
+	}
+	return requeued, err
+}
+
+// ListReportedReviews 按待处理举报数倒序查询被举报的评价，并按原因聚合举报数
+func (r *reviewRepo) ListReportedReviews(ctx context.Context, offset int32, limit int32) ([]*biz.ReportedReview, error) {
+	//分表时每张表取前offset+limit条，合并排序后再分页
+	var reviews []*model.ReviewInfo
+	for _, table := range ReviewTableNames(r.data.reviewShards) {
+		q := r.data.query.ReviewInfo.Table(table)
+		list, err := q.WithContext(ctx).Debug().
+			Where(q.ReportCount.Gt(0)).
+			Order(q.ReportCount.Desc(), q.ReviewID.Desc()).
+			Limit(int(offset + limit)).
+			Find()
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, list...)
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		if reviews[i].ReportCount != reviews[j].ReportCount {
+			return reviews[i].ReportCount > reviews[j].ReportCount
+		}
+		return reviews[i].ReviewID > reviews[j].ReviewID
+	})
+	reviews = pageOf(reviews, offset, limit)
+	if len(reviews) == 0 {
+		return []*biz.ReportedReview{}, nil
+	}
+	ids := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		ids = append(ids, review.ReviewID)
+	}
+	var counts []struct {
+		ReviewID int64
+		Reason   int32
+		Count    int64
+	}
+	q := r.data.query.ReviewReportInfo
+	err := q.WithContext(ctx).Debug().
+		Select(q.ReviewID, q.Reason, q.ID.Count().As("count")).
+		Where(q.ReviewID.In(ids...), q.Status.Eq(10)).
+		Group(q.ReviewID, q.Reason).
+		Scan(&counts)
+	if err != nil {
+		return nil, err
+	}
+	reasons := make(map[int64]map[int32]int64, len(reviews))
+	for _, c := range counts {
+		if reasons[c.ReviewID] == nil {
+			reasons[c.ReviewID] = make(map[int32]int64)
+		}
+		reasons[c.ReviewID][c.Reason] = c.Count
+	}
+	list := make([]*biz.ReportedReview, 0, len(reviews))
+	for _, review := range reviews {
+		list = append(list, &biz.ReportedReview{ReviewInfo: review, Reasons: reasons[review.ReviewID]})
+	}
+	return list, nil
+}
@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	pb "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+)
+
+func TestSaveReport(t *testing.T) {
+	convey.Convey("reports over the threshold send an approved review back to audit", t, func() {
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		repo := NewReviewRepo(&Data{
+			query: query.Use(db),
+			log:   log.NewHelper(log.DefaultLogger),
+			rdb:   redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		}, log.DefaultLogger)
+		ctx := context.Background()
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 1, OrderID: 101, UserID: 10, StoreID: 20, Content: "评价内容", Score: 1, Status: 20})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 2, OrderID: 102, UserID: 10, StoreID: 20, Content: "评价内容", Score: 1, Status: 30})
+		convey.So(err, convey.ShouldBeNil)
+		report := func(reportID, reviewID, userID int64, reason int32) (bool, error) {
+			return repo.SaveReport(ctx, &model.ReviewReportInfo{ReportID: reportID, ReviewID: reviewID, StoreID: 20, UserID: userID, Reason: reason, Status: 10}, 2)
+		}
+
+		requeued, err := report(1, 1, 30, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(requeued, convey.ShouldBeFalse)
+		//同一用户重复举报不计数
+		_, err = report(2, 1, 30, 1)
+		convey.So(pb.IsReviewReported(err), convey.ShouldBeTrue)
+		review, err := repo.GetReviewByReviewID(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.ReportCount, convey.ShouldEqual, 1)
+		convey.So(review.Status, convey.ShouldEqual, 20)
+
+		//达到阈值后回到待审核，之后的举报不再重复撤回
+		requeued, err = report(3, 1, 31, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(requeued, convey.ShouldBeTrue)
+		review, err = repo.GetReviewByReviewID(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.ReportCount, convey.ShouldEqual, 2)
+		convey.So(review.Status, convey.ShouldEqual, 10)
+		convey.So(review.OpRemarks, convey.ShouldEqual, "举报数达到阈值，重新审核")
+		requeued, err = report(4, 1, 32, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(requeued, convey.ShouldBeFalse)
+
+		//没有通过审核的评价只累加举报数
+		for i := int64(0); i < 2; i++ {
+			requeued, err = report(5+i, 2, 30+i, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(requeued, convey.ShouldBeFalse)
+		}
+		review, err = repo.GetReviewByReviewID(ctx, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.ReportCount, convey.ShouldEqual, 2)
+		convey.So(review.Status, convey.ShouldEqual, 30)
+
+		list, err := repo.ListReportedReviews(ctx, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(list), convey.ShouldEqual, 2)
+		convey.So(list[0].ReviewID, convey.ShouldEqual, 1)
+		convey.So(list[0].Reasons, convey.ShouldResemble, map[int32]int64{1: 1, 2: 2})
+		convey.So(list[1].Reasons, convey.ShouldResemble, map[int32]int64{1: 2})
+	})
+}
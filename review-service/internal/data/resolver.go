@@ -0,0 +1,44 @@
+package data
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+)
+
+// useReplicas 配置了从库时注册dbresolver做读写分离
+// 查询默认走从库，写操作和事务内的查询由dbresolver自动走主库
+func useReplicas(db *gorm.DB, cfg *conf.Data_Database) error {
+	if len(cfg.GetReplicas()) == 0 {
+		return nil
+	}
+	replicas := make([]gorm.Dialector, 0, len(cfg.GetReplicas()))
+	for _, source := range cfg.GetReplicas() {
+		dialector, err := openDialector(cfg.GetDriver(), source)
+		if err != nil {
+			return err
+		}
+		replicas = append(replicas, dialector)
+	}
+	err := db.Use(dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	}))
+	if err != nil {
+		return err
+	}
+	//context里标记了主库的查询强制走主库，在dbresolver选完从库之后改回主库
+	err = db.Callback().Query().After("gorm:db_resolver").Before("gorm:query").Register("review:primary", pinPrimary)
+	if err != nil {
+		return err
+	}
+	return db.Callback().Row().After("gorm:db_resolver").Before("gorm:row").Register("review:primary", pinPrimary)
+}
+
+// pinPrimary Write.ModifyStatement会重新执行dbresolver的选库逻辑
+func pinPrimary(db *gorm.DB) {
+	if db.Statement.Context != nil && biz.UsePrimary(db.Statement.Context) {
+		dbresolver.Write.ModifyStatement(db.Statement)
+	}
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"context"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"testing"
+)
+
+func TestReadReplicas(t *testing.T) {
+	convey.Convey("reads go to replica unless pinned to primary", t, func() {
+		dir := t.TempDir()
+		primary := filepath.Join(dir, "primary.db")
+		replica := filepath.Join(dir, "replica.db")
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:   "sqlite",
+			Source:   primary,
+			Replicas: []string{replica},
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		//两个库建同名表，主库写一条，从库没有
+		for _, source := range []string{primary, replica} {
+			d, err := NewDB(&conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Source: source}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(d.Exec("CREATE TABLE t (id INTEGER)").Error, convey.ShouldBeNil)
+		}
+		convey.So(db.Exec("INSERT INTO t (id) VALUES (1)").Error, convey.ShouldBeNil)
+
+		var n int64
+		convey.So(db.WithContext(context.Background()).Table("t").Count(&n).Error, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 0)
+		convey.So(db.WithContext(biz.WithPrimary(context.Background())).Table("t").Count(&n).Error, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 1)
+	})
+}
@@ -6,13 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/fieldtype"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gen"
 	"gorm.io/gorm"
 	pb "review-service/api/review/v1"
 	"review-service/internal/biz"
 	"review-service/internal/data/model"
 	"review-service/internal/data/query"
-	"review-service/pkg/snowflake"
+	"sort"
+	"time"
 )
 
 type reviewRepo struct {
@@ -29,45 +33,208 @@ func NewReviewRepo(data *Data, logger log.Logger) biz.ReviewRepo {
 }
 
 func (r *reviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
-	err := r.data.query.ReviewInfo.WithContext(ctx).Save(review)
+	table := r.reviewTable(review.StoreID)
+	review.TraceCtx = traceContext(ctx)
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewInfo.Table(table).WithContext(ctx).Save(review); err != nil {
+			return err
+		}
+		return saveReviewIndex(ctx, tx, review)
+	})
+	if err == nil {
+		//订单之前可能缓存了没有评价的空值
+		r.evictReviewCache(ctx, review.ReviewID, review.OrderID)
+	}
 	return review, err
 }
 
 func (r *reviewRepo) GetReviewByOrderID(ctx context.Context, OrderID int64) ([]*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.WithContext(ctx).Debug().
-		Where(r.data.query.ReviewInfo.OrderID.Eq(OrderID)).Find()
+	if r.data.reviewShards <= 1 {
+		return r.data.query.ReviewInfo.WithContext(ctx).Debug().
+			Where(r.data.query.ReviewInfo.OrderID.Eq(OrderID)).Find()
+	}
+	idx, err := r.data.query.ReviewInfoIndex.WithContext(ctx).Debug().
+		Where(r.data.query.ReviewInfoIndex.OrderID.Eq(OrderID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	return r.findIndexed(ctx, idx)
 }
 
 func (r *reviewRepo) GetReviewByReviewID(ctx context.Context, ReviewID int64) (*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.WithContext(ctx).Debug().Where(r.data.query.ReviewInfo.ReviewID.Eq(ReviewID)).First()
+	table, err := r.locateReview(ctx, ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	q := r.data.query.ReviewInfo.Table(table)
+	return q.WithContext(ctx).Debug().Where(q.ReviewID.Eq(ReviewID)).First()
+}
+
+// GetReviewDetail 评价和商家回复，has_reply为1时才查回复表
+func (r *reviewRepo) GetReviewDetail(ctx context.Context, reviewID int64) (*biz.ReviewDetail, error) {
+	review, err := r.GetReviewByReviewID(ctx, reviewID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pb.ErrorNotFound("没有这个评价")
+	}
+	if err != nil {
+		return nil, err
+	}
+	detail := &biz.ReviewDetail{Review: review}
+	if review.HasReply != 1 {
+		return detail, nil
+	}
+	q := r.data.query.ReviewReplyInfo
+	reply, err := q.WithContext(ctx).Debug().
+		Where(q.ReviewID.Eq(reviewID), q.DeleteAt.IsNull()).
+		Order(q.ID.Desc()).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return detail, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	detail.Reply = reply
+	return detail, nil
 }
 
 func (r *reviewRepo) GetReviewByUserID(ctx context.Context, id int64) ([]*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.WithContext(ctx).Debug().
-		Where(r.data.query.ReviewInfo.UserID.Eq(id)).Find()
+	if r.data.reviewShards <= 1 {
+		return r.data.query.ReviewInfo.WithContext(ctx).Debug().
+			Where(r.data.query.ReviewInfo.UserID.Eq(id)).Find()
+	}
+	idx, err := r.data.query.ReviewInfoIndex.WithContext(ctx).Debug().
+		Where(r.data.query.ReviewInfoIndex.UserID.Eq(id)).Find()
+	if err != nil {
+		return nil, err
+	}
+	return r.findIndexed(ctx, idx)
+}
+
+// ListUserReviews 走user_id索引；分表后先从索引表取用户的评价ID，再按分表带条件查询后合并分页
+// 每个分表只取排序后的前offset+limit条，总数按分表count相加
+func (r *reviewRepo) ListUserReviews(ctx context.Context, param *biz.UserReviewParam, offset int32, limit int32) ([]*model.ReviewInfo, int64, error) {
+	ids := map[string][]int64{model.TableNameReviewInfo: nil}
+	if r.data.reviewShards > 1 {
+		idx, err := r.data.query.ReviewInfoIndex.WithContext(ctx).Debug().
+			Where(r.data.query.ReviewInfoIndex.UserID.Eq(param.UserID)).Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		ids = make(map[string][]int64)
+		for _, i := range idx {
+			table := r.reviewTable(i.StoreID)
+			ids[table] = append(ids[table], i.ReviewID)
+		}
+	}
+	list := []*model.ReviewInfo{}
+	var total int64
+	for table, reviewIDs := range ids {
+		q := r.data.query.ReviewInfo.Table(table)
+		conds := []gen.Condition{q.UserID.Eq(param.UserID), q.DeleteAt.IsNull()}
+		if param.Status > 0 {
+			conds = append(conds, q.Status.Eq(param.Status))
+		}
+		switch param.HasReply {
+		case 1:
+			conds = append(conds, q.HasReply.Eq(1))
+		case 2:
+			conds = append(conds, q.HasReply.Eq(0))
+		}
+		if !param.StartTime.IsZero() {
+			conds = append(conds, q.CreateAt.Gte(param.StartTime))
+		}
+		if !param.EndTime.IsZero() {
+			conds = append(conds, q.CreateAt.Lt(param.EndTime))
+		}
+		if r.data.reviewShards <= 1 {
+			return q.WithContext(ctx).Debug().Where(conds...).Order(q.CreateAt.Desc(), q.ID.Desc()).
+				FindByPage(int(offset), int(limit))
+		}
+		//各分表的自增ID不可比，同一时间的按review_id排序
+		do := q.WithContext(ctx).Debug().Where(conds...).Where(q.ReviewID.In(reviewIDs...))
+		n, err := do.Count()
+		if err != nil {
+			return nil, 0, err
+		}
+		total += n
+		if n == 0 {
+			continue
+		}
+		reviews, err := do.Order(q.CreateAt.Desc(), q.ReviewID.Desc()).Limit(int(offset + limit)).Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, reviews...)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].CreateAt.Equal(list[j].CreateAt) {
+			return list[i].CreateAt.After(list[j].CreateAt)
+		}
+		return list[i].ReviewID > list[j].ReviewID
+	})
+	return pageOf(list, offset, limit), total, nil
 }
 
 func (r *reviewRepo) UpdateReview(ctx context.Context, updatereview *model.ReviewInfo) (int64, error) {
-	info, err := r.data.query.ReviewInfo.WithContext(ctx).Debug().
-		Where(r.data.query.ReviewInfo.ReviewID.Eq(updatereview.ReviewID)).Updates(updatereview)
+	//写之前的查询走主库，从库可能还没同步到
+	ctx = biz.WithPrimary(ctx)
+	table, err := r.locateReview(ctx, updatereview.ReviewID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	//Updates只更新非零值，没有链路时保留原来的trace_ctx
+	updatereview.TraceCtx = traceContext(ctx)
+	q := r.data.query.ReviewInfo.Table(table)
+	info, err := q.WithContext(ctx).Debug().
+		Where(q.ReviewID.Eq(updatereview.ReviewID)).Updates(updatereview)
+	if err == nil {
+		r.evictReviewCache(ctx, updatereview.ReviewID, 0)
+	}
 	return info.RowsAffected, err
 }
 
 func (r *reviewRepo) DeleteReview(ctx context.Context, deletereview *model.ReviewInfo) (int64, error) {
-	info, err := r.data.query.ReviewInfo.WithContext(ctx).Debug().
-		Where(r.data.query.ReviewInfo.ReviewID.Eq(deletereview.ReviewID)).Delete()
-	return info.RowsAffected, err
+	ctx = biz.WithPrimary(ctx)
+	table, err := r.locateReview(ctx, deletereview.ReviewID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var rows int64
+	err = r.data.query.Transaction(func(tx *query.Query) error {
+		q := tx.ReviewInfo.Table(table)
+		info, err := q.WithContext(ctx).Debug().
+			Where(q.ReviewID.Eq(deletereview.ReviewID)).Delete()
+		if err != nil {
+			return err
+		}
+		rows = info.RowsAffected
+		_, err = tx.ReviewInfoIndex.WithContext(ctx).Debug().
+			Where(tx.ReviewInfoIndex.ReviewID.Eq(deletereview.ReviewID)).Delete()
+		return err
+	})
+	if err == nil {
+		r.evictReviewCache(ctx, deletereview.ReviewID, deletereview.OrderID)
+	}
+	return rows, err
 }
 
 func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error) {
 	//1.数据校验
 	//1.1数据合法性(已回复的不能再回复)
-	//查评价id，看是否已回复
-	review, err := r.data.query.ReviewInfo.WithContext(ctx).
-		Where(r.data.query.ReviewInfo.ReviewID.Eq(reply.ReviewID)).First()
+	//查评价id，看是否已回复，走主库防止从库延迟导致重复回复
+	ctx = biz.WithPrimary(ctx)
+	review, err := r.GetReviewByReviewID(ctx, reply.ReviewID)
 	if err != nil {
 		return nil, err
 	}
+	table := r.reviewTable(review.StoreID)
 	if review.HasReply == 1 {
 		return nil, pb.ErrorReviewReplied("该评价已回复")
 	}
@@ -85,13 +252,17 @@ func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo
 			return err
 		}
 		//评价表hasreply字段更新
-		if _, err = tx.ReviewInfo.WithContext(ctx).Debug().
-			Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID)).Update(tx.ReviewInfo.HasReply, 1); err != nil {
+		t := tx.ReviewInfo.Table(table)
+		if _, err = t.WithContext(ctx).Debug().
+			Where(t.ReviewID.Eq(reply.ReviewID)).Update(t.HasReply, 1); err != nil {
 			r.log.WithContext(ctx).Errorf("savereply update review failed ,err:%v\n", err)
 			return err
 		}
 		return nil
 	})
+	if err == nil {
+		r.evictReviewCache(ctx, reply.ReviewID, 0)
+	}
 	//3.返回
 	return reply, err
 }
@@ -120,45 +291,70 @@ func (r *reviewRepo) UpdateReply(ctx context.Context, reply *model.ReviewReplyIn
 	return info.RowsAffected, err
 }
 
-func (r *reviewRepo) SaveAppeal(ctx context.Context, appeal *model.ReviewAppealInfo) (int64, error) {
-	appeals, err := r.data.query.ReviewAppealInfo.WithContext(ctx).
-		Where(r.data.query.ReviewAppealInfo.ReviewID.Eq(appeal.ReviewID)).First()
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return 0, err
-	}
-	if err == nil && appeals.Status > 10 {
-		return 0, pb.ErrorAppealAudited("该评价已有审核过的申诉记录")
-	}
-	//if appeals != nil {
-	//	return 0, pb.ErrorReviewAppealed("该review正在申诉，不能重复发起申诉")
-	//}
-	if appeals == nil {
-		//1.没有申诉记录则创建
-		appeal.AppealID = snowflake.GenID()
-	} else {
-		//2.有申诉记录但待审核，需要更新
-		//只要更新申诉内容,appeal_id复用查询到的appeal记录的appeal_id
-		//storeID,reviewID已经保证一致(若已存在),只可能更新原因、内容等字段...
-		appeal.AppealID = appeals.AppealID
-	}
-	//.save方法根据主键更新或新建
-	err = r.data.query.ReviewAppealInfo.WithContext(ctx).Debug().Save(appeal)
+// SaveAppeal 新建一轮申诉，并记一条提交记录
+// uk_review_round兜底并发重复提交
+func (r *reviewRepo) SaveAppeal(ctx context.Context, appeal *model.ReviewAppealInfo, msg *model.ReviewAppealMessage) (int64, error) {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewAppealInfo.WithContext(ctx).Debug().Create(appeal); err != nil {
+			return err
+		}
+		return tx.ReviewAppealMessage.WithContext(ctx).Debug().Create(msg)
+	})
 	return appeal.AppealID, err
 }
 
+// GetLatestAppeal 查询评价最近一轮申诉，没有申诉时返回nil
+func (r *reviewRepo) GetLatestAppeal(ctx context.Context, reviewID int64) (*model.ReviewAppealInfo, error) {
+	q := r.data.query.ReviewAppealInfo
+	appeal, err := q.WithContext(ctx).Debug().
+		Where(q.ReviewID.Eq(reviewID)).
+		Order(q.Round.Desc()).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return appeal, err
+}
+
 func (r *reviewRepo) AuditReview(ctx context.Context, audit *model.ReviewInfo) error {
-	review_info, err := r.data.query.ReviewInfo.WithContext(ctx).Debug().
-		Where(r.data.query.ReviewInfo.ReviewID.Eq(audit.ReviewID)).First()
+	ctx = biz.WithPrimary(ctx)
+	review_info, err := r.GetReviewByReviewID(ctx, audit.ReviewID)
 	if review_info == nil {
 		//没有这个申诉
 		return pb.ErrorDbFailed("没有这个评价ID！")
 	}
-	_, err = r.data.query.ReviewInfo.WithContext(ctx).Debug().
-		Where(r.data.query.ReviewInfo.ReviewID.Eq(audit.ReviewID)).Updates(audit)
+	table := r.reviewTable(review_info.StoreID)
+	audit.TraceCtx = traceContext(ctx)
+	if review_info.ReportCount == 0 {
+		q := r.data.query.ReviewInfo.Table(table)
+		_, err = q.WithContext(ctx).Debug().
+			Where(q.ReviewID.Eq(audit.ReviewID)).Updates(audit)
+	} else {
+		//被举报的评价审核后，待处理的举报一并结案，举报数清零重新累计
+		err = r.data.query.Transaction(func(tx *query.Query) error {
+			t := tx.ReviewInfo.Table(table)
+			if _, err := t.WithContext(ctx).Debug().
+				Where(t.ReviewID.Eq(audit.ReviewID)).Updates(audit); err != nil {
+				return err
+			}
+			if _, err := t.WithContext(ctx).Debug().
+				Where(t.ReviewID.Eq(audit.ReviewID)).Update(t.ReportCount, 0); err != nil {
+				return err
+			}
+			_, err := tx.ReviewReportInfo.WithContext(ctx).Debug().
+				Where(tx.ReviewReportInfo.ReviewID.Eq(audit.ReviewID), tx.ReviewReportInfo.Status.Eq(10)).
+				Update(tx.ReviewReportInfo.Status, 20)
+			return err
+		})
+	}
+	if err == nil {
+		r.evictReviewCache(ctx, audit.ReviewID, 0)
+	}
 	return err
 }
 
-func (r *reviewRepo) AuditAppeal(ctx context.Context, audit *model.ReviewAppealInfo) error {
+// AuditAppeal 审核处理中的申诉，审核意见记入沟通记录
+func (r *reviewRepo) AuditAppeal(ctx context.Context, audit *model.ReviewAppealInfo, msg *model.ReviewAppealMessage) error {
 	appeal_info, err := r.data.query.ReviewAppealInfo.WithContext(ctx).Debug().
 		Where(r.data.query.ReviewAppealInfo.AppealID.Eq(audit.AppealID)).First()
 	if appeal_info == nil {
@@ -166,31 +362,127 @@ func (r *reviewRepo) AuditAppeal(ctx context.Context, audit *model.ReviewAppealI
 		return pb.ErrorDbFailed("没有这个申诉ID！")
 	}
 	audit.ReviewID = appeal_info.ReviewID
+	audit.StoreID = appeal_info.StoreID
+	msg.ReviewID = appeal_info.ReviewID
 	err = r.data.query.Transaction(func(tx *query.Query) error {
-		if _, err := tx.ReviewAppealInfo.WithContext(ctx).Debug().
-			Where(r.data.query.ReviewAppealInfo.AppealID.Eq(audit.AppealID)).
+		//只有处理中的申诉能审核，条件更新防止并发重复审核
+		info, err := tx.ReviewAppealInfo.WithContext(ctx).Debug().
+			Where(tx.ReviewAppealInfo.AppealID.Eq(audit.AppealID), tx.ReviewAppealInfo.Status.In(biz.AppealActive...)).
 			Updates(map[string]interface{}{
-				"status":  audit.Status,
-				"op_user": audit.OpUser,
-				"reason":  audit.Reason,
-			}); err != nil {
+				"status":     audit.Status,
+				"op_user":    audit.OpUser,
+				"op_remarks": audit.OpRemarks,
+			})
+		if err != nil {
 			return err
 		}
-		if audit.Status == 20 { //申诉通过则需要隐藏评价
-			if _, err := tx.ReviewInfo.WithContext(ctx).Where(tx.ReviewInfo.ReviewID.Eq(audit.ReviewID)).
-				Update(tx.ReviewInfo.Status, 40); err != nil {
+		if info.RowsAffected == 0 {
+			return pb.ErrorAppealAudited("申诉已处理")
+		}
+		if err := tx.ReviewAppealMessage.WithContext(ctx).Debug().Create(msg); err != nil {
+			return err
+		}
+		if audit.Status == biz.AppealApproved { //申诉通过则需要隐藏评价
+			t := tx.ReviewInfo.Table(r.reviewTable(appeal_info.StoreID))
+			if _, err := t.WithContext(ctx).Where(t.ReviewID.Eq(audit.ReviewID)).
+				Update(t.Status, 40); err != nil {
 				return err
 			}
 		}
 		return nil
 	})
+	if err == nil && audit.Status == biz.AppealApproved {
+		r.evictReviewCache(ctx, audit.ReviewID, 0)
+	}
 	return err
 }
 
-// 根据storeID分页查询评价
-func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, id int64, offset int32, limit int32) ([]*biz.MyReviewInfo, error) {
+// TransitAppeal 申诉状态从from中的某个状态流转到to，并记一条沟通记录
+func (r *reviewRepo) TransitAppeal(ctx context.Context, appealID int64, from []int32, to int32, msg *model.ReviewAppealMessage) error {
+	return r.data.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.ReviewAppealInfo.WithContext(ctx).Debug().
+			Where(tx.ReviewAppealInfo.AppealID.Eq(appealID), tx.ReviewAppealInfo.Status.In(from...)).
+			Update(tx.ReviewAppealInfo.Status, to)
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return pb.ErrorAppealAudited("申诉已处理")
+		}
+		return tx.ReviewAppealMessage.WithContext(ctx).Debug().Create(msg)
+	})
+}
+
+// GetAppealHistory 按轮次查询评价的全部申诉和沟通记录
+func (r *reviewRepo) GetAppealHistory(ctx context.Context, reviewID int64) (*biz.AppealHistory, error) {
+	appeals, err := r.data.query.ReviewAppealInfo.WithContext(ctx).Debug().
+		Where(r.data.query.ReviewAppealInfo.ReviewID.Eq(reviewID)).
+		Order(r.data.query.ReviewAppealInfo.Round).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	messages, err := r.data.query.ReviewAppealMessage.WithContext(ctx).Debug().
+		Where(r.data.query.ReviewAppealMessage.ReviewID.Eq(reviewID)).
+		Order(r.data.query.ReviewAppealMessage.ID).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	return &biz.AppealHistory{Appeals: appeals, Messages: messages}, nil
+}
+
+// esSortField ES排序字段，canal同步过来的字段是字符串，按.keyword排序
+// helpful_count由review-job转成数值写入
+type esSortField struct {
+	field    string
+	unmapped fieldtype.FieldType
+}
+
+var reviewSorts = map[string][]esSortField{
+	biz.SortHelpful: {{"helpful_count", fieldtype.Long}, {"create_at.keyword", fieldtype.Keyword}},
+	biz.SortNewest:  {{"create_at.keyword", fieldtype.Keyword}},
+	biz.SortScore:   {{"score.keyword", fieldtype.Keyword}, {"create_at.keyword", fieldtype.Keyword}},
+}
+
+func esSort(sort string) []types.SortCombinations {
+	fields := reviewSorts[sort]
+	ret := make([]types.SortCombinations, 0, len(fields))
+	for _, f := range fields {
+		unmapped := f.unmapped
+		ret = append(ret, types.SortOptions{SortOptions: map[string]types.FieldSort{
+			f.field: {Order: &sortorder.Desc, UnmappedType: &unmapped},
+		}})
+	}
+	return ret
+}
+
+// 根据storeID分页查询评价，ES不可用时按配置降级查mysql
+func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, param *biz.ListReviewParam, offset int32, limit int32) (*biz.ReviewList, error) {
+	return r.searchOrFallback(ctx, "ListReviewByStoreID", param.Cursor,
+		func() ([]*biz.MyReviewInfo, error) {
+			return r.searchByStoreID(ctx, param.StoreID, param.Sort, offset, limit)
+		},
+		func() (*biz.ReviewList, error) {
+			return r.listStoreReviewsFromDB(ctx, param, offset, limit)
+		})
+}
+
+func (r *reviewRepo) ListReviewByContent(ctx context.Context, param *biz.ListReviewParam, offset int32, limit int32) (*biz.ReviewList, error) {
+	return r.searchOrFallback(ctx, "ListReviewByContent", param.Cursor,
+		func() ([]*biz.MyReviewInfo, error) {
+			return r.searchByContent(ctx, offset, limit)
+		},
+		func() (*biz.ReviewList, error) {
+			return r.listContentReviewsFromDB(ctx, param, offset, limit)
+		})
+}
+
+func (r *reviewRepo) searchByStoreID(ctx context.Context, id int64, sort string, offset int32, limit int32) ([]*biz.MyReviewInfo, error) {
 	//ES里查询评价
+	start := time.Now()
 	resp, err := r.data.es.Search().Index("review").From(int(offset)).Size(int(limit)).
+		Sort(esSort(sort)...).
 		Query(&types.Query{
 			Bool: &types.BoolQuery{
 				Filter: []types.Query{
@@ -203,6 +495,7 @@ func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, id int64, offset i
 			},
 		},
 		).Do(ctx)
+	observeES("search_by_store", start, err)
 	if err != nil {
 		return nil, err
 	}
@@ -221,8 +514,9 @@ func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, id int64, offset i
 	return list, nil
 }
 
-func (r *reviewRepo) ListReviewByContent(ctx context.Context, offset int32, limit int32) ([]*biz.MyReviewInfo, error) {
+func (r *reviewRepo) searchByContent(ctx context.Context, offset int32, limit int32) ([]*biz.MyReviewInfo, error) {
 	//ES里查询评价-评价不为空
+	start := time.Now()
 	resp, err := r.data.es.Search().Index("review").From(int(offset)).Size(int(limit)).
 		Query(&types.Query{
 			Bool: &types.BoolQuery{
@@ -236,6 +530,7 @@ func (r *reviewRepo) ListReviewByContent(ctx context.Context, offset int32, limi
 			},
 		},
 		).Do(ctx)
+	observeES("search_by_content", start, err)
 	if err != nil {
 		return nil, err
 	}
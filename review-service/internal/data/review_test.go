@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestReviewRepoSqlite(t *testing.T) {
+	convey.Convey("save and read a review on a migrated sqlite db", t, func() {
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		query.SetDefault(db)
+		repo := NewReviewRepo(&Data{query: query.Q, log: log.NewHelper(log.DefaultLogger)}, log.DefaultLogger)
+		ctx := context.Background()
+
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 1, OrderID: 11, UserID: 21, StoreID: 31, Content: "好评"})
+		convey.So(err, convey.ShouldBeNil)
+		review, err := repo.GetReviewByReviewID(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.Content, convey.ShouldEqual, "好评")
+		list, err := repo.GetReviewByOrderID(ctx, 11)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(list, convey.ShouldHaveLength, 1)
+
+		detail, err := repo.GetReviewDetail(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(detail.Reply, convey.ShouldBeNil)
+		_, err = repo.SaveReply(ctx, &model.ReviewReplyInfo{ReplyID: 2, ReviewID: 1, StoreID: 31, Content: "谢谢支持"})
+		convey.So(err, convey.ShouldBeNil)
+		detail, err = repo.GetReviewDetail(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(detail.Reply.Content, convey.ShouldEqual, "谢谢支持")
+		_, err = repo.GetReviewDetail(ctx, 404)
+		convey.So(pb.IsNotFound(err), convey.ShouldBeTrue)
+
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 3, OrderID: 13, UserID: 21, StoreID: 32, Content: "还行", Status: 20})
+		convey.So(err, convey.ShouldBeNil)
+		mine, total, err := repo.ListUserReviews(ctx, &biz.UserReviewParam{UserID: 21}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 2)
+		mine, total, err = repo.ListUserReviews(ctx, &biz.UserReviewParam{UserID: 21, Status: 20, HasReply: 2}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 1)
+		convey.So(mine[0].ReviewID, convey.ShouldEqual, 3)
+		mine, total, err = repo.ListUserReviews(ctx, &biz.UserReviewParam{UserID: 22}, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 0)
+		convey.So(mine, convey.ShouldBeEmpty)
+	})
+}
+
+func TestListUserReviewsSharded(t *testing.T) {
+	convey.Convey("user reviews across shards are merged and paged", t, func() {
+		db, err := NewDB(&conf.Data{
+			Database: &conf.Data_Database{
+				Driver:      "sqlite",
+				Source:      filepath.Join(t.TempDir(), "review.db"),
+				AutoMigrate: true,
+			},
+			Sharding: &conf.Data_Sharding{ReviewShards: 4},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		repo := NewReviewRepo(&Data{query: query.Use(db), log: log.NewHelper(log.DefaultLogger), reviewShards: 4}, log.DefaultLogger)
+		ctx := context.Background()
+		//评价分散在4个分表里，按创建时间依次递增
+		start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+		for i := int64(1); i <= 7; i++ {
+			_, err := repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: i, OrderID: 10 + i, UserID: 21, StoreID: 30 + i, Content: "评价内容", CreateAt: start.Add(time.Duration(i) * time.Minute)})
+			convey.So(err, convey.ShouldBeNil)
+		}
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 8, OrderID: 18, UserID: 22, StoreID: 31, Content: "别人的评价"})
+		convey.So(err, convey.ShouldBeNil)
+
+		var pages [][]int64
+		for offset := int32(0); offset < 8; offset += 3 {
+			mine, total, err := repo.ListUserReviews(ctx, &biz.UserReviewParam{UserID: 21}, offset, 3)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(total, convey.ShouldEqual, 7)
+			var ids []int64
+			for _, r := range mine {
+				ids = append(ids, r.ReviewID)
+			}
+			pages = append(pages, ids)
+		}
+		convey.So(pages, convey.ShouldResemble, [][]int64{{7, 6, 5}, {4, 3, 2}, {1}})
+	})
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+)
+
+func TestReviewTableName(t *testing.T) {
+	convey.Convey("single table keeps review_info", t, func() {
+		convey.So(ReviewTableName(0, 7), convey.ShouldEqual, "review_info")
+		convey.So(ReviewTableName(1, 7), convey.ShouldEqual, "review_info")
+		convey.So(ReviewTableNames(1), convey.ShouldResemble, []string{"review_info"})
+	})
+
+	convey.Convey("shards by store id", t, func() {
+		convey.So(ReviewTableName(4, 7), convey.ShouldEqual, "review_info_3")
+		convey.So(ReviewTableName(4, 8), convey.ShouldEqual, "review_info_0")
+		convey.So(len(ReviewTableNames(4)), convey.ShouldEqual, 4)
+	})
+
+	convey.Convey("page of merged list", t, func() {
+		list := []*model.ReviewInfo{{ReviewID: 1}, {ReviewID: 2}, {ReviewID: 3}}
+		convey.So(len(pageOf(list, 1, 5)), convey.ShouldEqual, 2)
+		convey.So(len(pageOf(list, 3, 5)), convey.ShouldEqual, 0)
+	})
+}
+
+func TestEnsureReviewShards(t *testing.T) {
+	convey.Convey("startup creates the shard tables", t, func() {
+		cfg := &conf.Data{
+			Database: &conf.Data_Database{
+				Driver:      "sqlite",
+				Source:      filepath.Join(t.TempDir(), "review.db"),
+				AutoMigrate: true,
+			},
+			Sharding: &conf.Data_Sharding{ReviewShards: 4},
+		}
+		db, err := NewDB(cfg)
+		convey.So(err, convey.ShouldBeNil)
+		for _, table := range ReviewTableNames(4) {
+			convey.So(db.Migrator().HasTable(table), convey.ShouldBeTrue)
+			convey.So(db.Migrator().HasIndex(table, table+"_uk_review_id"), convey.ShouldBeTrue)
+		}
+		//重启时补上迁移给review_info新加的列
+		convey.So(db.Exec("ALTER TABLE review_info_2 DROP COLUMN trace_ctx").Error, convey.ShouldBeNil)
+		_, err = NewDB(cfg)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(db.Migrator().HasColumn("review_info_2", "trace_ctx"), convey.ShouldBeTrue)
+
+		repo := NewReviewRepo(&Data{query: query.Use(db), log: log.NewHelper(log.DefaultLogger), reviewShards: 4}, log.DefaultLogger)
+		ctx := context.Background()
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 1, OrderID: 101, UserID: 10, StoreID: 7, Content: "评价内容", Score: 5})
+		convey.So(err, convey.ShouldBeNil)
+		review, err := repo.GetReviewByReviewID(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.StoreID, convey.ShouldEqual, 7)
+		var n int64
+		convey.So(db.Table("review_info_3").Count(&n).Error, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 1)
+	})
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"strings"
+	"time"
+)
+
+// 预签名下载地址默认有效期
+const defaultExportURLExpiry = 15 * time.Minute
+
+// NewExportStorage 按配置选择导出文件的存储，默认存本地目录
+func NewExportStorage(c *conf.Export) (biz.ExportStorage, error) {
+	switch strings.ToLower(c.GetStorage()) {
+	case "", "local":
+		dir := c.GetDir()
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "review-export")
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		return &localStorage{dir: dir}, nil
+	case "s3":
+		return newS3Storage(c.GetS3())
+	}
+	return nil, fmt.Errorf("unsupported export storage: %s", c.GetStorage())
+}
+
+// localStorage 文件存在本地目录，只适合单实例部署或共享目录
+type localStorage struct {
+	dir string
+}
+
+func (s *localStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	p := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	//先写临时文件再改名，下载时不会读到写了一半的文件
+	f, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
+
+func (s *localStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(s.path(key))
+}
+
+func (s *localStorage) URL(ctx context.Context, key string, fileName string) (string, error) {
+	return "", nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// s3Storage 兼容S3协议的对象存储，下载走预签名地址不经过服务
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+	expiry time.Duration
+}
+
+func newS3Storage(c *conf.Export_S3) (*s3Storage, error) {
+	if c.GetEndpoint() == "" || c.GetBucket() == "" {
+		return nil, errors.New("export s3 storage requires endpoint and bucket")
+	}
+	client, err := minio.New(c.GetEndpoint(), &minio.Options{
+		Creds:  credentials.NewStaticV4(c.GetAccessKey(), c.GetSecretKey(), ""),
+		Secure: c.GetUseSsl(),
+		Region: c.GetRegion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	expiry := c.GetUrlExpiry().AsDuration()
+	if expiry <= 0 {
+		expiry = defaultExportURLExpiry
+	}
+	return &s3Storage{client: client, bucket: c.GetBucket(), expiry: expiry}, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *s3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+}
+
+func (s *s3Storage) URL(ctx context.Context, key string, fileName string) (string, error) {
+	//浏览器按fileName保存，而不是对象的key
+	params := url.Values{}
+	params.Set("response-content-disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(fileName)))
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, key, s.expiry, params)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
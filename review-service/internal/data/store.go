@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+)
+
+// ListStoreReviews B端管理本店评价查mysql，需要看到各个审核状态，ES里只有对外展示用的数据
+func (r *reviewRepo) ListStoreReviews(ctx context.Context, param *biz.StoreReviewParam, offset int32, limit int32) ([]*model.ReviewInfo, int64, error) {
+	q := r.data.query.ReviewInfo.Table(r.reviewTable(param.StoreID))
+	conds := []gen.Condition{q.StoreID.Eq(param.StoreID)}
+	if param.Unreplied {
+		conds = append(conds, q.HasReply.Eq(0))
+	}
+	if param.MaxScore > 0 {
+		conds = append(conds, q.Score.Lte(param.MaxScore))
+	}
+	switch param.HasMedia {
+	case 1:
+		conds = append(conds, q.HasMedia.Eq(1))
+	case 2:
+		conds = append(conds, q.HasMedia.Eq(0))
+	}
+	if !param.StartTime.IsZero() {
+		conds = append(conds, q.CreateAt.Gte(param.StartTime))
+	}
+	if !param.EndTime.IsZero() {
+		conds = append(conds, q.CreateAt.Lt(param.EndTime))
+	}
+	return q.WithContext(ctx).Debug().Where(conds...).
+		Order(q.CreateAt.Desc(), q.ID.Desc()).
+		FindByPage(int(offset), int(limit))
+}
+
+func (r *reviewRepo) ListStoreAppeals(ctx context.Context, storeID int64, status int32, offset int32, limit int32) ([]*model.ReviewAppealInfo, int64, error) {
+	q := r.data.query.ReviewAppealInfo
+	conds := []gen.Condition{q.StoreID.Eq(storeID)}
+	if status > 0 {
+		conds = append(conds, q.Status.Eq(status))
+	}
+	return q.WithContext(ctx).Debug().Where(conds...).
+		Order(q.CreateAt.Desc(), q.ID.Desc()).
+		FindByPage(int(offset), int(limit))
+}
+
+func (r *reviewRepo) GetAppealByAppealID(ctx context.Context, appealID int64) (*model.ReviewAppealInfo, error) {
+	appeal, err := r.data.query.ReviewAppealInfo.WithContext(ctx).Debug().
+		Where(r.data.query.ReviewAppealInfo.AppealID.Eq(appealID)).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pb.ErrorNotFound("没有这个申诉")
+	}
+	return appeal, err
+}
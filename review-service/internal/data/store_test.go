@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestStoreReads(t *testing.T) {
+	convey.Convey("store reads only return the store's own reviews and appeals", t, func() {
+		db, err := NewDB(&conf.Data{
+			Database: &conf.Data_Database{
+				Driver:      "sqlite",
+				Source:      filepath.Join(t.TempDir(), "review.db"),
+				AutoMigrate: true,
+			},
+			Sharding: &conf.Data_Sharding{ReviewShards: 4},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		repo := NewReviewRepo(&Data{query: query.Use(db), log: log.NewHelper(log.DefaultLogger), reviewShards: 4}, log.DefaultLogger)
+		uc := biz.NewReviewerUsecase(repo, nil, nil, &conf.Report{}, &conf.Export{}, &conf.Anonymous{}, log.DefaultLogger)
+		ctx := context.Background()
+		start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+		//31和35落在同一张分表，1-6是31的，按ID依次晚一天
+		for i := int64(1); i <= 6; i++ {
+			review := &model.ReviewInfo{ReviewID: i, OrderID: 100 + i, UserID: 10, StoreID: 31, Content: "评价内容", Score: int32(i), CreateAt: start.AddDate(0, 0, int(i))}
+			if i%2 == 0 {
+				review.HasReply = 1
+			}
+			if i == 1 || i == 4 {
+				review.HasMedia = 1
+			}
+			_, err := repo.SaveReview(ctx, review)
+			convey.So(err, convey.ShouldBeNil)
+		}
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 7, OrderID: 107, UserID: 10, StoreID: 35, Content: "别家的评价", Score: 1, CreateAt: start})
+		convey.So(err, convey.ShouldBeNil)
+		list := func(param *biz.StoreReviewParam, offset int32, limit int32) ([]int64, int64) {
+			reviews, total, err := repo.ListStoreReviews(ctx, param, offset, limit)
+			convey.So(err, convey.ShouldBeNil)
+			ids := make([]int64, 0, len(reviews))
+			for _, r := range reviews {
+				ids = append(ids, r.ReviewID)
+			}
+			return ids, total
+		}
+
+		ids, total := list(&biz.StoreReviewParam{StoreID: 31}, 2, 2)
+		convey.So(total, convey.ShouldEqual, 6)
+		convey.So(ids, convey.ShouldResemble, []int64{4, 3})
+		ids, _ = list(&biz.StoreReviewParam{StoreID: 31, Unreplied: true}, 0, 10)
+		convey.So(ids, convey.ShouldResemble, []int64{5, 3, 1})
+		ids, _ = list(&biz.StoreReviewParam{StoreID: 31, MaxScore: 2}, 0, 10)
+		convey.So(ids, convey.ShouldResemble, []int64{2, 1})
+		ids, _ = list(&biz.StoreReviewParam{StoreID: 31, HasMedia: 1}, 0, 10)
+		convey.So(ids, convey.ShouldResemble, []int64{4, 1})
+		ids, _ = list(&biz.StoreReviewParam{StoreID: 31, HasMedia: 2, Unreplied: true}, 0, 10)
+		convey.So(ids, convey.ShouldResemble, []int64{5, 3})
+		//时间范围左闭右开
+		ids, total = list(&biz.StoreReviewParam{StoreID: 31, StartTime: start.AddDate(0, 0, 2), EndTime: start.AddDate(0, 0, 4)}, 0, 10)
+		convey.So(total, convey.ShouldEqual, 2)
+		convey.So(ids, convey.ShouldResemble, []int64{3, 2})
+		ids, total = list(&biz.StoreReviewParam{StoreID: 35, MaxScore: 2}, 0, 10)
+		convey.So(total, convey.ShouldEqual, 1)
+		convey.So(ids, convey.ShouldResemble, []int64{7})
+
+		for i := int64(1); i <= 3; i++ {
+			appeal := &model.ReviewAppealInfo{AppealID: 100 + i, ReviewID: i, StoreID: 31, Reason: "原因", Content: "申诉内容", CreateAt: start.AddDate(0, 0, int(i))}
+			if i == 2 {
+				appeal.Status = biz.AppealRejected
+			}
+			convey.So(query.Use(db).ReviewAppealInfo.WithContext(ctx).Create(appeal), convey.ShouldBeNil)
+		}
+		convey.So(query.Use(db).ReviewAppealInfo.WithContext(ctx).Create(&model.ReviewAppealInfo{AppealID: 107, ReviewID: 7, StoreID: 35, Reason: "原因", Content: "申诉内容"}), convey.ShouldBeNil)
+		appeals, total, err := uc.ListStoreAppeals(ctx, 31, 0, 1, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 3)
+		convey.So(appeals[0].AppealID, convey.ShouldEqual, 103)
+		appeals, total, err = uc.ListStoreAppeals(ctx, 31, biz.AppealRejected, 1, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 1)
+		convey.So(appeals[0].AppealID, convey.ShouldEqual, 102)
+
+		appeal, err := uc.GetAppeal(ctx, 31, 102)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(appeal.Status, convey.ShouldEqual, biz.AppealRejected)
+		//别家的申诉和不存在的一样
+		_, err = uc.GetAppeal(ctx, 31, 107)
+		convey.So(pb.IsNotFound(err), convey.ShouldBeTrue)
+		_, err = uc.GetAppeal(ctx, 31, 999)
+		convey.So(pb.IsNotFound(err), convey.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	pb "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"time"
+)
+
+const autoReplyLockKey = "review:autoreply:lock"
+
+func (r *reviewRepo) SaveTemplate(ctx context.Context, template *model.ReviewReplyTemplate) error {
+	return r.data.query.ReviewReplyTemplate.WithContext(ctx).Debug().Create(template)
+}
+
+func (r *reviewRepo) UpdateTemplate(ctx context.Context, template *model.ReviewReplyTemplate) (int64, error) {
+	q := r.data.query.ReviewReplyTemplate
+	info, err := q.WithContext(ctx).Debug().
+		Where(q.TemplateID.Eq(template.TemplateID), q.StoreID.Eq(template.StoreID)).
+		Updates(map[string]interface{}{
+			"name":    template.Name,
+			"content": template.Content,
+		})
+	return info.RowsAffected, err
+}
+
+func (r *reviewRepo) DeleteTemplate(ctx context.Context, storeID int64, templateID int64) (int64, error) {
+	q := r.data.query.ReviewReplyTemplate
+	info, err := q.WithContext(ctx).Debug().
+		Where(q.TemplateID.Eq(templateID), q.StoreID.Eq(storeID)).
+		Delete()
+	return info.RowsAffected, err
+}
+
+func (r *reviewRepo) GetTemplate(ctx context.Context, templateID int64) (*model.ReviewReplyTemplate, error) {
+	q := r.data.query.ReviewReplyTemplate
+	template, err := q.WithContext(ctx).Debug().Where(q.TemplateID.Eq(templateID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pb.ErrorNotFound("没有这个模板")
+	}
+	return template, err
+}
+
+func (r *reviewRepo) ListTemplates(ctx context.Context, storeID int64) ([]*model.ReviewReplyTemplate, error) {
+	q := r.data.query.ReviewReplyTemplate
+	return q.WithContext(ctx).Debug().Where(q.StoreID.Eq(storeID)).Order(q.ID).Find()
+}
+
+func (r *reviewRepo) SaveAutoReplyRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error {
+	return r.data.query.ReviewAutoReplyRule.WithContext(ctx).Debug().Create(rule)
+}
+
+// UpdateAutoReplyRule 用map更新，关闭规则或去掉只回复空评价时零值也要写进去
+func (r *reviewRepo) UpdateAutoReplyRule(ctx context.Context, rule *model.ReviewAutoReplyRule) (int64, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	info, err := q.WithContext(ctx).Debug().
+		Where(q.RuleID.Eq(rule.RuleID), q.StoreID.Eq(rule.StoreID)).
+		Updates(map[string]interface{}{
+			"template_id":   rule.TemplateID,
+			"min_score":     rule.MinScore,
+			"max_score":     rule.MaxScore,
+			"only_empty":    rule.OnlyEmpty,
+			"delay_seconds": rule.DelaySeconds,
+			"enabled":       rule.Enabled,
+		})
+	return info.RowsAffected, err
+}
+
+func (r *reviewRepo) DeleteAutoReplyRule(ctx context.Context, storeID int64, ruleID int64) (int64, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	info, err := q.WithContext(ctx).Debug().
+		Where(q.RuleID.Eq(ruleID), q.StoreID.Eq(storeID)).
+		Delete()
+	return info.RowsAffected, err
+}
+
+func (r *reviewRepo) ListAutoReplyRules(ctx context.Context, storeID int64) ([]*model.ReviewAutoReplyRule, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	return q.WithContext(ctx).Debug().Where(q.StoreID.Eq(storeID)).Order(q.ID).Find()
+}
+
+func (r *reviewRepo) ListEnabledAutoReplyRules(ctx context.Context) ([]*model.ReviewAutoReplyRule, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	return q.WithContext(ctx).Debug().Where(q.Enabled.Eq(1)).Order(q.ID).Find()
+}
+
+func (r *reviewRepo) CountRulesByTemplate(ctx context.Context, templateID int64) (int64, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	return q.WithContext(ctx).Debug().Where(q.TemplateID.Eq(templateID)).Count()
+}
+
+// ListAutoReplyCandidates 查询符合规则、还没回复的已审核通过评价，按创建时间正序
+func (r *reviewRepo) ListAutoReplyCandidates(ctx context.Context, rule *model.ReviewAutoReplyRule, before time.Time, limit int) ([]*model.ReviewInfo, error) {
+	q := r.data.query.ReviewInfo.Table(r.reviewTable(rule.StoreID))
+	conds := []gen.Condition{
+		q.StoreID.Eq(rule.StoreID),
+		q.Status.Eq(20),
+		q.HasReply.Eq(0),
+		q.Score.Between(rule.MinScore, rule.MaxScore),
+		q.CreateAt.Lte(before),
+	}
+	if rule.OnlyEmpty == 1 {
+		conds = append(conds, q.Content.Eq(""))
+	}
+	return q.WithContext(ctx).Debug().Where(conds...).
+		Order(q.CreateAt, q.ID).
+		Limit(limit).
+		Find()
+}
+
+// LockAutoReply 拿到锁时返回token，释放时用token校验是不是自己的锁
+func (r *reviewRepo) LockAutoReply(ctx context.Context, lease time.Duration) (string, error) {
+	return r.data.tryLock(ctx, autoReplyLockKey, lease)
+}
+
+func (r *reviewRepo) UnlockAutoReply(ctx context.Context, token string) {
+	if err := r.data.unlock(ctx, autoReplyLockKey, token); err != nil {
+		r.log.WithContext(ctx).Errorf("unlock auto reply failed, err:%v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	traceSpanKey   = "review:trace_span"
+	traceStartName = "review:trace_start"
+	traceEndName   = "review:trace_end"
+)
+
+// useTracing 每条SQL记一个span，挂在请求的span下面
+func useTracing(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register(traceStartName, startSpan("create")),
+		cb.Create().After("gorm:create").Register(traceEndName, endSpan),
+		cb.Query().Before("gorm:query").Register(traceStartName, startSpan("query")),
+		cb.Query().After("gorm:query").Register(traceEndName, endSpan),
+		cb.Update().Before("gorm:update").Register(traceStartName, startSpan("update")),
+		cb.Update().After("gorm:update").Register(traceEndName, endSpan),
+		cb.Delete().Before("gorm:delete").Register(traceStartName, startSpan("delete")),
+		cb.Delete().After("gorm:delete").Register(traceEndName, endSpan),
+		cb.Row().Before("gorm:row").Register(traceStartName, startSpan("row")),
+		cb.Row().After("gorm:row").Register(traceEndName, endSpan),
+		cb.Raw().Before("gorm:raw").Register(traceStartName, startSpan("raw")),
+		cb.Raw().After("gorm:raw").Register(traceEndName, endSpan),
+	)
+}
+
+func startSpan(op string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		//只记录请求链路里的SQL，定时任务的轮询不单独成链路
+		if ctx == nil || !trace.SpanContextFromContext(ctx).IsValid() {
+			return
+		}
+		_, span := otel.Tracer("review-service/data").Start(ctx, "gorm."+op, trace.WithSpanKind(trace.SpanKindClient))
+		db.InstanceSet(traceSpanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	v, ok := db.InstanceGet(traceSpanKey)
+	if !ok {
+		return
+	}
+	span := v.(trace.Span)
+	defer span.End()
+	//只记带占位符的SQL，不记参数
+	span.SetAttributes(
+		semconv.DBSystemKey.String(db.Dialector.Name()),
+		semconv.DBStatement(db.Statement.SQL.String()),
+		semconv.DBSQLTable(db.Statement.Table),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
+
+// traceContext 当前链路序列化后写到trace_ctx列，没有链路时返回空
+// 评价经canal->kafka同步到ES，canal不带消息头，review-job从这一列取链路把索引span接到原请求上
+func traceContext(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(carrier)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
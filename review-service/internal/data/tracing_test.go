@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"path/filepath"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+)
+
+func TestGormTracing(t *testing.T) {
+	convey.Convey("sql inside a traced request records a child span", t, func() {
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		otel.SetTracerProvider(tp)
+		defer otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver: "sqlite",
+			Source: filepath.Join(t.TempDir(), "trace.db"),
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(db.Exec("CREATE TABLE t (id INTEGER)").Error, convey.ShouldBeNil)
+		//没有链路的SQL不记
+		convey.So(recorder.Ended(), convey.ShouldBeEmpty)
+
+		ctx, parent := tp.Tracer("test").Start(context.Background(), "request")
+		var n int64
+		convey.So(db.WithContext(ctx).Table("t").Count(&n).Error, convey.ShouldBeNil)
+		parent.End()
+
+		spans := recorder.Ended()
+		convey.So(spans, convey.ShouldHaveLength, 2)
+		convey.So(spans[0].Name(), convey.ShouldEqual, "gorm.query")
+		convey.So(spans[0].Parent().SpanID(), convey.ShouldEqual, parent.SpanContext().SpanID())
+
+		convey.So(traceContext(context.Background()), convey.ShouldBeEmpty)
+		convey.So(traceContext(ctx), convey.ShouldContainSubstring, parent.SpanContext().TraceID().String())
+	})
+}
+
+func TestReviewTraceContext(t *testing.T) {
+	convey.Convey("writes keep ctrl_json and record the trace in trace_ctx", t, func() {
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		repo := NewReviewRepo(&Data{query: query.Use(db), log: log.NewHelper(log.DefaultLogger)}, log.DefaultLogger)
+		tp := sdktrace.NewTracerProvider()
+		ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+		defer span.End()
+
+		_, err = repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: 1, OrderID: 101, UserID: 10, StoreID: 20, Content: "评价内容", Score: 5, CtrlJSON: `{"source":"app"}`})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = repo.UpdateReview(ctx, &model.ReviewInfo{ReviewID: 1, Content: "追加修改"})
+		convey.So(err, convey.ShouldBeNil)
+		review, err := repo.GetReviewByReviewID(context.Background(), 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(review.CtrlJSON, convey.ShouldEqual, `{"source":"app"}`)
+		convey.So(review.TraceCtx, convey.ShouldContainSubstring, span.SpanContext().TraceID().String())
+	})
+}
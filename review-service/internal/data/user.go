@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	lru "github.com/hashicorp/golang-lru"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"time"
+)
+
+const (
+	defaultUserCacheSize = 10000
+	defaultUserCacheTTL  = 5 * time.Minute
+)
+
+// NewUserClient 用户服务客户端，外面套一层本地LRU缓存
+// 目前还没有接入用户服务，查不到用户信息，调用方按默认昵称展示
+func NewUserClient(c *conf.User, logger log.Logger) (biz.UserClient, error) {
+	return newCachedUserClient(noopUserClient{}, int(c.GetCacheSize()), c.GetCacheTtl().AsDuration(), logger)
+}
+
+// noopUserClient 没有接入用户服务时使用，不返回任何用户
+type noopUserClient struct{}
+
+func (noopUserClient) BatchGetUsers(ctx context.Context, ids []int64) (map[int64]*biz.UserProfile, error) {
+	return map[int64]*biz.UserProfile{}, nil
+}
+
+type userEntry struct {
+	profile  *biz.UserProfile
+	expireAt time.Time
+}
+
+// cachedUserClient 带过期时间的LRU缓存，只把未命中的userID批量转发给下游
+type cachedUserClient struct {
+	next  biz.UserClient
+	cache *lru.Cache
+	ttl   time.Duration
+	log   *log.Helper
+}
+
+func newCachedUserClient(next biz.UserClient, size int, ttl time.Duration, logger log.Logger) (*cachedUserClient, error) {
+	if size <= 0 {
+		size = defaultUserCacheSize
+	}
+	if ttl <= 0 {
+		ttl = defaultUserCacheTTL
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedUserClient{next: next, cache: cache, ttl: ttl, log: log.NewHelper(logger)}, nil
+}
+
+func (c *cachedUserClient) BatchGetUsers(ctx context.Context, ids []int64) (map[int64]*biz.UserProfile, error) {
+	ret := make(map[int64]*biz.UserProfile, len(ids))
+	missed := make([]int64, 0, len(ids))
+	now := time.Now()
+	for _, id := range ids {
+		if v, ok := c.cache.Get(id); ok {
+			if e := v.(*userEntry); now.Before(e.expireAt) {
+				ret[id] = e.profile
+				continue
+			}
+		}
+		missed = append(missed, id)
+	}
+	if len(missed) == 0 {
+		return ret, nil
+	}
+	users, err := c.next.BatchGetUsers(ctx, missed)
+	if err != nil {
+		return nil, err
+	}
+	for id, u := range users {
+		c.cache.Add(id, &userEntry{profile: u, expireAt: now.Add(c.ttl)})
+		ret[id] = u
+	}
+	c.log.WithContext(ctx).Debugf("[data] BatchGetUsers hit:%d missed:%d", len(ids)-len(missed), len(missed))
+	return ret, nil
+}
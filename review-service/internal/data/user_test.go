@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/smartystreets/goconvey/convey"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCachedUserClient(t *testing.T) {
+	convey.Convey("cache hit does not call downstream", t, func() {
+		fake := NewFakeUserClient()
+		c, err := newCachedUserClient(fake, 2, time.Minute, log.DefaultLogger)
+		convey.So(err, convey.ShouldBeNil)
+
+		users, err := c.BatchGetUsers(context.Background(), []int64{1, 2})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(users), convey.ShouldEqual, 2)
+		convey.So(users[1].Nickname, convey.ShouldEqual, "用户1")
+
+		users, err = c.BatchGetUsers(context.Background(), []int64{2, 1})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(users), convey.ShouldEqual, 2)
+		convey.So(fake.Calls(), convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("evicted and expired entries are fetched again", t, func() {
+		fake := NewFakeUserClient()
+		c, err := newCachedUserClient(fake, 1, time.Millisecond, log.DefaultLogger)
+		convey.So(err, convey.ShouldBeNil)
+
+		_, _ = c.BatchGetUsers(context.Background(), []int64{1, 2})
+		time.Sleep(2 * time.Millisecond)
+		_, _ = c.BatchGetUsers(context.Background(), []int64{2})
+		convey.So(fake.Calls(), convey.ShouldEqual, 2)
+	})
+}
+
+func TestNewUserClient(t *testing.T) {
+	convey.Convey("without a user service no profile is returned", t, func() {
+		c, err := NewUserClient(&conf.User{}, log.DefaultLogger)
+		convey.So(err, convey.ShouldBeNil)
+		users, err := c.BatchGetUsers(context.Background(), []int64{1, 2})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(users, convey.ShouldBeEmpty)
+	})
+}
+
+// FakeUserClient 用户服务的假实现，按userID生成固定的用户信息，只在测试里使用
+type FakeUserClient struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func NewFakeUserClient() *FakeUserClient {
+	return &FakeUserClient{}
+}
+
+func (f *FakeUserClient) BatchGetUsers(ctx context.Context, ids []int64) (map[int64]*biz.UserProfile, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+	ret := make(map[int64]*biz.UserProfile, len(ids))
+	for _, id := range ids {
+		ret[id] = &biz.UserProfile{
+			UserID:   id,
+			Nickname: fmt.Sprintf("用户%d", id),
+			Avatar:   fmt.Sprintf("https://avatar.example.com/%d.png", id),
+			Level:    int32(id % 5),
+		}
+	}
+	return ret, nil
+}
+
+// Calls 返回BatchGetUsers被调用的次数
+func (f *FakeUserClient) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"strconv"
+	"time"
+)
+
+// 有用数先累加在redis里，由定时任务批量刷回mysql，再经canal->kafka->review-job同步到ES
+const (
+	helpfulDeltaKey    = "review:helpful:delta"
+	helpfulFlushingKey = "review:helpful:delta:flushing"
+	helpfulLockKey     = "review:helpful:flush:lock"
+)
+
+func (r *reviewRepo) SaveVote(ctx context.Context, vote *model.ReviewVoteInfo) error {
+	_, err := r.data.query.ReviewVoteInfo.WithContext(ctx).
+		Where(r.data.query.ReviewVoteInfo.ReviewID.Eq(vote.ReviewID), r.data.query.ReviewVoteInfo.UserID.Eq(vote.UserID)).
+		First()
+	if err == nil {
+		return pb.ErrorReviewVoted("已经投过票了")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	//uk_review_user兜底并发重复投票
+	if err = r.data.query.ReviewVoteInfo.WithContext(ctx).Debug().Create(vote); err != nil {
+		return err
+	}
+	r.incrHelpful(ctx, vote.ReviewID, 1)
+	return nil
+}
+
+func (r *reviewRepo) DeleteVote(ctx context.Context, vote *model.ReviewVoteInfo) (int64, error) {
+	info, err := r.data.query.ReviewVoteInfo.WithContext(ctx).Debug().
+		Where(r.data.query.ReviewVoteInfo.ReviewID.Eq(vote.ReviewID), r.data.query.ReviewVoteInfo.UserID.Eq(vote.UserID)).
+		Delete()
+	if err != nil {
+		return 0, err
+	}
+	if info.RowsAffected > 0 {
+		r.incrHelpful(ctx, vote.ReviewID, -1)
+	}
+	return info.RowsAffected, nil
+}
+
+// incrHelpful 累加redis中的有用数增量，redis不可用时直接写mysql，保证投票表和计数不漂移
+func (r *reviewRepo) incrHelpful(ctx context.Context, reviewID int64, delta int32) {
+	err := r.data.rdb.HIncrBy(ctx, helpfulDeltaKey, strconv.FormatInt(reviewID, 10), int64(delta)).Err()
+	if err == nil {
+		return
+	}
+	r.log.WithContext(ctx).Errorf("incr helpful in redis failed, reviewID:%v err:%v", reviewID, err)
+	if err = r.applyHelpful(ctx, reviewID, delta); err != nil {
+		r.log.WithContext(ctx).Errorf("incr helpful in db failed, reviewID:%v err:%v", reviewID, err)
+	}
+}
+
+func (r *reviewRepo) applyHelpful(ctx context.Context, reviewID int64, delta int32) error {
+	table, err := r.locateReview(biz.WithPrimary(ctx), reviewID)
+	if err != nil {
+		return err
+	}
+	q := r.data.query.ReviewInfo.Table(table)
+	_, err = q.WithContext(ctx).Debug().
+		Where(q.ReviewID.Eq(reviewID)).
+		UpdateSimple(q.HelpfulCount.Add(delta))
+	if err == nil {
+		r.evictReviewCache(ctx, reviewID, 0)
+	}
+	return err
+}
+
+// FlushHelpfulVotes 把redis中累计的有用数增量刷回mysql，返回处理的评价数，写库失败的不计入
+// 多实例部署时用锁保证同一时刻只有一个实例在刷
+func (r *reviewRepo) FlushHelpfulVotes(ctx context.Context, lease time.Duration) (int, error) {
+	token, err := r.data.tryLock(ctx, helpfulLockKey, lease)
+	if err != nil || token == "" {
+		return 0, err
+	}
+	defer func() {
+		if err := r.data.unlock(context.Background(), helpfulLockKey, token); err != nil {
+			r.log.WithContext(ctx).Errorf("unlock helpful flush failed, err:%v", err)
+		}
+	}()
+
+	//上次刷到一半中断的增量先处理，否则把当前增量整体挪过来，之后的投票继续累加到新key
+	n, err := r.data.rdb.Exists(ctx, helpfulFlushingKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		if n, err = r.data.rdb.Exists(ctx, helpfulDeltaKey).Result(); err != nil || n == 0 {
+			return 0, err
+		}
+		if err = r.data.rdb.Rename(ctx, helpfulDeltaKey, helpfulFlushingKey).Err(); err != nil {
+			return 0, err
+		}
+	}
+	deltas, err := r.data.rdb.HGetAll(ctx, helpfulFlushingKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	flushed := 0
+	for field, v := range deltas {
+		reviewID, _ := strconv.ParseInt(field, 10, 64)
+		delta, _ := strconv.ParseInt(v, 10, 32)
+		if reviewID > 0 && delta != 0 {
+			err = r.applyHelpful(ctx, reviewID, int32(delta))
+			//评价已删除时增量直接丢掉
+			//其他错误把增量挪回累加的key下一轮重试，flushing key留着会挡住之后所有的增量
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				r.log.WithContext(ctx).Errorf("flush helpful failed, reviewID:%v err:%v", reviewID, err)
+				if _, err = r.data.rdb.TxPipelined(ctx, func(p redis.Pipeliner) error {
+					p.HIncrBy(ctx, helpfulDeltaKey, field, delta)
+					p.HDel(ctx, helpfulFlushingKey, field)
+					return nil
+				}); err != nil {
+					return flushed, err
+				}
+				continue
+			}
+		}
+		//逐条删除，中断后重试不会重复累加已经写入的评价
+		if err = r.data.rdb.HDel(ctx, helpfulFlushingKey, field).Err(); err != nil {
+			return flushed, err
+		}
+		flushed++
+	}
+	return flushed, nil
+}
@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"path/filepath"
+	pb "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"testing"
+	"time"
+)
+
+func TestHelpfulVotes(t *testing.T) {
+	convey.Convey("votes are counted in redis and flushed to mysql", t, func() {
+		db, err := NewDB(&conf.Data{Database: &conf.Data_Database{
+			Driver:      "sqlite",
+			Source:      filepath.Join(t.TempDir(), "review.db"),
+			AutoMigrate: true,
+		}})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		repo := NewReviewRepo(&Data{
+			query: query.Use(db),
+			log:   log.NewHelper(log.DefaultLogger),
+			rdb:   redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+		}, log.DefaultLogger)
+		ctx := context.Background()
+		for i := int64(1); i <= 2; i++ {
+			_, err := repo.SaveReview(ctx, &model.ReviewInfo{ReviewID: i, OrderID: 100 + i, UserID: 10, StoreID: 20, Content: "评价内容", Score: 5, Status: 20})
+			convey.So(err, convey.ShouldBeNil)
+		}
+		helpful := func(reviewID int64) int32 {
+			review, err := repo.GetReviewByReviewID(ctx, reviewID)
+			convey.So(err, convey.ShouldBeNil)
+			return review.HelpfulCount
+		}
+
+		//同一用户重复投票报错，不重复计数
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 1, UserID: 30}), convey.ShouldBeNil)
+		convey.So(pb.IsReviewVoted(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 1, UserID: 30})), convey.ShouldBeTrue)
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 1, UserID: 31}), convey.ShouldBeNil)
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 2, UserID: 30}), convey.ShouldBeNil)
+		convey.So(mr.HGet(helpfulDeltaKey, "1"), convey.ShouldEqual, "2")
+
+		//取消后可以再投，没投过的取消不影响计数
+		n, err := repo.DeleteVote(ctx, &model.ReviewVoteInfo{ReviewID: 2, UserID: 30})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 1)
+		n, err = repo.DeleteVote(ctx, &model.ReviewVoteInfo{ReviewID: 2, UserID: 30})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 0)
+		convey.So(mr.HGet(helpfulDeltaKey, "2"), convey.ShouldEqual, "0")
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 2, UserID: 30}), convey.ShouldBeNil)
+		convey.So(helpful(1), convey.ShouldEqual, 0)
+
+		flushed, err := repo.FlushHelpfulVotes(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(flushed, convey.ShouldEqual, 2)
+		convey.So(helpful(1), convey.ShouldEqual, 2)
+		convey.So(helpful(2), convey.ShouldEqual, 1)
+		convey.So(mr.Exists(helpfulDeltaKey), convey.ShouldBeFalse)
+		convey.So(mr.Exists(helpfulFlushingKey), convey.ShouldBeFalse)
+		convey.So(mr.Exists(helpfulLockKey), convey.ShouldBeFalse)
+
+		//上次中断留下的增量先刷，新增量留到下一轮
+		mr.HSet(helpfulFlushingKey, "1", "3")
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 2, UserID: 31}), convey.ShouldBeNil)
+		flushed, err = repo.FlushHelpfulVotes(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(flushed, convey.ShouldEqual, 1)
+		convey.So(helpful(1), convey.ShouldEqual, 5)
+		convey.So(mr.HGet(helpfulDeltaKey, "2"), convey.ShouldEqual, "1")
+
+		//别的实例持有锁时跳过
+		convey.So(mr.Set(helpfulLockKey, "other"), convey.ShouldBeNil)
+		flushed, err = repo.FlushHelpfulVotes(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(flushed, convey.ShouldEqual, 0)
+		v, _ := mr.Get(helpfulLockKey)
+		convey.So(v, convey.ShouldEqual, "other")
+		mr.Del(helpfulLockKey)
+		flushed, err = repo.FlushHelpfulVotes(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(flushed, convey.ShouldEqual, 1)
+		convey.So(helpful(2), convey.ShouldEqual, 2)
+
+		//redis不可用时直接写mysql
+		mr.Close()
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 1, UserID: 32}), convey.ShouldBeNil)
+		convey.So(helpful(1), convey.ShouldEqual, 6)
+	})
+	convey.Convey("a deleted or failing review does not block later flushes", t, func() {
+		db, err := NewDB(&conf.Data{
+			Database: &conf.Data_Database{
+				Driver:      "sqlite",
+				Source:      filepath.Join(t.TempDir(), "review.db"),
+				AutoMigrate: true,
+			},
+			Sharding: &conf.Data_Sharding{ReviewShards: 4},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		mr := miniredis.RunT(t)
+		repo := NewReviewRepo(&Data{
+			query:        query.Use(db),
+			log:          log.NewHelper(log.DefaultLogger),
+			rdb:          redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+			reviewShards: 4,
+		}, log.DefaultLogger)
+		ctx := context.Background()
+		//1和2在review_info_1，3单独在review_info_3
+		for _, r := range []*model.ReviewInfo{
+			{ReviewID: 1, OrderID: 101, UserID: 10, StoreID: 21, Content: "评价内容", Score: 5, Status: 20},
+			{ReviewID: 2, OrderID: 102, UserID: 10, StoreID: 21, Content: "评价内容", Score: 5, Status: 20},
+			{ReviewID: 3, OrderID: 103, UserID: 10, StoreID: 23, Content: "评价内容", Score: 5, Status: 20},
+		} {
+			_, err := repo.SaveReview(ctx, r)
+			convey.So(err, convey.ShouldBeNil)
+		}
+		for _, id := range []int64{1, 2, 3} {
+			convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: id, UserID: 30}), convey.ShouldBeNil)
+		}
+		//投票后评价被删除，分表后按评价ID再也找不到所在分表
+		convey.So(db.Exec("DELETE FROM review_info_1 WHERE review_id = 2").Error, convey.ShouldBeNil)
+		convey.So(db.Exec("DELETE FROM review_info_index WHERE review_id = 2").Error, convey.ShouldBeNil)
+		//3所在的分表暂时写不进去
+		convey.So(db.Exec("ALTER TABLE review_info_3 RENAME TO review_info_3_bak").Error, convey.ShouldBeNil)
+
+		flushed, err := repo.FlushHelpfulVotes(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(flushed, convey.ShouldEqual, 2)
+		convey.So(mr.Exists(helpfulFlushingKey), convey.ShouldBeFalse)
+		//写失败的增量挪回去下一轮重试，删除的评价直接丢掉
+		keys, _ := mr.HKeys(helpfulDeltaKey)
+		convey.So(keys, convey.ShouldResemble, []string{"3"})
+		convey.So(mr.HGet(helpfulDeltaKey, "3"), convey.ShouldEqual, "1")
+
+		convey.So(db.Exec("ALTER TABLE review_info_3_bak RENAME TO review_info_3").Error, convey.ShouldBeNil)
+		convey.So(repo.SaveVote(ctx, &model.ReviewVoteInfo{ReviewID: 1, UserID: 31}), convey.ShouldBeNil)
+		flushed, err = repo.FlushHelpfulVotes(ctx, time.Minute)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(flushed, convey.ShouldEqual, 2)
+		convey.So(mr.Exists(helpfulDeltaKey), convey.ShouldBeFalse)
+		convey.So(mr.Exists(helpfulFlushingKey), convey.ShouldBeFalse)
+		for id, want := range map[int64]int32{1: 2, 3: 1} {
+			review, err := repo.GetReviewByReviewID(ctx, id)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(review.HelpfulCount, convey.ShouldEqual, want)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"time"
+)
+
+const webhookLockKey = "review:webhook:lock"
+
+func (r *reviewRepo) SaveWebhook(ctx context.Context, sub *model.ReviewWebhookSubscription) error {
+	return r.data.query.ReviewWebhookSubscription.WithContext(ctx).Debug().Create(sub)
+}
+
+func (r *reviewRepo) DeleteWebhook(ctx context.Context, ownerType int32, ownerID int64, subscriptionID int64) (int64, error) {
+	q := r.data.query.ReviewWebhookSubscription
+	info, err := q.WithContext(ctx).Debug().
+		Where(q.SubscriptionID.Eq(subscriptionID), q.OwnerType.Eq(ownerType), q.OwnerID.Eq(ownerID)).
+		Delete()
+	return info.RowsAffected, err
+}
+
+func (r *reviewRepo) GetWebhook(ctx context.Context, subscriptionID int64) (*model.ReviewWebhookSubscription, error) {
+	q := r.data.query.ReviewWebhookSubscription
+	sub, err := q.WithContext(ctx).Debug().Where(q.SubscriptionID.Eq(subscriptionID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pb.ErrorNotFound("没有这个订阅")
+	}
+	return sub, err
+}
+
+func (r *reviewRepo) ListWebhooks(ctx context.Context, ownerType int32, ownerID int64) ([]*model.ReviewWebhookSubscription, error) {
+	q := r.data.query.ReviewWebhookSubscription
+	return q.WithContext(ctx).Debug().
+		Where(q.OwnerType.Eq(ownerType), q.OwnerID.Eq(ownerID)).
+		Order(q.ID).
+		Find()
+}
+
+// ListWebhooksForStore 店铺自己的订阅加上全部应用订阅
+func (r *reviewRepo) ListWebhooksForStore(ctx context.Context, storeID int64) ([]*model.ReviewWebhookSubscription, error) {
+	q := r.data.query.ReviewWebhookSubscription
+	return q.WithContext(ctx).Debug().
+		Where(q.OwnerType.Eq(biz.WebhookOwnerStore), q.OwnerID.Eq(storeID)).
+		Or(q.OwnerType.Eq(biz.WebhookOwnerApp)).
+		Find()
+}
+
+func (r *reviewRepo) SaveWebhookDeliveries(ctx context.Context, deliveries []*model.ReviewWebhookDelivery) error {
+	return r.data.query.ReviewWebhookDelivery.WithContext(ctx).Debug().Create(deliveries...)
+}
+
+func (r *reviewRepo) ListWebhookDeliveries(ctx context.Context, subscriptionID int64, status int32, offset int32, limit int32) ([]*model.ReviewWebhookDelivery, int64, error) {
+	q := r.data.query.ReviewWebhookDelivery
+	conds := []gen.Condition{q.SubscriptionID.Eq(subscriptionID)}
+	if status > 0 {
+		conds = append(conds, q.Status.Eq(status))
+	}
+	return q.WithContext(ctx).Debug().Where(conds...).
+		Order(q.ID.Desc()).
+		FindByPage(int(offset), int(limit))
+}
+
+// ListDueWebhookDeliveries 到了投递时间的待投递记录，先进先出
+func (r *reviewRepo) ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.ReviewWebhookDelivery, error) {
+	q := r.data.query.ReviewWebhookDelivery
+	return q.WithContext(ctx).Debug().
+		Where(q.Status.Eq(biz.DeliveryPending), q.NextRetryAt.Lte(now)).
+		Order(q.NextRetryAt, q.ID).
+		Limit(limit).
+		Find()
+}
+
+func (r *reviewRepo) UpdateWebhookDelivery(ctx context.Context, delivery *model.ReviewWebhookDelivery) error {
+	q := r.data.query.ReviewWebhookDelivery
+	_, err := q.WithContext(ctx).Debug().
+		Where(q.DeliveryID.Eq(delivery.DeliveryID)).
+		Updates(map[string]interface{}{
+			"status":        delivery.Status,
+			"attempts":      delivery.Attempts,
+			"next_retry_at": delivery.NextRetryAt,
+			"response_code": delivery.ResponseCode,
+			"last_error":    delivery.LastError,
+		})
+	return err
+}
+
+// LockWebhookDelivery 拿到锁时返回token，释放时用token校验是不是自己的锁
+func (r *reviewRepo) LockWebhookDelivery(ctx context.Context, lease time.Duration) (string, error) {
+	return r.data.tryLock(ctx, webhookLockKey, lease)
+}
+
+func (r *reviewRepo) UnlockWebhookDelivery(ctx context.Context, token string) {
+	if err := r.data.unlock(ctx, webhookLockKey, token); err != nil {
+		r.log.WithContext(ctx).Errorf("unlock webhook delivery failed, err:%v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"time"
+)
+
+const defaultAutoReplyInterval = time.Minute
+
+// AutoReplier 定时按商家配置的规则自动回复评价
+type AutoReplier struct {
+	uc       *biz.ReviewerUsecase
+	interval time.Duration
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+func NewAutoReplier(c *conf.Job, uc *biz.ReviewerUsecase, logger log.Logger) *AutoReplier {
+	interval := c.GetAutoReplyInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultAutoReplyInterval
+	}
+	return &AutoReplier{
+		uc:       uc,
+		interval: interval,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (a *AutoReplier) Start(ctx context.Context) error {
+	a.log.Debug("auto replier start")
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-a.stop:
+			return nil
+		case <-ticker.C:
+			a.run(ctx)
+		}
+	}
+}
+
+func (a *AutoReplier) Stop(ctx context.Context) error {
+	a.log.Debug("auto replier stop.")
+	close(a.stop)
+	return nil
+}
+
+func (a *AutoReplier) run(ctx context.Context) {
+	n, err := a.uc.RunAutoReply(ctx, a.interval)
+	if err != nil {
+		a.log.Errorf("auto reply failed, err:%v", err)
+		return
+	}
+	if n > 0 {
+		a.log.Infof("auto reply, reviews:%d", n)
+	}
+}
@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"time"
+)
+
+const defaultExportInterval = 5 * time.Second
+
+// Exporter 定时领取导出任务生成文件，并清理过期文件
+type Exporter struct {
+	uc       *biz.ReviewerUsecase
+	interval time.Duration
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+func NewExporter(c *conf.Export, uc *biz.ReviewerUsecase, logger log.Logger) *Exporter {
+	interval := c.GetInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultExportInterval
+	}
+	return &Exporter{
+		uc:       uc,
+		interval: interval,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (e *Exporter) Start(ctx context.Context) error {
+	e.log.Debug("exporter start")
+	//停止时中断正在执行的任务，任务会被记为失败
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-e.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			e.uc.RunExports(ctx)
+		}
+	}
+}
+
+func (e *Exporter) Stop(ctx context.Context) error {
+	e.log.Debug("exporter stop.")
+	close(e.stop)
+	return nil
+}
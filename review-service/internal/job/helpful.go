@@ -0,0 +1,68 @@
+package job
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"time"
+)
+
+const defaultHelpfulFlushInterval = 10 * time.Second
+
+// HelpfulFlusher 定时把redis中的有用数刷回mysql，实现transport.Server随app启停
+type HelpfulFlusher struct {
+	uc       *biz.ReviewerUsecase
+	interval time.Duration
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+func NewHelpfulFlusher(c *conf.Job, uc *biz.ReviewerUsecase, logger log.Logger) *HelpfulFlusher {
+	interval := c.GetHelpfulFlushInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultHelpfulFlushInterval
+	}
+	return &HelpfulFlusher{
+		uc:       uc,
+		interval: interval,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (f *HelpfulFlusher) Start(ctx context.Context) error {
+	f.log.Debug("helpful flusher start")
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-f.stop:
+			//退出前把剩余的增量刷一次
+			f.flush(context.Background())
+			return nil
+		case <-ticker.C:
+			f.flush(ctx)
+		}
+	}
+}
+
+func (f *HelpfulFlusher) Stop(ctx context.Context) error {
+	f.log.Debug("helpful flusher stop.")
+	close(f.stop)
+	return nil
+}
+
+func (f *HelpfulFlusher) flush(ctx context.Context) {
+	//锁的租期和刷新间隔一致，实例挂掉后下个周期其他实例可以接手
+	n, err := f.uc.FlushHelpfulVotes(ctx, f.interval)
+	if err != nil {
+		f.log.Errorf("flush helpful votes failed, err:%v", err)
+		return
+	}
+	if n > 0 {
+		f.log.Infof("flush helpful votes, reviews:%d", n)
+	}
+}
@@ -0,0 +1,5 @@
+package job
+
+import "github.com/google/wire"
+
+var ProviderSet = wire.NewSet(NewHelpfulFlusher, NewAutoReplier, NewWebhookDeliverer, NewReconciler, NewExporter)
@@ -0,0 +1,69 @@
+package job
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"time"
+)
+
+const defaultReconcileInterval = time.Hour
+
+// Reconciler 定时对账mysql和ES，结果记录到review_reconcile_documents
+type Reconciler struct {
+	uc       *biz.ReviewerUsecase
+	interval time.Duration
+	opt      *biz.ReconcileOption
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+func NewReconciler(c *conf.Job, uc *biz.ReviewerUsecase, logger log.Logger) *Reconciler {
+	interval := c.GetReconcileInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultReconcileInterval
+	}
+	return &Reconciler{
+		uc:       uc,
+		interval: interval,
+		opt:      &biz.ReconcileOption{Batch: int(c.GetReconcileBatch()), Repair: c.GetReconcileRepair()},
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (r *Reconciler) Start(ctx context.Context) error {
+	r.log.Debug("reconciler start")
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case <-ticker.C:
+			r.run(ctx)
+		}
+	}
+}
+
+func (r *Reconciler) Stop(ctx context.Context) error {
+	r.log.Debug("reconciler stop.")
+	close(r.stop)
+	return nil
+}
+
+func (r *Reconciler) run(ctx context.Context) {
+	report, err := r.uc.RunReconcile(ctx, r.opt, r.interval)
+	if err != nil {
+		r.log.Errorf("reconcile failed, err:%v", err)
+		return
+	}
+	if report == nil {
+		return
+	}
+	r.log.Infof("reconcile, scanned:%d missing:%d stale:%d orphan:%d repaired:%d",
+		report.Scanned, report.Missing, report.Stale, report.Orphan, report.Repaired)
+}
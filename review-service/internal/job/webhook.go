@@ -0,0 +1,97 @@
+package job
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/pkg/webhook"
+	"time"
+)
+
+const (
+	defaultWebhookInterval    = 10 * time.Second
+	defaultWebhookTimeout     = 3 * time.Second
+	defaultWebhookMaxAttempts = 6
+	defaultWebhookBaseBackoff = 30 * time.Second
+	defaultWebhookMaxBackoff  = time.Hour
+)
+
+// WebhookDeliverer 定时投递待发送的webhook通知
+type WebhookDeliverer struct {
+	uc       *biz.ReviewerUsecase
+	sender   *webhook.Sender
+	policy   *biz.RetryPolicy
+	interval time.Duration
+	lease    time.Duration
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+func NewWebhookDeliverer(c *conf.Webhook, uc *biz.ReviewerUsecase, logger log.Logger) *WebhookDeliverer {
+	interval := c.GetInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultWebhookInterval
+	}
+	timeout := c.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	policy := &biz.RetryPolicy{
+		MaxAttempts: c.GetMaxAttempts(),
+		BaseBackoff: c.GetBaseBackoff().AsDuration(),
+		MaxBackoff:  c.GetMaxBackoff().AsDuration(),
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaultWebhookMaxAttempts
+	}
+	if policy.BaseBackoff <= 0 {
+		policy.BaseBackoff = defaultWebhookBaseBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultWebhookMaxBackoff
+	}
+	return &WebhookDeliverer{
+		uc:       uc,
+		sender:   webhook.NewSender(timeout),
+		policy:   policy,
+		interval: interval,
+		//一轮最多投递WebhookBatch条，每条最长timeout，锁的租期按最坏情况算，避免两个实例重复投递
+		lease: interval + biz.WebhookBatch*timeout,
+		stop:  make(chan struct{}),
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (w *WebhookDeliverer) Start(ctx context.Context) error {
+	w.log.Debug("webhook deliverer start")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.stop:
+			return nil
+		case <-ticker.C:
+			w.run(ctx)
+		}
+	}
+}
+
+func (w *WebhookDeliverer) Stop(ctx context.Context) error {
+	w.log.Debug("webhook deliverer stop.")
+	close(w.stop)
+	return nil
+}
+
+func (w *WebhookDeliverer) run(ctx context.Context) {
+	n, err := w.uc.RunWebhookDelivery(ctx, w.sender, w.policy, w.lease)
+	if err != nil {
+		w.log.Errorf("webhook delivery failed, err:%v", err)
+		return
+	}
+	if n > 0 {
+		w.log.Infof("webhook delivered:%d", n)
+	}
+}
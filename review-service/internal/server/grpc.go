@@ -1,22 +1,35 @@
 package server
 
 import (
+	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"review-common/health"
+	"review-common/telemetry"
 	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
 	"review-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, reviewer *service.ReviewService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, ac *conf.Auth, reviewer *service.ReviewService, h *health.Health, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
+		//用自己的health服务，按依赖检查结果返回状态
+		grpc.CustomHealth(),
 		grpc.Middleware(
 			validate.Validator(),
 			recovery.Recovery(),
+			tracing.Server(),
+			telemetry.ServerMetrics(),
+			metadata.Server(),
+			//review-b/review-o携带的调用方角色要校验签名
+			verifyRole(ac, logger),
+			readPrimary(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -29,6 +42,7 @@ func NewGRPCServer(c *conf.Server, reviewer *service.ReviewService, logger log.L
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
+	grpc_health_v1.RegisterHealthServer(srv, h.GRPC())
 	v1.RegisterReviewServer(srv, reviewer)
 	return srv
 }
@@ -2,21 +2,28 @@ package server
 
 import (
 	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"review-common/health"
+	"review-common/telemetry"
 	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
 	"review-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, reviewer *service.ReviewService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, reviewer *service.ReviewService, h *health.Health, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			validate.Validator(),
 			recovery.Recovery(),
+			tracing.Server(),
+			telemetry.ServerMetrics(),
+			readPrimary(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -29,6 +36,9 @@ func NewHTTPServer(c *conf.Server, reviewer *service.ReviewService, logger log.L
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
+	srv.HandleFunc("/healthz", h.Liveness)
+	srv.HandleFunc("/readyz", h.Readiness)
 	v1.RegisterReviewHTTPServer(srv, reviewer)
 	return srv
 }
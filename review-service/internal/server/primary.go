@@ -0,0 +1,24 @@
+package server
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"review-service/internal/biz"
+)
+
+// PrimaryHeader 请求头带上该值为1时，本次请求的读操作都走主库
+// 调用方刚写完马上读(如创建后跳详情页)时使用
+const PrimaryHeader = "x-read-primary"
+
+// readPrimary 把请求头里的主库标记放进context
+func readPrimary() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok && tr.RequestHeader().Get(PrimaryHeader) == "1" {
+				ctx = biz.WithPrimary(ctx)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package server
+
+import (
+	"github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestCheckRoleSign(t *testing.T) {
+	convey.Convey("role signatures", t, func() {
+		now := time.Unix(1700000000, 0)
+		sign := SignRole("secret", "operator", now.Unix())
+		convey.So(checkRoleSign("secret", "operator", sign, now, time.Minute), convey.ShouldBeTrue)
+		//签名的角色和声明的角色不一致
+		convey.So(checkRoleSign("secret", "merchant", sign, now, time.Minute), convey.ShouldBeFalse)
+		convey.So(checkRoleSign("other", "operator", sign, now, time.Minute), convey.ShouldBeFalse)
+		convey.So(checkRoleSign("", "operator", SignRole("", "operator", now.Unix()), now, time.Minute), convey.ShouldBeFalse)
+		convey.So(checkRoleSign("secret", "operator", sign, now.Add(2*time.Minute), time.Minute), convey.ShouldBeFalse)
+		convey.So(checkRoleSign("secret", "operator", "", now, time.Minute), convey.ShouldBeFalse)
+		convey.So(checkRoleSign("secret", "operator", "abc", now, time.Minute), convey.ShouldBeFalse)
+	})
+}
@@ -1,25 +1,38 @@
 package server
 
 import (
+	"context"
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/google/wire"
 	"github.com/hashicorp/consul/api"
+	"review-common/health"
 	"review-service/internal/conf"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewRegister, NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewConsulClient, NewRegister, NewHealth, NewGRPCServer, NewHTTPServer)
 
-func NewRegister(conf *conf.Registry) registry.Registrar {
+func NewConsulClient(conf *conf.Registry) (*api.Client, error) {
 	// new consul client
 	c := api.DefaultConfig()
 	c.Address = conf.Consul.Address
 	c.Scheme = conf.Consul.Scheme
-	client, err := api.NewClient(c)
-	if err != nil {
-		panic(err)
-	}
+	return api.NewClient(c)
+}
+
+func NewRegister(client *api.Client) registry.Registrar {
 	reg := consul.New(client, consul.WithHealthCheck(true))
 	return reg
 }
+
+// NewHealth 数据层的检查加上consul，consul不可用只影响注册，算降级
+// app通过Health注册，未就绪时从consul摘除，恢复后重新注册
+func NewHealth(c *conf.Health, probes health.Probes, client *api.Client, reg registry.Registrar, logger log.Logger) *health.Health {
+	probes = append(probes, health.Probe{Name: "consul", Check: func(ctx context.Context) error {
+		_, err := client.Status().LeaderWithQueryOptions((&api.QueryOptions{}).WithContext(ctx))
+		return err
+	}})
+	return health.New(probes, c.GetInterval().AsDuration(), c.GetTimeout().AsDuration(), logger, health.WithRegistrar(reg))
+}
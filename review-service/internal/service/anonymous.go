@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+)
+
+// 调用方角色，由review-b/review-o在请求头中携带并签名，server端校验通过后放进context
+// 没有签名或签名不对时按c端用户处理，HTTP入口一律按c端用户处理
+const (
+	RoleKey      = "x-md-local-role"
+	RoleSignKey  = "x-role-sign"
+	RoleMerchant = "merchant"
+	RoleOperator = "operator"
+)
+
+// 匿名评价统一展示的头像
+const anonymousAvatar = "https://avatar.example.com/anonymous.png"
+
+// anonymizer 匿名评价展示层，根据调用方角色决定是否隐藏真实用户
+type anonymizer struct {
+	salt string
+}
+
+func newAnonymizer(c *conf.Anonymous) *anonymizer {
+	return &anonymizer{salt: c.GetSalt()}
+}
+
+type roleKey struct{}
+
+// WithCallerRole 记下校验通过的调用方角色
+func WithCallerRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, roleKey{}, role)
+}
+
+// callerRole 只认校验过签名的角色，不读客户端传的metadata
+func callerRole(ctx context.Context) string {
+	role, _ := ctx.Value(roleKey{}).(string)
+	return role
+}
+
+// mask 返回对当前调用方可见的userID与展示名
+// 只有O端运营能看到匿名评价的真实用户，其他调用方拿到0和稳定的化名
+func (a *anonymizer) mask(ctx context.Context, userID int64, anonymous bool) (int64, string) {
+	if !anonymous || callerRole(ctx) == RoleOperator {
+		return userID, ""
+	}
+	return 0, a.pseudonym(userID)
+}
+
+func (a *anonymizer) pseudonym(userID int64) string {
+	return biz.Pseudonym(a.salt, userID)
+}
+
+// fillUser 填充列表中的用户信息，匿名评价对非运营方只展示化名和统一头像
+func (a *anonymizer) fillUser(ctx context.Context, info *pb.ReviewInfo, userID int64, users map[int64]*biz.UserProfile) {
+	var nickname string
+	info.UserID, nickname = a.mask(ctx, userID, info.Anonymous)
+	if info.UserID == 0 {
+		info.Nickname = nickname
+		info.Avatar = anonymousAvatar
+		return
+	}
+	if u, ok := users[userID]; ok {
+		info.Nickname = u.Nickname
+		info.Avatar = u.Avatar
+		info.Level = u.Level
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/smartystreets/goconvey/convey"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"testing"
+)
+
+func TestAnonymizer(t *testing.T) {
+	a := newAnonymizer(&conf.Anonymous{Salt: "salt"})
+	operator := WithCallerRole(context.Background(), RoleOperator)
+	merchant := WithCallerRole(context.Background(), RoleMerchant)
+
+	convey.Convey("only operators see the real user of anonymous reviews", t, func() {
+		userID, nickname := a.mask(operator, 7, true)
+		convey.So(userID, convey.ShouldEqual, 7)
+		convey.So(nickname, convey.ShouldBeEmpty)
+
+		userID, nickname = a.mask(merchant, 7, true)
+		convey.So(userID, convey.ShouldEqual, 0)
+		convey.So(nickname, convey.ShouldEqual, biz.Pseudonym("salt", 7))
+
+		userID, _ = a.mask(merchant, 7, false)
+		convey.So(userID, convey.ShouldEqual, 7)
+	})
+
+	convey.Convey("role from client metadata is not trusted", t, func() {
+		ctx := metadata.NewServerContext(context.Background(), metadata.Metadata{RoleKey: {RoleOperator}})
+		userID, nickname := a.mask(ctx, 7, true)
+		convey.So(userID, convey.ShouldEqual, 0)
+		convey.So(nickname, convey.ShouldNotBeEmpty)
+	})
+
+	convey.Convey("fill user profile or pseudonym", t, func() {
+		users := map[int64]*biz.UserProfile{7: {Nickname: "小明", Avatar: "a.png", Level: 3}}
+		info := &pb.ReviewInfo{Anonymous: true}
+		a.fillUser(merchant, info, 7, users)
+		convey.So(info.UserID, convey.ShouldEqual, 0)
+		convey.So(info.Nickname, convey.ShouldEqual, biz.Pseudonym("salt", 7))
+		convey.So(info.Avatar, convey.ShouldEqual, anonymousAvatar)
+		convey.So(info.Level, convey.ShouldEqual, 0)
+
+		info = &pb.ReviewInfo{Anonymous: true}
+		a.fillUser(operator, info, 7, users)
+		convey.So(info.UserID, convey.ShouldEqual, 7)
+		convey.So(info.Nickname, convey.ShouldEqual, "小明")
+		convey.So(info.Level, convey.ShouldEqual, 3)
+
+		//查不到用户资料时只填userID
+		info = &pb.ReviewInfo{}
+		a.fillUser(merchant, info, 8, users)
+		convey.So(info.UserID, convey.ShouldEqual, 8)
+		convey.So(info.Nickname, convey.ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"time"
+)
+
+// 下载时每条消息携带的文件字节数
+const exportChunkSize = 64 << 10
+
+// CreateReviewExport B端创建评价导出任务，筛选条件和ListStoreReviews相同
+func (s *ReviewService) CreateReviewExport(ctx context.Context, req *pb.CreateReviewExportRequest) (*pb.CreateReviewExportReply, error) {
+	fmt.Printf("[service] CreateReviewExport, req:#%v\n", req)
+	param := &biz.ExportParam{
+		Format: req.Format,
+		Filter: &biz.StoreReviewParam{
+			StoreID:   req.StoreID,
+			Unreplied: req.Unreplied,
+			MaxScore:  req.MaxScore,
+			HasMedia:  req.HasMedia,
+		},
+	}
+	if req.StartTime > 0 {
+		param.Filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		param.Filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+	exportID, err := s.uc.CreateReviewExport(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateReviewExportReply{ExportID: exportID}, nil
+}
+
+func (s *ReviewService) GetReviewExport(ctx context.Context, req *pb.GetReviewExportRequest) (*pb.GetReviewExportReply, error) {
+	fmt.Printf("[service] GetReviewExport, req:#%v\n", req)
+	export, err := s.uc.GetReviewExport(ctx, req.StoreID, req.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetReviewExportReply{Export: toExportInfo(export)}, nil
+}
+
+func (s *ReviewService) ListReviewExports(ctx context.Context, req *pb.ListReviewExportsRequest) (*pb.ListReviewExportsReply, error) {
+	fmt.Printf("[service] ListReviewExports, req:#%v\n", req)
+	ret, total, err := s.uc.ListReviewExports(ctx, req.StoreID, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewExportInfo, 0, len(ret))
+	for _, e := range ret {
+		list = append(list, toExportInfo(e))
+	}
+	return &pb.ListReviewExportsReply{List: list, Total: total}, nil
+}
+
+// DownloadReviewExport 分块读取导出文件，第一条消息带文件名和大小
+func (s *ReviewService) DownloadReviewExport(req *pb.DownloadReviewExportRequest, stream pb.Review_DownloadReviewExportServer) error {
+	fmt.Printf("[service] DownloadReviewExport, req:#%v\n", req)
+	ctx := stream.Context()
+	export, body, err := s.uc.OpenReviewExport(ctx, req.StoreID, req.ExportID)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	reply := &pb.DownloadReviewExportReply{FileName: export.FileName, FileSize: export.FileSize}
+	buf := make([]byte, exportChunkSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			reply.Chunk = buf[:n]
+			if err := stream.Send(reply); err != nil {
+				return err
+			}
+			reply = &pb.DownloadReviewExportReply{}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func toExportInfo(e *biz.ReviewExport) *pb.ReviewExportInfo {
+	info := &pb.ReviewExportInfo{
+		ExportID:    e.ExportID,
+		Format:      e.Format,
+		Status:      e.Status,
+		RowCount:    int64(e.RowCount),
+		FileSize:    e.FileSize,
+		FileName:    e.FileName,
+		Error:       e.LastError,
+		DownloadURL: e.DownloadURL,
+		CreateAt:    timestamppb.New(e.CreateAt),
+	}
+	if e.FinishAt != nil {
+		info.FinishAt = timestamppb.New(*e.FinishAt)
+	}
+	if e.ExpireAt != nil {
+		info.ExpireAt = timestamppb.New(*e.ExpireAt)
+	}
+	return info
+}
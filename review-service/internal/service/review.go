@@ -3,20 +3,26 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	pb "review-service/api/review/v1"
 	"review-service/internal/biz"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
+	"sort"
+	"strings"
 	"time"
 )
 
 type ReviewService struct {
 	pb.UnimplementedReviewServer
 
-	uc *biz.ReviewerUsecase
+	uc   *biz.ReviewerUsecase
+	anon *anonymizer
 }
 
-func NewReviewService(uc *biz.ReviewerUsecase) *ReviewService {
-	return &ReviewService{uc: uc}
+func NewReviewService(uc *biz.ReviewerUsecase, c *conf.Anonymous) *ReviewService {
+	return &ReviewService{uc: uc, anon: newAnonymizer(c)}
 }
 
 func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewReply, error) {
@@ -70,9 +76,178 @@ func (s *ReviewService) DeleteReview(ctx context.Context, req *pb.DeleteReviewRe
 func (s *ReviewService) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewReply, error) {
 	fmt.Printf("[service] GetReview, req:#%v\n", req)
 	reviewinfo, err := s.uc.GetReview(ctx, &model.ReviewInfo{ReviewID: req.GetReviewID()})
-	return reviewinfo, err
+	if err != nil {
+		return nil, err
+	}
+	//匿名评价按调用方角色脱敏
+	reviewinfo.UserID, reviewinfo.Nickname = s.anon.mask(ctx, reviewinfo.UserID, reviewinfo.Anonymous)
+	return reviewinfo, nil
+}
+
+// ListUserReviews c端我的评价，用户看自己的评价不做匿名处理
+func (s *ReviewService) ListUserReviews(ctx context.Context, req *pb.ListUserReviewsRequest) (*pb.ListUserReviewsReply, error) {
+	fmt.Printf("[service] ListUserReviews, req:#%v\n", req)
+	param := &biz.UserReviewParam{
+		UserID:   req.UserID,
+		Status:   req.Status,
+		HasReply: req.HasReply,
+	}
+	if req.StartTime > 0 {
+		param.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		param.EndTime = time.Unix(req.EndTime, 0)
+	}
+	ret, total, err := s.uc.ListUserReviews(ctx, param, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.UserReviewInfo, 0, len(ret))
+	for _, r := range ret {
+		list = append(list, &pb.UserReviewInfo{
+			ReviewID:     r.ReviewID,
+			OrderID:      r.OrderID,
+			StoreID:      r.StoreID,
+			SkuID:        r.SkuID,
+			SpuID:        r.SpuID,
+			Score:        r.Score,
+			ServiceScore: r.ServiceScore,
+			ExpressScore: r.ExpressScore,
+			Content:      r.Content,
+			PicInfo:      r.PicInfo,
+			VideoInfo:    r.VideoInfo,
+			Status:       r.Status,
+			Anonymous:    r.Anonymous == 1,
+			HasReply:     r.HasReply == 1,
+			HelpfulCount: r.HelpfulCount,
+			OpReason:     r.OpReason,
+			CreateAt:     timestamppb.New(r.CreateAt),
+		})
+	}
+	return &pb.ListUserReviewsReply{List: list, Total: total}, nil
 }
 
+func (s *ReviewService) BatchGetReviews(ctx context.Context, req *pb.BatchGetReviewsRequest) (*pb.BatchGetReviewsReply, error) {
+	fmt.Printf("[service] BatchGetReviews, req:#%v\n", req)
+	ret, err := s.uc.BatchGetReviews(ctx, req.ReviewIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchGetReviewsReply{List: s.toBatchReviewInfos(ctx, ret.List), NotFound: ret.NotFound}, nil
+}
+
+func (s *ReviewService) GetReviewsByOrderIDs(ctx context.Context, req *pb.GetReviewsByOrderIDsRequest) (*pb.GetReviewsByOrderIDsReply, error) {
+	fmt.Printf("[service] GetReviewsByOrderIDs, req:#%v\n", req)
+	ret, err := s.uc.GetReviewsByOrderIDs(ctx, req.OrderIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetReviewsByOrderIDsReply{List: s.toBatchReviewInfos(ctx, ret.List), NotFound: ret.NotFound}, nil
+}
+
+// toBatchReviewInfos 匿名评价按调用方角色脱敏
+func (s *ReviewService) toBatchReviewInfos(ctx context.Context, reviews []*model.ReviewInfo) []*pb.BatchReviewInfo {
+	list := make([]*pb.BatchReviewInfo, 0, len(reviews))
+	for _, r := range reviews {
+		userID, nickname := s.anon.mask(ctx, r.UserID, r.Anonymous == 1)
+		list = append(list, &pb.BatchReviewInfo{
+			ReviewID:     r.ReviewID,
+			OrderID:      r.OrderID,
+			StoreID:      r.StoreID,
+			UserID:       userID,
+			Nickname:     nickname,
+			Score:        r.Score,
+			ServiceScore: r.ServiceScore,
+			ExpressScore: r.ExpressScore,
+			Content:      r.Content,
+			PicInfo:      r.PicInfo,
+			VideoInfo:    r.VideoInfo,
+			Status:       r.Status,
+			Anonymous:    r.Anonymous == 1,
+			HasReply:     r.HasReply == 1,
+			CreateAt:     timestamppb.New(r.CreateAt),
+		})
+	}
+	return list
+}
+
+// GetReviewDetail 评价详情，申诉只给运营和评价所属店铺的商家，审核人只给运营
+func (s *ReviewService) GetReviewDetail(ctx context.Context, req *pb.GetReviewDetailRequest) (*pb.GetReviewDetailReply, error) {
+	fmt.Printf("[service] GetReviewDetail, req:#%v\n", req)
+	role := callerRole(ctx)
+	param := &biz.ReviewDetailParam{ReviewID: req.ReviewID}
+	switch role {
+	case RoleOperator:
+		param.WithAppeal = true
+	case RoleMerchant:
+		param.StoreID = req.StoreID
+		param.WithAppeal = req.StoreID > 0
+	}
+	detail, err := s.uc.GetReviewDetail(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	r := detail.Review
+	review := &pb.ReviewDetail{
+		ReviewID:     r.ReviewID,
+		Anonymous:    r.Anonymous == 1,
+		OrderID:      r.OrderID,
+		StoreID:      r.StoreID,
+		SkuID:        r.SkuID,
+		SpuID:        r.SpuID,
+		Score:        r.Score,
+		ServiceScore: r.ServiceScore,
+		ExpressScore: r.ExpressScore,
+		Content:      r.Content,
+		PicInfo:      r.PicInfo,
+		VideoInfo:    r.VideoInfo,
+		Tags:         r.Tags,
+		Status:       r.Status,
+		HasReply:     r.HasReply == 1,
+		HelpfulCount: r.HelpfulCount,
+		CreateAt:     timestamppb.New(r.CreateAt),
+		UpdateAt:     timestamppb.New(r.UpdateAt),
+	}
+	//匿名评价按调用方角色脱敏
+	review.UserID, review.Nickname = s.anon.mask(ctx, r.UserID, review.Anonymous)
+	if role == RoleOperator {
+		review.OpReason, review.OpRemarks, review.OpUser = r.OpReason, r.OpRemarks, r.OpUser
+	}
+	reply := &pb.GetReviewDetailReply{Review: review}
+	if rp := detail.Reply; rp != nil {
+		reply.Reply = &pb.ReviewReplyDetail{
+			ReplyID:   rp.ReplyID,
+			StoreID:   rp.StoreID,
+			Content:   rp.Content,
+			PicInfo:   rp.PicInfo,
+			VideoInfo: rp.VideoInfo,
+			CreateAt:  timestamppb.New(rp.CreateAt),
+			UpdateAt:  timestamppb.New(rp.UpdateAt),
+		}
+	}
+	if a := detail.Appeal; a != nil {
+		reply.Appeal = &pb.ReviewAppealDetail{
+			AppealID:  a.AppealID,
+			Round:     a.Round,
+			Status:    a.Status,
+			Reason:    a.Reason,
+			Content:   a.Content,
+			PicInfo:   a.PicInfo,
+			VideoInfo: a.VideoInfo,
+			OpRemarks: a.OpRemarks,
+			CreateAt:  timestamppb.New(a.CreateAt),
+			UpdateAt:  timestamppb.New(a.UpdateAt),
+		}
+		if role == RoleOperator {
+			reply.Appeal.OpUser = a.OpUser
+		}
+	}
+	return reply, nil
+}
+
+// GetAllReviews 已废弃，保留给老调用方
+//
+// Deprecated: 使用ListUserReviews
 func (s *ReviewService) GetAllReviews(ctx context.Context, req *pb.GetAllReviewsRequest) (*pb.GetAllReviewsReply, error) {
 	fmt.Printf("[service] GetAllReviews, req:#%v\n", req)
 	reviews, err := s.uc.GetAllReviews(ctx, &biz.GetAllReviewsParam{
@@ -178,14 +353,61 @@ func (s *ReviewService) AuditReview(ctx context.Context, req *pb.AuditReviewRequ
 	return &pb.AuditReviewReply{}, nil
 }
 
+// BatchAuditReviews O端批量审核评价
+func (s *ReviewService) BatchAuditReviews(ctx context.Context, req *pb.BatchAuditReviewsRequest) (*pb.BatchAuditReply, error) {
+	fmt.Printf("[service] BatchAuditReviews, req:#%v\n", req)
+	ret := s.uc.BatchAuditReviews(ctx, req.ReviewIDs, &biz.AuditReviewParam{
+		OpUser:    req.OpUser,
+		OpReason:  req.OpReason,
+		OpRemarks: req.OpRemarks,
+		Status:    req.Status,
+	})
+	return toBatchAuditReply(ret), nil
+}
+
+// BatchAuditAppeals O端批量审核申诉
+func (s *ReviewService) BatchAuditAppeals(ctx context.Context, req *pb.BatchAuditAppealsRequest) (*pb.BatchAuditReply, error) {
+	fmt.Printf("[service] BatchAuditAppeals, req:#%v\n", req)
+	ret := s.uc.BatchAuditAppeals(ctx, req.AppealIDs, &biz.AuditAppealParam{
+		OpUser:    req.OpUser,
+		OpReason:  req.OpReason,
+		OpRemarks: req.OpRemarks,
+		Status:    req.Status,
+	})
+	return toBatchAuditReply(ret), nil
+}
+
+func toBatchAuditReply(ret []*biz.AuditResult) *pb.BatchAuditReply {
+	reply := &pb.BatchAuditReply{Results: make([]*pb.AuditResult, 0, len(ret))}
+	for _, r := range ret {
+		result := &pb.AuditResult{Id: r.ID, Success: r.Err == nil}
+		if r.Err != nil {
+			e := errors.FromError(r.Err)
+			result.Code, result.Reason, result.Message = e.Code, e.Reason, e.Message
+			reply.Failed++
+		} else {
+			reply.Succeeded++
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	return reply
+}
+
 // 商家ID搜索评价列表
 func (s *ReviewService) ListReviewByStoreID(ctx context.Context, req *pb.ListReviewByStoreIDRequest) (*pb.ListReviewByStoreIDReply, error) {
 	fmt.Printf("[service] ListReviewByStoreID, req:#%v\n", req)
-	ret, err := s.uc.ListReviewByStoreID(ctx, req.StoreID, req.Page, req.Size)
-	list := make([]*pb.ReviewInfo, 0, len(ret))
-	for _, r := range ret {
-		list = append(list, &pb.ReviewInfo{
-			UserID:       r.UserID,
+	ret, err := s.uc.ListReviewByStoreID(ctx, &biz.ListReviewParam{
+		StoreID: req.StoreID,
+		Sort:    req.Sort,
+		Cursor:  req.Cursor,
+	}, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	users := s.uc.GetUserProfiles(ctx, ret.List)
+	list := make([]*pb.ReviewInfo, 0, len(ret.List))
+	for _, r := range ret.List {
+		info := &pb.ReviewInfo{
 			OrderID:      r.OrderID,
 			Score:        r.Score,
 			ServiceScore: r.ServiceScore,
@@ -194,18 +416,211 @@ func (s *ReviewService) ListReviewByStoreID(ctx context.Context, req *pb.ListRev
 			PicInfo:      r.PicInfo,
 			VideoInfo:    r.VideoInfo,
 			Status:       r.Status,
-		})
+			Anonymous:    r.Anonymous == 1,
+			ReviewID:     r.ReviewID,
+			HelpfulCount: r.HelpfulCount,
+		}
+		s.anon.fillUser(ctx, info, r.UserID, users)
+		list = append(list, info)
 	}
-	return &pb.ListReviewByStoreIDReply{List: list}, err
+	return &pb.ListReviewByStoreIDReply{List: list, Degraded: ret.Degraded, NextCursor: ret.NextCursor}, nil
 }
 
 func (s *ReviewService) ListReviewByContent(ctx context.Context, req *pb.ListReviewByContentRequest) (*pb.ListReviewByContentReply, error) {
 	fmt.Printf("[service] ListReviewByContent, req:#%v\n", req)
-	ret, err := s.uc.ListReviewByContent(ctx, req.Page, req.Size)
-	list := make([]*pb.ReviewInfo, 0, len(ret))
+	ret, err := s.uc.ListReviewByContent(ctx, &biz.ListReviewParam{Cursor: req.Cursor}, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	users := s.uc.GetUserProfiles(ctx, ret.List)
+	list := make([]*pb.ReviewInfo, 0, len(ret.List))
+	for _, r := range ret.List {
+		info := &pb.ReviewInfo{
+			OrderID:      r.OrderID,
+			Score:        r.Score,
+			ServiceScore: r.ServiceScore,
+			ExpressScore: r.ExpressScore,
+			Content:      r.Content,
+			PicInfo:      r.PicInfo,
+			VideoInfo:    r.VideoInfo,
+			Status:       r.Status,
+			Anonymous:    r.Anonymous == 1,
+			ReviewID:     r.ReviewID,
+			HelpfulCount: r.HelpfulCount,
+		}
+		s.anon.fillUser(ctx, info, r.UserID, users)
+		list = append(list, info)
+	}
+	return &pb.ListReviewByContentReply{List: list, Degraded: ret.Degraded, NextCursor: ret.NextCursor}, nil
+}
+
+func (s *ReviewService) VoteReview(ctx context.Context, req *pb.VoteReviewRequest) (*pb.VoteReviewReply, error) {
+	fmt.Printf("[service] VoteReview, req:#%v\n", req)
+	err := s.uc.VoteReview(ctx, &biz.VoteParam{
+		ReviewID: req.ReviewID,
+		UserID:   req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VoteReviewReply{}, nil
+}
+
+func (s *ReviewService) UnvoteReview(ctx context.Context, req *pb.UnvoteReviewRequest) (*pb.UnvoteReviewReply, error) {
+	fmt.Printf("[service] UnvoteReview, req:#%v\n", req)
+	err := s.uc.UnvoteReview(ctx, &biz.VoteParam{
+		ReviewID: req.ReviewID,
+		UserID:   req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnvoteReviewReply{}, nil
+}
+
+func (s *ReviewService) ReportReview(ctx context.Context, req *pb.ReportReviewRequest) (*pb.ReportReviewReply, error) {
+	fmt.Printf("[service] ReportReview, req:#%v\n", req)
+	reportID, err := s.uc.ReportReview(ctx, &biz.ReportParam{
+		ReviewID: req.ReviewID,
+		UserID:   req.UserID,
+		Reason:   req.Reason,
+		Content:  req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReportReviewReply{ReportID: reportID}, nil
+}
+
+// ListReportedReviews O端查询被举报的评价
+func (s *ReviewService) ListReportedReviews(ctx context.Context, req *pb.ListReportedReviewsRequest) (*pb.ListReportedReviewsReply, error) {
+	fmt.Printf("[service] ListReportedReviews, req:#%v\n", req)
+	ret, err := s.uc.ListReportedReviews(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReportedReviewInfo, 0, len(ret))
+	for _, r := range ret {
+		userID, _ := s.anon.mask(ctx, r.UserID, r.Anonymous == 1)
+		reasons := make([]*pb.ReportReasonCount, 0, len(r.Reasons))
+		for reason, count := range r.Reasons {
+			reasons = append(reasons, &pb.ReportReasonCount{Reason: reason, Count: count})
+		}
+		sort.Slice(reasons, func(i, j int) bool { return reasons[i].Reason < reasons[j].Reason })
+		list = append(list, &pb.ReportedReviewInfo{
+			ReviewID:    r.ReviewID,
+			StoreID:     r.StoreID,
+			UserID:      userID,
+			Content:     r.Content,
+			PicInfo:     r.PicInfo,
+			VideoInfo:   r.VideoInfo,
+			Status:      r.Status,
+			ReportCount: r.ReportCount,
+			Reasons:     reasons,
+		})
+	}
+	return &pb.ListReportedReviewsReply{List: list}, nil
+}
+
+// ListPendingReviews O端查询待审核评价
+func (s *ReviewService) ListPendingReviews(ctx context.Context, req *pb.ListPendingReviewsRequest) (*pb.ListPendingReviewsReply, error) {
+	fmt.Printf("[service] ListPendingReviews, req:#%v\n", req)
+	param := &biz.PendingParam{
+		StoreID:        req.StoreID,
+		HasMedia:       req.HasMedia,
+		MinReportCount: req.MinReportCount,
+	}
+	if req.CreatedBefore > 0 {
+		param.CreatedBefore = time.Unix(req.CreatedBefore, 0)
+	}
+	ret, err := s.uc.ListPendingReviews(ctx, param, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.PendingReviewInfo, 0, len(ret.List))
+	for _, r := range ret.List {
+		userID, _ := s.anon.mask(ctx, r.UserID, r.Anonymous == 1)
+		list = append(list, &pb.PendingReviewInfo{
+			ReviewID:    r.ReviewID,
+			StoreID:     r.StoreID,
+			UserID:      userID,
+			OrderID:     r.OrderID,
+			Score:       r.Score,
+			Content:     r.Content,
+			PicInfo:     r.PicInfo,
+			VideoInfo:   r.VideoInfo,
+			HasMedia:    r.HasMedia,
+			ReportCount: r.ReportCount,
+			Anonymous:   r.Anonymous == 1,
+			CreateAt:    r.CreateAt.Unix(),
+		})
+	}
+	reply := &pb.ListPendingReviewsReply{List: list, Total: ret.Total}
+	if !ret.OldestCreateAt.IsZero() {
+		reply.OldestCreateAt = ret.OldestCreateAt.Unix()
+	}
+	return reply, nil
+}
+
+// ListPendingAppeals O端查询待审核申诉
+func (s *ReviewService) ListPendingAppeals(ctx context.Context, req *pb.ListPendingAppealsRequest) (*pb.ListPendingAppealsReply, error) {
+	fmt.Printf("[service] ListPendingAppeals, req:#%v\n", req)
+	param := &biz.PendingParam{StoreID: req.StoreID}
+	if req.CreatedBefore > 0 {
+		param.CreatedBefore = time.Unix(req.CreatedBefore, 0)
+	}
+	ret, err := s.uc.ListPendingAppeals(ctx, param, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.PendingAppealInfo, 0, len(ret.List))
+	for _, a := range ret.List {
+		list = append(list, &pb.PendingAppealInfo{
+			AppealID:  a.AppealID,
+			ReviewID:  a.ReviewID,
+			StoreID:   a.StoreID,
+			Reason:    a.Reason,
+			Content:   a.Content,
+			PicInfo:   a.PicInfo,
+			VideoInfo: a.VideoInfo,
+			CreateAt:  a.CreateAt.Unix(),
+		})
+	}
+	reply := &pb.ListPendingAppealsReply{List: list, Total: ret.Total}
+	if !ret.OldestCreateAt.IsZero() {
+		reply.OldestCreateAt = ret.OldestCreateAt.Unix()
+	}
+	return reply, nil
+}
+
+// ListStoreReviews B端查询本店的评价
+func (s *ReviewService) ListStoreReviews(ctx context.Context, req *pb.ListStoreReviewsRequest) (*pb.ListStoreReviewsReply, error) {
+	fmt.Printf("[service] ListStoreReviews, req:#%v\n", req)
+	param := &biz.StoreReviewParam{
+		StoreID:   req.StoreID,
+		Unreplied: req.Unreplied,
+		MaxScore:  req.MaxScore,
+		HasMedia:  req.HasMedia,
+	}
+	if req.StartTime > 0 {
+		param.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		param.EndTime = time.Unix(req.EndTime, 0)
+	}
+	ret, total, err := s.uc.ListStoreReviews(ctx, param, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(ret))
+	for _, r := range ret {
+		ids = append(ids, r.UserID)
+	}
+	users := s.uc.GetUsers(ctx, ids)
+	list := make([]*pb.StoreReviewInfo, 0, len(ret))
 	for _, r := range ret {
-		list = append(list, &pb.ReviewInfo{
-			UserID:       r.UserID,
+		info := &pb.StoreReviewInfo{
+			ReviewID:     r.ReviewID,
 			OrderID:      r.OrderID,
 			Score:        r.Score,
 			ServiceScore: r.ServiceScore,
@@ -214,7 +629,312 @@ func (s *ReviewService) ListReviewByContent(ctx context.Context, req *pb.ListRev
 			PicInfo:      r.PicInfo,
 			VideoInfo:    r.VideoInfo,
 			Status:       r.Status,
+			Anonymous:    r.Anonymous == 1,
+			HasReply:     r.HasReply == 1,
+			CreateAt:     r.CreateAt.Unix(),
+		}
+		info.UserID, info.Nickname = s.anon.mask(ctx, r.UserID, info.Anonymous)
+		if u, ok := users[info.UserID]; ok {
+			info.Nickname = u.Nickname
+		}
+		list = append(list, info)
+	}
+	return &pb.ListStoreReviewsReply{List: list, Total: total}, nil
+}
+
+// ListStoreAppeals B端查询本店的申诉
+func (s *ReviewService) ListStoreAppeals(ctx context.Context, req *pb.ListStoreAppealsRequest) (*pb.ListStoreAppealsReply, error) {
+	fmt.Printf("[service] ListStoreAppeals, req:#%v\n", req)
+	ret, total, err := s.uc.ListStoreAppeals(ctx, req.StoreID, req.Status, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AppealInfo, 0, len(ret))
+	for _, a := range ret {
+		list = append(list, toAppealInfo(a))
+	}
+	return &pb.ListStoreAppealsReply{List: list, Total: total}, nil
+}
+
+// GetAppeal B端查询本店的一条申诉
+func (s *ReviewService) GetAppeal(ctx context.Context, req *pb.GetAppealRequest) (*pb.GetAppealReply, error) {
+	fmt.Printf("[service] GetAppeal, req:#%v\n", req)
+	appeal, err := s.uc.GetAppeal(ctx, req.StoreID, req.AppealID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAppealReply{Appeal: toAppealInfo(appeal)}, nil
+}
+
+func toAppealInfo(a *model.ReviewAppealInfo) *pb.AppealInfo {
+	return &pb.AppealInfo{
+		AppealID:  a.AppealID,
+		ReviewID:  a.ReviewID,
+		StoreID:   a.StoreID,
+		Status:    a.Status,
+		Reason:    a.Reason,
+		Content:   a.Content,
+		PicInfo:   a.PicInfo,
+		VideoInfo: a.VideoInfo,
+		OpRemarks: a.OpRemarks,
+		CreateAt:  a.CreateAt.Unix(),
+		UpdateAt:  a.UpdateAt.Unix(),
+		Round:     a.Round,
+	}
+}
+
+// SupplementAppeal B端补充申诉材料
+func (s *ReviewService) SupplementAppeal(ctx context.Context, req *pb.SupplementAppealRequest) (*pb.SupplementAppealReply, error) {
+	fmt.Printf("[service] SupplementAppeal, req:#%v\n", req)
+	err := s.uc.SupplementAppeal(ctx, &biz.SupplementAppealParam{
+		StoreID:   req.StoreID,
+		AppealID:  req.AppealID,
+		Content:   req.Content,
+		PicInfo:   req.PicInfo,
+		VideoInfo: req.VideoInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SupplementAppealReply{}, nil
+}
+
+// WithdrawAppeal B端撤回申诉
+func (s *ReviewService) WithdrawAppeal(ctx context.Context, req *pb.WithdrawAppealRequest) (*pb.WithdrawAppealReply, error) {
+	fmt.Printf("[service] WithdrawAppeal, req:#%v\n", req)
+	if err := s.uc.WithdrawAppeal(ctx, req.StoreID, req.AppealID, req.Reason); err != nil {
+		return nil, err
+	}
+	return &pb.WithdrawAppealReply{}, nil
+}
+
+// RequestAppealInfo O端要求商家补充申诉材料
+func (s *ReviewService) RequestAppealInfo(ctx context.Context, req *pb.RequestAppealInfoRequest) (*pb.RequestAppealInfoReply, error) {
+	fmt.Printf("[service] RequestAppealInfo, req:#%v\n", req)
+	if err := s.uc.RequestAppealInfo(ctx, req.AppealID, req.OpUser, req.Content); err != nil {
+		return nil, err
+	}
+	return &pb.RequestAppealInfoReply{}, nil
+}
+
+// GetAppealHistory 查询评价的申诉轮次和沟通记录，运营不校验店铺
+func (s *ReviewService) GetAppealHistory(ctx context.Context, req *pb.GetAppealHistoryRequest) (*pb.GetAppealHistoryReply, error) {
+	fmt.Printf("[service] GetAppealHistory, req:#%v\n", req)
+	storeID := req.StoreID
+	if callerRole(ctx) == RoleOperator {
+		storeID = 0
+	} else if storeID == 0 {
+		return nil, pb.ErrorInvalidParams("storeID不能为空")
+	}
+	history, err := s.uc.GetAppealHistory(ctx, storeID, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	appeals := make([]*pb.AppealInfo, 0, len(history.Appeals))
+	for _, a := range history.Appeals {
+		appeals = append(appeals, toAppealInfo(a))
+	}
+	messages := make([]*pb.AppealMessageInfo, 0, len(history.Messages))
+	for _, m := range history.Messages {
+		messages = append(messages, &pb.AppealMessageInfo{
+			MessageID:  m.MessageID,
+			AppealID:   m.AppealID,
+			SenderType: m.SenderType,
+			Sender:     m.Sender,
+			Action:     m.Action,
+			Content:    m.Content,
+			PicInfo:    m.PicInfo,
+			VideoInfo:  m.VideoInfo,
+			CreateAt:   m.CreateAt.Unix(),
+		})
+	}
+	return &pb.GetAppealHistoryReply{Appeals: appeals, Messages: messages}, nil
+}
+
+// CreateReplyTemplate B端新建回复模板
+func (s *ReviewService) CreateReplyTemplate(ctx context.Context, req *pb.CreateReplyTemplateRequest) (*pb.CreateReplyTemplateReply, error) {
+	fmt.Printf("[service] CreateReplyTemplate, req:#%v\n", req)
+	templateID, err := s.uc.CreateTemplate(ctx, &biz.TemplateParam{
+		StoreID: req.StoreID,
+		Name:    req.Name,
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateReplyTemplateReply{TemplateID: templateID}, nil
+}
+
+func (s *ReviewService) UpdateReplyTemplate(ctx context.Context, req *pb.UpdateReplyTemplateRequest) (*pb.UpdateReplyTemplateReply, error) {
+	fmt.Printf("[service] UpdateReplyTemplate, req:#%v\n", req)
+	err := s.uc.UpdateTemplate(ctx, &biz.TemplateParam{
+		StoreID:    req.StoreID,
+		TemplateID: req.TemplateID,
+		Name:       req.Name,
+		Content:    req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReplyTemplateReply{}, nil
+}
+
+func (s *ReviewService) DeleteReplyTemplate(ctx context.Context, req *pb.DeleteReplyTemplateRequest) (*pb.DeleteReplyTemplateReply, error) {
+	fmt.Printf("[service] DeleteReplyTemplate, req:#%v\n", req)
+	if err := s.uc.DeleteTemplate(ctx, req.StoreID, req.TemplateID); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteReplyTemplateReply{}, nil
+}
+
+func (s *ReviewService) ListReplyTemplates(ctx context.Context, req *pb.ListReplyTemplatesRequest) (*pb.ListReplyTemplatesReply, error) {
+	fmt.Printf("[service] ListReplyTemplates, req:#%v\n", req)
+	ret, err := s.uc.ListTemplates(ctx, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReplyTemplateInfo, 0, len(ret))
+	for _, t := range ret {
+		list = append(list, &pb.ReplyTemplateInfo{
+			TemplateID: t.TemplateID,
+			StoreID:    t.StoreID,
+			Name:       t.Name,
+			Content:    t.Content,
+			UpdateAt:   t.UpdateAt.Unix(),
+		})
+	}
+	return &pb.ListReplyTemplatesReply{List: list}, nil
+}
+
+// CreateAutoReplyRule B端新建自动回复规则
+func (s *ReviewService) CreateAutoReplyRule(ctx context.Context, req *pb.CreateAutoReplyRuleRequest) (*pb.CreateAutoReplyRuleReply, error) {
+	fmt.Printf("[service] CreateAutoReplyRule, req:#%v\n", req)
+	ruleID, err := s.uc.CreateAutoReplyRule(ctx, &biz.AutoReplyRuleParam{
+		StoreID:      req.StoreID,
+		TemplateID:   req.TemplateID,
+		MinScore:     req.MinScore,
+		MaxScore:     req.MaxScore,
+		OnlyEmpty:    req.OnlyEmpty,
+		DelaySeconds: req.DelaySeconds,
+		Enabled:      req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateAutoReplyRuleReply{RuleID: ruleID}, nil
+}
+
+func (s *ReviewService) UpdateAutoReplyRule(ctx context.Context, req *pb.UpdateAutoReplyRuleRequest) (*pb.UpdateAutoReplyRuleReply, error) {
+	fmt.Printf("[service] UpdateAutoReplyRule, req:#%v\n", req)
+	err := s.uc.UpdateAutoReplyRule(ctx, &biz.AutoReplyRuleParam{
+		StoreID:      req.StoreID,
+		RuleID:       req.RuleID,
+		TemplateID:   req.TemplateID,
+		MinScore:     req.MinScore,
+		MaxScore:     req.MaxScore,
+		OnlyEmpty:    req.OnlyEmpty,
+		DelaySeconds: req.DelaySeconds,
+		Enabled:      req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateAutoReplyRuleReply{}, nil
+}
+
+func (s *ReviewService) DeleteAutoReplyRule(ctx context.Context, req *pb.DeleteAutoReplyRuleRequest) (*pb.DeleteAutoReplyRuleReply, error) {
+	fmt.Printf("[service] DeleteAutoReplyRule, req:#%v\n", req)
+	if err := s.uc.DeleteAutoReplyRule(ctx, req.StoreID, req.RuleID); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAutoReplyRuleReply{}, nil
+}
+
+func (s *ReviewService) ListAutoReplyRules(ctx context.Context, req *pb.ListAutoReplyRulesRequest) (*pb.ListAutoReplyRulesReply, error) {
+	fmt.Printf("[service] ListAutoReplyRules, req:#%v\n", req)
+	ret, err := s.uc.ListAutoReplyRules(ctx, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AutoReplyRuleInfo, 0, len(ret))
+	for _, r := range ret {
+		list = append(list, &pb.AutoReplyRuleInfo{
+			RuleID:       r.RuleID,
+			StoreID:      r.StoreID,
+			TemplateID:   r.TemplateID,
+			MinScore:     r.MinScore,
+			MaxScore:     r.MaxScore,
+			OnlyEmpty:    r.OnlyEmpty == 1,
+			DelaySeconds: r.DelaySeconds,
+			Enabled:      r.Enabled == 1,
+		})
+	}
+	return &pb.ListAutoReplyRulesReply{List: list}, nil
+}
+
+// CreateWebhook 新建webhook订阅
+func (s *ReviewService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookReply, error) {
+	fmt.Printf("[service] CreateWebhook, req:#%v\n", req)
+	sub, err := s.uc.CreateWebhook(ctx, &biz.WebhookParam{
+		OwnerType: req.OwnerType,
+		OwnerID:   req.OwnerID,
+		URL:       req.Url,
+		Events:    req.Events,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateWebhookReply{SubscriptionID: sub.SubscriptionID, Secret: sub.Secret}, nil
+}
+
+func (s *ReviewService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookReply, error) {
+	fmt.Printf("[service] DeleteWebhook, req:#%v\n", req)
+	if err := s.uc.DeleteWebhook(ctx, req.OwnerType, req.OwnerID, req.SubscriptionID); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteWebhookReply{}, nil
+}
+
+func (s *ReviewService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksReply, error) {
+	fmt.Printf("[service] ListWebhooks, req:#%v\n", req)
+	ret, err := s.uc.ListWebhooks(ctx, req.OwnerType, req.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.WebhookInfo, 0, len(ret))
+	for _, sub := range ret {
+		list = append(list, &pb.WebhookInfo{
+			SubscriptionID: sub.SubscriptionID,
+			OwnerType:      sub.OwnerType,
+			OwnerID:        sub.OwnerID,
+			Url:            sub.URL,
+			Events:         strings.Split(sub.Events, ","),
+			CreateAt:       sub.CreateAt.Unix(),
+		})
+	}
+	return &pb.ListWebhooksReply{List: list}, nil
+}
+
+// ListWebhookDeliveries 查询订阅的投递记录，排查对方没收到通知
+func (s *ReviewService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesReply, error) {
+	fmt.Printf("[service] ListWebhookDeliveries, req:#%v\n", req)
+	ret, total, err := s.uc.ListWebhookDeliveries(ctx, req.OwnerType, req.OwnerID, req.SubscriptionID, req.Status, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.WebhookDeliveryInfo, 0, len(ret))
+	for _, d := range ret {
+		list = append(list, &pb.WebhookDeliveryInfo{
+			DeliveryID:   d.DeliveryID,
+			Event:        d.Event,
+			Payload:      d.Payload,
+			Status:       d.Status,
+			Attempts:     d.Attempts,
+			ResponseCode: d.ResponseCode,
+			LastError:    d.LastError,
+			NextRetryAt:  d.NextRetryAt.Unix(),
+			CreateAt:     d.CreateAt.Unix(),
 		})
 	}
-	return &pb.ListReviewByContentReply{List: list}, err
+	return &pb.ListWebhookDeliveriesReply{List: list, Total: total}, nil
 }
@@ -0,0 +1,41 @@
+package sheet
+
+import (
+	"bytes"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/xuri/excelize/v2"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	convey.Convey("csv has a bom and escapes formulas", t, func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(FormatCSV, &buf)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(w.Write([]string{"评价ID", "内容"}), convey.ShouldBeNil)
+		convey.So(w.Write([]string{"1", "=HYPERLINK(\"x\")"}), convey.ShouldBeNil)
+		convey.So(w.Close(), convey.ShouldBeNil)
+		convey.So(buf.String(), convey.ShouldEqual, "\xEF\xBB\xBF评价ID,内容\n1,\"'=HYPERLINK(\"\"x\"\")\"\n")
+	})
+
+	convey.Convey("xlsx rows read back", t, func() {
+		var buf bytes.Buffer
+		w, err := NewWriter(FormatXLSX, &buf)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(w.Write([]string{"评价ID", "内容"}), convey.ShouldBeNil)
+		convey.So(w.Write([]string{"1", "=1+1"}), convey.ShouldBeNil)
+		convey.So(w.Close(), convey.ShouldBeNil)
+
+		f, err := excelize.OpenReader(&buf)
+		convey.So(err, convey.ShouldBeNil)
+		defer f.Close()
+		rows, err := f.GetRows("Sheet1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(rows, convey.ShouldResemble, [][]string{{"评价ID", "内容"}, {"1", "=1+1"}})
+	})
+
+	convey.Convey("unknown format", t, func() {
+		_, err := NewWriter("pdf", &bytes.Buffer{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,227 @@
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 机器ID租约存在redis里，多个副本用同一份配置启动时各自抢一个没人用的机器ID
+// snowflake:node:{id}       持有者标识，带过期时间，持有者定时续期
+// snowflake:node:{id}:last  持有者最后一次续期时的时间，换机器接手时用来发现时钟落后
+const leaseKeyPrefix = "snowflake:node:"
+
+const defaultLeaseTTL = 30 * time.Second
+
+var NoFreeMachineIDErr = errors.New("snowflake没有空闲的机器ID")
+
+// 续期和释放都要确认还是自己持有，过期后被别人抢走的不能动
+var (
+	renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	redis.call("SET", KEYS[2], ARGV[3])
+	return 1
+end
+return 0`)
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[2], ARGV[2])
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// Lease 从redis租用机器ID，实现Holder，并实现transport.Server随app启停续期
+type Lease struct {
+	rdb   *redis.Client
+	ttl   time.Duration
+	owner string
+	id    int64
+	stop  chan struct{}
+	log   *log.Helper
+
+	mu sync.Mutex
+	//本地认为租约有效的截止时间，续期失败超过这个时间就不能再生成ID
+	expireAt time.Time
+	lost     bool
+}
+
+func NewLease(rdb *redis.Client, ttl time.Duration, logger log.Logger) *Lease {
+	if ttl <= 0 {
+		ttl = defaultLeaseTTL
+	}
+	host, _ := os.Hostname()
+	return &Lease{
+		rdb:   rdb,
+		ttl:   ttl,
+		owner: fmt.Sprintf("%s-%d-%d", host, os.Getpid(), time.Now().UnixNano()),
+		stop:  make(chan struct{}),
+		log:   log.NewHelper(logger),
+	}
+}
+
+func nodeKey(id int64) string {
+	return leaseKeyPrefix + strconv.FormatInt(id, 10)
+}
+
+func lastKey(id int64) string {
+	return nodeKey(id) + ":last"
+}
+
+// Acquire 从1开始找第一个空闲的机器ID
+func (l *Lease) Acquire(ctx context.Context) (int64, error) {
+	id, start, err := l.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+	l.hold(id, start)
+	return id, nil
+}
+
+// claim 抢一个空闲的机器ID，返回开始抢时的时间作为租期起点
+// 上一个持有者最后使用的时间比本机当前时间还晚时跳过，说明本机时钟落后，用这个ID可能生成重复的ID
+func (l *Lease) claim(ctx context.Context) (int64, time.Time, error) {
+	for id := int64(1); id <= MaxMachineID(); id++ {
+		start := time.Now()
+		last, err := l.rdb.Get(ctx, lastKey(id)).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return 0, start, err
+		}
+		if last >= start.UnixMilli() {
+			continue
+		}
+		ok, err := l.rdb.SetNX(ctx, nodeKey(id), l.owner, l.ttl).Result()
+		if err != nil {
+			return 0, start, err
+		}
+		if ok {
+			return id, start, nil
+		}
+	}
+	return 0, time.Time{}, NoFreeMachineIDErr
+}
+
+func (l *Lease) hold(id int64, start time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.id = id
+	l.expireAt = start.Add(l.ttl)
+	l.lost = false
+}
+
+// ID 当前持有的机器ID
+func (l *Lease) ID() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.id
+}
+
+// Valid 租约没有丢失且没有过期
+func (l *Lease) Valid() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return !l.lost && l.id > 0 && time.Now().Before(l.expireAt)
+}
+
+// Renew 续期一次，租约已被别人持有时标记为丢失
+func (l *Lease) Renew(ctx context.Context) error {
+	id := l.ID()
+	start := time.Now()
+	ret, err := renewScript.Run(ctx, l.rdb, []string{nodeKey(id), lastKey(id)},
+		l.owner, l.ttl.Milliseconds(), lastUsed(start)).Int()
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if ret == 0 {
+		l.lost = true
+		return LeaseLostErr
+	}
+	l.expireAt = start.Add(l.ttl)
+	return nil
+}
+
+// lastUsed 取已生成ID的时间和当前时间中较晚的一个
+func lastUsed(now time.Time) int64 {
+	if last := Last(); last > now.UnixMilli() {
+		return last
+	}
+	return now.UnixMilli()
+}
+
+func (l *Lease) Start(ctx context.Context) error {
+	//每三分之一个租期续一次，偶尔失败一次还来得及再续
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-l.stop:
+			return nil
+		case <-ticker.C:
+			l.keepalive(ctx)
+		}
+	}
+}
+
+// keepalive 续期一次；租约丢失(被抢走，或redis中断超过租期后过期)时重新租用一个空闲的机器ID
+// 不重新租用的话只有重启才能恢复，期间所有创建都会失败
+func (l *Lease) keepalive(ctx context.Context) {
+	err := l.Renew(ctx)
+	if err == nil {
+		return
+	}
+	if !errors.Is(err, LeaseLostErr) {
+		l.log.Errorf("renew snowflake machine id %d failed, err:%v", l.ID(), err)
+		return
+	}
+	old := l.ID()
+	id, start, err := l.claim(ctx)
+	if err != nil {
+		l.log.Errorf("snowflake machine id %d lost, acquire again failed, err:%v", old, err)
+		return
+	}
+	//先切换生成器的机器ID再标记租约有效，避免用新租约生成旧机器ID的ID
+	if err := switchHolderNode(l, id); err != nil {
+		l.log.Errorf("snowflake switch machine id %d to %d failed, err:%v", old, id, err)
+		return
+	}
+	l.hold(id, start)
+	l.log.Infof("snowflake machine id %d lost, leased %d again", old, id)
+}
+
+// LeaseMachineID 从redis租用机器ID并初始化生成器，后台续期，租约丢失后重新租用
+func LeaseMachineID(rdb *redis.Client, startTime string, ttl time.Duration, logger log.Logger) (*Lease, error) {
+	lease := NewLease(rdb, ttl, logger)
+	id, err := lease.Acquire(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if err := InitWithHolder(startTime, id, lease); err != nil {
+		return nil, err
+	}
+	lease.log.Infof("snowflake machine id leased: %d", id)
+	go lease.Start(context.Background())
+	return lease, nil
+}
+
+// Stop 停止续期并释放机器ID，释放前记下最后使用时间
+func (l *Lease) Stop(ctx context.Context) error {
+	close(l.stop)
+	id := l.ID()
+	_, err := releaseScript.Run(ctx, l.rdb, []string{nodeKey(id), lastKey(id)}, l.owner, lastUsed(time.Now())).Result()
+	l.mu.Lock()
+	l.lost = true
+	l.mu.Unlock()
+	return err
+}
@@ -2,6 +2,7 @@ package snowflake
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	sf "github.com/bwmarrin/snowflake"
@@ -10,12 +11,39 @@ import (
 var (
 	InvalidInitParamErr    = errors.New("snowflake初始化失败，无效的startTime或machineID")
 	InvalidTimeFormatError = errors.New("snowflake初始化失败，无效的startTime格式")
+	NotInitializedErr      = errors.New("snowflake未初始化")
+	LeaseLostErr           = errors.New("snowflake机器ID租约已失效，拒绝生成ID")
+	ClockBackwardsErr      = errors.New("snowflake检测到时钟回拨，拒绝生成ID")
 )
 
-var node *sf.Node
+// MaxMachineID 机器ID上限，默认占10位
+func MaxMachineID() int64 {
+	return -1 ^ (-1 << sf.NodeBits)
+}
+
+// Holder 机器ID的持有状态，租约失效后不能再用这个机器ID生成
+type Holder interface {
+	Valid() bool
+}
 
-func Init(startTime string, machineID int64) (err error) {
-	if len(startTime) == 0 || machineID <= 0 {
+type generator struct {
+	mu     sync.Mutex
+	node   *sf.Node
+	holder Holder
+	//最近一次生成ID时的墙上时间(毫秒)，用来发现时钟回拨
+	last int64
+}
+
+var gen *generator
+
+// Init 使用配置里固定的机器ID
+func Init(startTime string, machineID int64) error {
+	return InitWithHolder(startTime, machineID, nil)
+}
+
+// InitWithHolder 机器ID由holder持有(如Lease)，holder失效后GenID返回LeaseLostErr
+func InitWithHolder(startTime string, machineID int64, holder Holder) (err error) {
+	if len(startTime) == 0 || machineID <= 0 || machineID > MaxMachineID() {
 		return InvalidInitParamErr
 	}
 	var st time.Time
@@ -24,9 +52,64 @@ func Init(startTime string, machineID int64) (err error) {
 		return InvalidTimeFormatError
 	}
 	sf.Epoch = st.UnixNano() / 1000000
-	node, err = sf.NewNode(machineID)
-	return
+	node, err := sf.NewNode(machineID)
+	if err != nil {
+		return err
+	}
+	gen = &generator{node: node, holder: holder}
+	return nil
+}
+
+// switchHolderNode holder重新租到机器ID后切换生成器的节点，保留时钟回拨检查用的时间
+func switchHolderNode(holder Holder, machineID int64) error {
+	if gen == nil || gen.holder != holder {
+		return nil
+	}
+	node, err := sf.NewNode(machineID)
+	if err != nil {
+		return err
+	}
+	gen.mu.Lock()
+	gen.node = node
+	gen.mu.Unlock()
+	return nil
 }
-func GenID() int64 {
-	return node.Generate().Int64()
+
+func GenID() (int64, error) {
+	if gen == nil {
+		return 0, NotInitializedErr
+	}
+	return gen.generate()
+}
+
+func (g *generator) generate() (int64, error) {
+	if g.holder != nil && !g.holder.Valid() {
+		return 0, LeaseLostErr
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	//sf.Node用单调时钟，进程内不会重复，但墙上时间回拨说明机器时钟有问题
+	//回拨期间拒绝生成，等时钟追上，避免重启后和回拨前生成的ID重复
+	now := time.Now().UnixMilli()
+	if now < g.last {
+		return 0, ClockBackwardsErr
+	}
+	g.last = now
+	return g.node.Generate().Int64(), nil
+}
+
+// Last 最近一次生成ID时的墙上时间(毫秒)
+func Last() int64 {
+	if gen == nil {
+		return 0
+	}
+	gen.mu.Lock()
+	defer gen.mu.Unlock()
+	return gen.last
+}
+
+// Decode 解析ID里的生成时间、机器ID和序列号，需要先Init设置起始时间
+func Decode(id int64) (time.Time, int64, int64) {
+	sid := sf.ID(id)
+	return time.UnixMilli(sid.Time()), sid.Node(), sid.Step()
 }
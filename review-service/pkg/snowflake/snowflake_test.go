@@ -0,0 +1,137 @@
+package snowflake
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGenID(t *testing.T) {
+	convey.Convey("decode returns the node and time", t, func() {
+		convey.So(Init("2024-03-01", 7), convey.ShouldBeNil)
+		id, err := GenID()
+		convey.So(err, convey.ShouldBeNil)
+		at, node, _ := Decode(id)
+		convey.So(node, convey.ShouldEqual, 7)
+		convey.So(time.Since(at), convey.ShouldBeLessThan, time.Second)
+	})
+
+	convey.Convey("refuse when the clock goes backwards", t, func() {
+		convey.So(Init("2024-03-01", 7), convey.ShouldBeNil)
+		gen.last = time.Now().Add(time.Minute).UnixMilli()
+		_, err := GenID()
+		convey.So(err, convey.ShouldEqual, ClockBackwardsErr)
+	})
+}
+
+func TestLease(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	ctx := context.Background()
+	gen = nil
+
+	convey.Convey("replicas lease different machine ids", t, func() {
+		mr.FlushAll()
+		a := NewLease(rdb, time.Minute, log.DefaultLogger)
+		b := NewLease(rdb, time.Minute, log.DefaultLogger)
+		ida, err := a.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		idb, err := b.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ida, convey.ShouldNotEqual, idb)
+		convey.So(a.Renew(ctx), convey.ShouldBeNil)
+
+		//释放后可以被别人重新租用
+		convey.So(a.Stop(ctx), convey.ShouldBeNil)
+		convey.So(a.Valid(), convey.ShouldBeFalse)
+		//同一毫秒内接手可能和释放前生成的ID重复
+		time.Sleep(2 * time.Millisecond)
+		c := NewLease(rdb, time.Minute, log.DefaultLogger)
+		idc, err := c.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(idc, convey.ShouldEqual, ida)
+	})
+
+	convey.Convey("lost lease stops id generation", t, func() {
+		mr.FlushAll()
+		l := NewLease(rdb, time.Minute, log.DefaultLogger)
+		id, err := l.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(InitWithHolder("2024-03-01", id, l), convey.ShouldBeNil)
+		_, err = GenID()
+		convey.So(err, convey.ShouldBeNil)
+
+		//租约过期后被其他实例抢走
+		mr.Set(nodeKey(id), "other")
+		convey.So(l.Renew(ctx), convey.ShouldEqual, LeaseLostErr)
+		_, err = GenID()
+		convey.So(err, convey.ShouldEqual, LeaseLostErr)
+	})
+
+	convey.Convey("skip ids last used later than the local clock", t, func() {
+		mr.FlushAll()
+		mr.Set(lastKey(1), strconv.FormatInt(time.Now().Add(time.Minute).UnixMilli(), 10))
+		l := NewLease(rdb, time.Minute, log.DefaultLogger)
+		id, err := l.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(id, convey.ShouldEqual, 2)
+	})
+
+	convey.Convey("a lost lease is leased again on the next renewal", t, func() {
+		mr.FlushAll()
+		l := NewLease(rdb, time.Minute, log.DefaultLogger)
+		id, err := l.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(InitWithHolder("2024-03-01", id, l), convey.ShouldBeNil)
+
+		mr.Set(nodeKey(id), "other")
+		l.keepalive(ctx)
+		convey.So(l.Valid(), convey.ShouldBeTrue)
+		convey.So(l.ID(), convey.ShouldNotEqual, id)
+		//生成器切到新的机器ID
+		gid, err := GenID()
+		convey.So(err, convey.ShouldBeNil)
+		_, node, _ := Decode(gid)
+		convey.So(node, convey.ShouldEqual, l.ID())
+	})
+
+	convey.Convey("a lease expired during a redis outage is leased again", t, func() {
+		mr.FlushAll()
+		l := NewLease(rdb, time.Minute, log.DefaultLogger)
+		id, err := l.Acquire(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(InitWithHolder("2024-03-01", id, l), convey.ShouldBeNil)
+
+		//redis中断超过租期，本地和redis里的租约都过期了
+		mr.FastForward(2 * time.Minute)
+		l.mu.Lock()
+		l.expireAt = time.Now().Add(-time.Second)
+		l.mu.Unlock()
+		_, err = GenID()
+		convey.So(err, convey.ShouldEqual, LeaseLostErr)
+
+		l.keepalive(ctx)
+		convey.So(l.Valid(), convey.ShouldBeTrue)
+		_, err = GenID()
+		convey.So(err, convey.ShouldBeNil)
+		owner, err := mr.Get(nodeKey(l.ID()))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(owner, convey.ShouldEqual, l.owner)
+	})
+
+	convey.Convey("LeaseMachineID initializes the generator with the leased id", t, func() {
+		mr.FlushAll()
+		l, err := LeaseMachineID(rdb, "2024-03-01", time.Minute, log.DefaultLogger)
+		convey.So(err, convey.ShouldBeNil)
+		defer l.Stop(ctx)
+		gid, err := GenID()
+		convey.So(err, convey.ShouldBeNil)
+		_, node, _ := Decode(gid)
+		convey.So(node, convey.ShouldEqual, l.ID())
+	})
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+// ErrBlockedAddress 回调地址指向内网、本机或链路本地地址
+// 投递在集群内发起，不能让商家填的地址打到consul、ES、云厂商元数据等内部服务
+var ErrBlockedAddress = errors.New("webhook: address not allowed")
+
+// 运营商级NAT地址段，云上常用作内部网络
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// Blocked 不允许投递的地址：回环、私有网段、链路本地(含169.254.169.254元数据)、未指定和组播地址
+func Blocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr)
+}
+
+// CheckHost 创建订阅时的检查，域名在投递时按实际连接的IP再检查一次
+func CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrBlockedAddress
+	}
+	if addr, err := netip.ParseAddr(strings.Trim(host, "[]")); err == nil && Blocked(addr) {
+		return ErrBlockedAddress
+	}
+	return nil
+}
+
+// dialControl 在建立连接前检查DNS解析后的IP，防止用域名解析到内网地址绕过CheckHost
+func dialControl(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if Blocked(addr) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, addr)
+	}
+	return nil
+}
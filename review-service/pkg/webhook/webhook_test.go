@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"github.com/smartystreets/goconvey/convey"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSend(t *testing.T) {
+	convey.Convey("delivery is signed and accepted", t, func() {
+		var (
+			secret = NewSecret()
+			body   = []byte(`{"event":"review.negative","reviewID":1}`)
+			ok     bool
+			event  string
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ := io.ReadAll(r.Body)
+			ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+			ok = Verify(secret, ts, got, r.Header.Get(HeaderSignature))
+			event = r.Header.Get(HeaderEvent)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		code, err := newSender(time.Second, nil).Send(context.Background(), srv.URL, secret, "review.negative", 1, body)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(code, convey.ShouldEqual, http.StatusNoContent)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(event, convey.ShouldEqual, "review.negative")
+	})
+
+	convey.Convey("non-2xx response is a failure", t, func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		code, err := newSender(time.Second, nil).Send(context.Background(), srv.URL, "s", "reply.posted", 2, []byte(`{}`))
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(code, convey.ShouldEqual, http.StatusBadGateway)
+	})
+
+	convey.Convey("wrong secret does not verify", t, func() {
+		sig := Sign("a", 100, []byte("x"))
+		convey.So(Verify("b", 100, []byte("x"), sig), convey.ShouldBeFalse)
+		convey.So(Verify("a", 101, []byte("x"), sig), convey.ShouldBeFalse)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	convey.Convey("backoff doubles up to max", t, func() {
+		convey.So(Backoff(time.Second, time.Minute, 1), convey.ShouldEqual, time.Second)
+		convey.So(Backoff(time.Second, time.Minute, 3), convey.ShouldEqual, 4*time.Second)
+		convey.So(Backoff(time.Second, time.Minute, 20), convey.ShouldEqual, time.Minute)
+	})
+}
+
+func TestBlockedAddress(t *testing.T) {
+	convey.Convey("internal addresses are rejected", t, func() {
+		for _, host := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "[::1]", "fe80::1", "::ffff:127.0.0.1", "localhost", "consul.localhost"} {
+			convey.So(CheckHost(host), convey.ShouldEqual, ErrBlockedAddress)
+		}
+		convey.So(CheckHost("8.8.8.8"), convey.ShouldBeNil)
+		convey.So(CheckHost("hooks.example.com"), convey.ShouldBeNil)
+	})
+
+	convey.Convey("sender refuses to dial internal addresses", t, func() {
+		var called bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer srv.Close()
+		_, err := NewSender(time.Second).Send(context.Background(), srv.URL, NewSecret(), "review.negative", 1, []byte("{}"))
+		convey.So(errors.Is(err, ErrBlockedAddress), convey.ShouldBeTrue)
+		convey.So(called, convey.ShouldBeFalse)
+	})
+}